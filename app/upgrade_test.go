@@ -10,6 +10,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/osmosis-labs/osmosis/app"
+	lockuptypes "github.com/osmosis-labs/osmosis/x/lockup/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/x/superfluid/types"
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
@@ -115,3 +117,23 @@ func (suite *UpgradeTestSuite) TestUpgradePayments() {
 		})
 	}
 }
+
+func (suite *UpgradeTestSuite) TestV5Upgrade() {
+	// the v5 upgrade keeps the pool creation fee set by governance
+	gammParams := suite.app.GAMMKeeper.GetParams(suite.ctx)
+	gammParams.PoolCreationFee = sdk.Coins{sdk.NewInt64Coin("uosmo", 1)}
+	suite.app.GAMMKeeper.SetParams(suite.ctx, gammParams)
+
+	plan := upgradetypes.Plan{Name: "v5", Height: 5}
+	err := suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, plan)
+	suite.Require().NoError(err)
+	plan, exists := suite.app.UpgradeKeeper.GetUpgradePlan(suite.ctx)
+	suite.Require().True(exists)
+	suite.Require().NotPanics(func() {
+		suite.app.UpgradeKeeper.ApplyUpgrade(suite.ctx.WithBlockHeight(5), plan)
+	})
+
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("uosmo", 1)}, suite.app.GAMMKeeper.GetParams(suite.ctx).PoolCreationFee)
+	suite.Require().Equal(lockuptypes.DefaultParams().RestrictLockTransfers, suite.app.LockupKeeper.GetParams(suite.ctx).RestrictLockTransfers)
+	suite.Require().Equal(superfluidtypes.DefaultParams(), suite.app.SuperfluidKeeper.GetParams(suite.ctx))
+}
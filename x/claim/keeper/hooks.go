@@ -64,6 +64,8 @@ func (h Hooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 func (h Hooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 	h.k.AfterSwap(ctx, sender)
 }
+func (h Hooks) AfterPoolMigrated(ctx sdk.Context, oldPoolId uint64, newPoolId uint64) {
+}
 
 // governance hooks
 func (h Hooks) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {}
@@ -1018,6 +1018,51 @@ func (s *IntegrationTestSuite) TestGetCmdSpotPrice() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdTwap() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"invalid start time", // osmosisd query gamm twap 1 stake node0token yesterday
+			[]string{
+				"1", "stake", "node0token", "yesterday",
+				fmt.Sprintf("--%s=%s", tmcli.OutputFlag, "json"),
+			},
+			true,
+		},
+		{
+			"start time before the pool was created", // osmosisd query gamm twap 1 stake node0token 0
+			[]string{
+				"1", "stake", "node0token", "0",
+				fmt.Sprintf("--%s=%s", tmcli.OutputFlag, "json"),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdTwap()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				resp := types.QueryTwapResponse{}
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &resp), out.String())
+			}
+		})
+	}
+}
+
 // func (s *IntegrationTestSuite) TestGetCmdEstimateSwapExactAmountIn() {
 // 	val := s.network.Validators[0]
 
@@ -15,11 +15,17 @@ const (
 	PoolFileExitFee        = "exit-fee"
 	PoolFileFutureGovernor = "future-governor"
 
+	PoolFileAmplificationParameter = "amplification-parameter"
+
 	PoolFileSmoothWeightChangeParams = "lbp-params"
 	PoolFileStartTime                = "start-time"
 	PoolFileDuration                 = "duration"
 	PoolFileTargetPoolWeights        = "target-pool-weights"
 
+	PoolFileLBPSale       = "lbp-sale"
+	PoolFileSaleStartTime = "sale-start-time"
+	PoolFileSaleEndTime   = "sale-end-time"
+
 	FlagPoolId = "pool-id"
 	// Will be parsed to sdk.Int
 	FlagShareAmountOut = "share-amount-out"
@@ -37,6 +43,38 @@ const (
 	FlagSwapRouteAmounts = "swap-route-amounts"
 	// Will be parsed to []string
 	FlagSwapRouteDenoms = "swap-route-denoms"
+
+	// Will be parsed to time.Time
+	FlagStartTime = "start-time"
+
+	// Will be parsed to []types.SwapAmountInSplitRoute
+	FlagSplitRoutes = "split-routes"
+
+	// Will be parsed to uint32
+	FlagMaxHops = "max-hops"
+	// Will be parsed to uint32
+	FlagMaxSplits = "max-splits"
+
+	// Will be parsed to uint32
+	FlagNumPoints = "num-points"
+
+	// Will be parsed to sdk.Dec
+	FlagSwapFee        = "swap-fee"
+	FlagFutureGovernor = "future-governor"
+
+	// Will be parsed to []types.PoolAsset
+	FlagReopenWeights = "reopen-weights"
+
+	// Will be parsed to sdk.Dec
+	FlagMaxSpotPrice = "max-spot-price"
+
+	// The denom the pool creation fee is paid in
+	FlagFeeDenom = "fee-denom"
+
+	// Will be parsed to sdk.Int
+	FlagMinAmount0 = "min-amount0"
+	// Will be parsed to sdk.Int
+	FlagMinAmount1 = "min-amount1"
 )
 
 type createPoolInputs struct {
@@ -45,7 +83,17 @@ type createPoolInputs struct {
 	SwapFee                  string                         `json:"swap-fee"`
 	ExitFee                  string                         `json:"exit-fee"`
 	FutureGovernor           string                         `json:"future-governor"`
+	ShareSymbol              string                         `json:"share-symbol"`
 	SmoothWeightChangeParams smoothWeightChangeParamsInputs `json:"lbp-params"`
+	LBPSale                  lbpSaleInputs                  `json:"lbp-sale"`
+}
+
+type createStableswapPoolInputs struct {
+	InitialDeposit         string `json:"initial-deposit"`
+	SwapFee                string `json:"swap-fee"`
+	ExitFee                string `json:"exit-fee"`
+	AmplificationParameter string `json:"amplification-parameter"`
+	FutureGovernor         string `json:"future-governor"`
 }
 
 type smoothWeightChangeParamsInputs struct {
@@ -54,6 +102,11 @@ type smoothWeightChangeParamsInputs struct {
 	TargetPoolWeights string `json:"target-pool-weights"`
 }
 
+type lbpSaleInputs struct {
+	SaleStartTime string `json:"sale-start-time"`
+	SaleEndTime   string `json:"sale-end-time"`
+}
+
 func FlagSetQuerySwapRoutes() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -70,10 +123,57 @@ func FlagSetSwapAmountOutRoutes() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetMaxSpotPrice() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagMaxSpotPrice, "0", "The max spot price of the routes before the swap, in tokens in per token out (no max if 0)")
+	return fs
+}
+
+func FlagSetSplitRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.StringArray(FlagSplitRoutes, []string{""}, "split route as <token-in-amount>=<pool-id>:<token-out-denom>,... (specify multiple routes with: --split-routes=100=1:uion --split-routes=50=2:uatom,3:uion)")
+	return fs
+}
+
+func FlagSetEstimateBestRoute() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint32(FlagMaxHops, 3, "The maximum number of pools in a route")
+	fs.Uint32(FlagMaxSplits, 1, "The maximum number of routes the input can be split across")
+	return fs
+}
+
+func FlagSetLBPStatus() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint32(FlagNumPoints, 10, "The number of points of the projected price curve")
+	return fs
+}
+
+func FlagSetCreateConcentratedPool() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagSwapFee, "0", "The swap fee of the pool")
+	fs.String(FlagFutureGovernor, "", "The future governor of the pool, as an address or a lockup duration")
+	fs.String(FlagFeeDenom, "", "The denom to pay the pool creation fee in, among the denoms allowed by the params (defaults to the fee set in the params)")
+	return fs
+}
+
+func FlagSetCreatePosition() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagMinAmount0, "0", "Minimum amount of the pool's first denom to deposit")
+	fs.String(FlagMinAmount1, "0", "Minimum amount of the pool's second denom to deposit")
+	return fs
+}
+
 func FlagSetCreatePool() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
 	fs.String(FlagPoolFile, "", "Pool json file path (if this path is given, other create pool flags should not be used)")
+	fs.String(FlagFeeDenom, "", "The denom to pay the pool creation fee in, among the denoms allowed by the params (defaults to the fee set in the params)")
 	return fs
 }
 
@@ -104,3 +204,17 @@ func FlagSetJoinSwapExternAmount() *flag.FlagSet {
 
 	return fs
 }
+
+func FlagSetScheduleWeightChange() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagStartTime, "", "The RFC3339 start time of the weight change (defaults to the block time)")
+	return fs
+}
+
+func FlagSetFinalizeLBP() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagReopenWeights, "", "The weights to reopen the pool with, e.g. 1uatom,1uosmo (the pool is closed if empty)")
+	return fs
+}
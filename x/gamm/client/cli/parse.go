@@ -7,6 +7,10 @@ import (
 	"io/ioutil"
 
 	"github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
 )
 
 type XCreatePoolInputs createPoolInputs
@@ -30,6 +34,27 @@ func (release *createPoolInputs) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+type XCreateStableswapPoolInputs createStableswapPoolInputs
+
+type XCreateStableswapPoolInputsExceptions struct {
+	XCreateStableswapPoolInputs
+	Other *string // Other won't raise an error
+}
+
+// UnmarshalJSON should error if there are fields unexpected
+func (release *createStableswapPoolInputs) UnmarshalJSON(data []byte) error {
+	var createPoolE XCreateStableswapPoolInputsExceptions
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields() // Force
+
+	if err := dec.Decode(&createPoolE); err != nil {
+		return err
+	}
+
+	*release = createStableswapPoolInputs(createPoolE.XCreateStableswapPoolInputs)
+	return nil
+}
+
 func parseCreatePoolFlags(fs *pflag.FlagSet) (*createPoolInputs, error) {
 	pool := &createPoolInputs{}
 	poolFile, _ := fs.GetString(FlagPoolFile)
@@ -51,3 +76,41 @@ func parseCreatePoolFlags(fs *pflag.FlagSet) (*createPoolInputs, error) {
 
 	return pool, nil
 }
+
+func parseCreateStableswapPoolFlags(fs *pflag.FlagSet) (*createStableswapPoolInputs, error) {
+	pool := &createStableswapPoolInputs{}
+	poolFile, _ := fs.GetString(FlagPoolFile)
+
+	if poolFile == "" {
+		return nil, fmt.Errorf("must pass in a pool json using the --%s flag", FlagPoolFile)
+	}
+
+	contents, err := ioutil.ReadFile(poolFile)
+	if err != nil {
+		return nil, err
+	}
+
+	// make exception if unknown field exists
+	err = pool.UnmarshalJSON(contents)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// parseFlashSwapMsgsFile returns a MsgFlashSwap with the msgs of the json file.
+func parseFlashSwapMsgsFile(cdc codec.JSONMarshaler, msgsFile string) (*types.MsgFlashSwap, error) {
+	contents, err := ioutil.ReadFile(msgsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &types.MsgFlashSwap{}
+	err = cdc.UnmarshalJSON(contents, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -33,9 +34,27 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdTotalShares(),
 		GetCmdPoolAssets(),
 		GetCmdSpotPrice(),
+		GetCmdTwap(),
 		GetCmdQueryTotalLiquidity(),
+		GetCmdQueryProtocolFees(),
+		GetCmdPoolCreationFee(),
 		GetCmdEstimateSwapExactAmountIn(),
 		GetCmdEstimateSwapExactAmountOut(),
+		GetCmdEstimateJoinPool(),
+		GetCmdEstimateJoinSwapExternAmountIn(),
+		GetCmdEstimateJoinSwapShareAmountOut(),
+		GetCmdEstimateExitPool(),
+		GetCmdEstimateExitSwapShareAmountIn(),
+		GetCmdEstimateExitSwapExternAmountOut(),
+		GetCmdEstimateBestRoute(),
+		GetCmdPosition(),
+		GetCmdAccountPositions(),
+		GetCmdBatchResult(),
+		GetCmdLimitOrder(),
+		GetCmdAccountLimitOrders(),
+		GetCmdPoolLimitOrders(),
+		GetCmdPoolStats(),
+		GetCmdLBPStatus(),
 	)
 
 	return cmd
@@ -299,20 +318,641 @@ $ %s query gamm total-share 1
 	return cmd
 }
 
+// GetCmdPosition returns a concentrated liquidity position
+func GetCmdPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "position <positionID>",
+		Short: "Query a concentrated liquidity position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a concentrated liquidity position.
+Example:
+$ %s query gamm position 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			positionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Position(cmd.Context(), &types.QueryPositionRequest{
+				PositionId: positionID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAccountPositions returns the concentrated liquidity positions of an account
+func GetCmdAccountPositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-positions <address>",
+		Short: "Query the concentrated liquidity positions of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the concentrated liquidity positions of an account.
+Example:
+$ %s query gamm account-positions osmo1fqlr98d45v5ysqgp6h56kpujcj4cvsjnjq9nck
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountPositions(cmd.Context(), &types.QueryAccountPositionsRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdLimitOrder returns a limit order
+func GetCmdLimitOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-order <orderID>",
+		Short: "Query a limit order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a limit order that isn't filled yet.
+Example:
+$ %s query gamm limit-order 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.LimitOrder(cmd.Context(), &types.QueryLimitOrderRequest{
+				OrderId: orderID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAccountLimitOrders returns the limit orders of an account
+func GetCmdAccountLimitOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-limit-orders <address>",
+		Short: "Query the limit orders of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the limit orders of an account that aren't filled yet.
+Example:
+$ %s query gamm account-limit-orders osmo1fqlr98d45v5ysqgp6h56kpujcj4cvsjnjq9nck
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountLimitOrders(cmd.Context(), &types.QueryAccountLimitOrdersRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPoolLimitOrders returns the limit orders resting against a pool
+func GetCmdPoolLimitOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-limit-orders <poolID>",
+		Short: "Query the limit orders resting against a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the limit orders resting against a pool, by asset pair and increasing trigger price.
+Example:
+$ %s query gamm pool-limit-orders 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolLimitOrders(cmd.Context(), &types.QueryPoolLimitOrdersRequest{
+				PoolId: poolID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPoolStats returns the activity of a pool by epoch
+func GetCmdPoolStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-stats <poolID> <fromEpoch> <toEpoch>",
+		Short: "Query the swap volume, fees, joins, exits and liquidity of a pool by epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the activity of a pool during the epochs between fromEpoch and toEpoch, both included.
+Epochs are those of the gamm stats epoch identifier, and the pool has no stats for the epochs it didn't change in.
+Example:
+$ %s query gamm pool-stats 1 10 17
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fromEpoch, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			toEpoch, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolStats(cmd.Context(), &types.QueryPoolStatsRequest{
+				PoolId:    poolID,
+				FromEpoch: fromEpoch,
+				ToEpoch:   toEpoch,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdLBPStatus returns the sale status and projected price curve of a liquidity bootstrapping pool
+func GetCmdLBPStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lbp-status <poolID> <baseAssetDenom> <quoteAssetDenom>",
+		Short: "Query the sale status of a liquidity bootstrapping pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the sale status of a liquidity bootstrapping pool, and the spot price of the base asset in the quote asset
+over the sale, projected from the weight change of the pool, assuming no swaps.
+Example:
+$ %s query gamm lbp-status 1 uatom uosmo --num-points 20
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			numPoints, err := cmd.Flags().GetUint32(FlagNumPoints)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.LBPStatus(cmd.Context(), &types.QueryLBPStatusRequest{
+				PoolId:          poolID,
+				BaseAssetDenom:  args[1],
+				QuoteAssetDenom: args[2],
+				NumPoints:       numPoints,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetLBPStatus())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdBatchResult returns the last batch of swaps executed on a pool
+func GetCmdBatchResult() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-result <poolID>",
+		Short: "Query the result of the last batch of swaps executed on a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the clearing prices and the swaps of the last batch executed on a pool in batch mode.
+Example:
+$ %s query gamm batch-result 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BatchResult(cmd.Context(), &types.QueryBatchResultRequest{
+				PoolId: poolID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPoolCreationFee returns who paid the creation fee of a pool, and what
+func GetCmdPoolCreationFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-creation-fee <poolID>",
+		Short: "Query the creation fee paid for a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the account that paid the creation fee of a pool, the fee and where it was sent.
+Example:
+$ %s query gamm pool-creation-fee 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolCreationFee(cmd.Context(), &types.QueryPoolCreationFeeRequest{
+				PoolId: poolID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryTotalLiquidity return total liquidity
 func GetCmdQueryTotalLiquidity() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "total-liquidity",
-		Short: "Query total-liquidity",
+		Use:   "total-liquidity",
+		Short: "Query total-liquidity",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query total-liquidity.
+Example:
+$ %s query gamm total-liquidity
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalLiquidity(cmd.Context(), &types.QueryTotalLiquidityRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProtocolFees return the cumulative swap fees sent to the community pool
+func GetCmdQueryProtocolFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protocol-fees",
+		Short: "Query the swap fees sent to the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative swap fees sent to the community pool.
+Example:
+$ %s query gamm protocol-fees
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProtocolFees(cmd.Context(), &types.QueryProtocolFeesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPoolAssets return pool-assets for a pool
+func GetCmdPoolAssets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-assets <poolID>",
+		Short: "Query pool-assets",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query pool assets.
+Example:
+$ %s query gamm pool-assets 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolAssets(cmd.Context(), &types.QueryPoolAssetsRequest{
+				PoolId: uint64(poolID),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSpotPrice returns spot price
+func GetCmdSpotPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spot-price <poolID> <tokenInDenom> <tokenOutDenom>",
+		Short: "Query spot-price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query spot-price.
+Example:
+$ %s query gamm spot-price 1 stake stake2
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SpotPrice(cmd.Context(), &types.QuerySpotPriceRequest{
+				PoolId:        uint64(poolID),
+				TokenInDenom:  args[1],
+				TokenOutDenom: args[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdTwap returns the arithmetic twap of a pool asset pair
+func GetCmdTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap <poolID> <baseAsset> <quoteAsset> <startTime> [endTime]",
+		Short: "Query arithmetic twap",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the arithmetic time weighted average price of the base asset, in units of the quote asset.
+Times are either unix timestamps or RFC3339 times. The end time defaults to the latest block time.
+Example:
+$ %s query gamm twap 1 stake stake2 2021-12-01T00:00:00Z 1638403200
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(4, 5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := parseTime(args[3])
+			if err != nil {
+				return err
+			}
+
+			var endTime *time.Time
+			if len(args) == 5 {
+				t, err := parseTime(args[4])
+				if err != nil {
+					return err
+				}
+				endTime = &t
+			}
+
+			res, err := queryClient.Twap(cmd.Context(), &types.QueryTwapRequest{
+				PoolId:     uint64(poolID),
+				BaseAsset:  args[1],
+				QuoteAsset: args[2],
+				StartTime:  startTime,
+				EndTime:    endTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseTime parses either a unix timestamp or an RFC3339 time
+func parseTime(timeStr string) (time.Time, error) {
+	if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil {
+		return time.Unix(timeUnix, 0).UTC(), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, timeStr)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("time %s is neither a unix timestamp nor an RFC3339 time", timeStr)
+	}
+	return t, nil
+}
+
+// GetCmdEstimateSwapExactAmountIn returns estimation of output coin when amount of x token input
+func GetCmdEstimateSwapExactAmountIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-swap-exact-amount-in <poolID> <sender> <tokenIn>",
+		Short: "Query estimate-swap-exact-amount-in",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query total-liquidity.
+			fmt.Sprintf(`Query estimate-swap-exact-amount-in.
 Example:
-$ %s query gamm total-liquidity
+$ %s query gamm estimate-swap-exact-amount-in 1 osm11vmx8jtggpd9u7qr0t8vxclycz85u925sazglr7 stake --swap-route-pool-ids=2 --swap-route-amounts=100stake2 --swap-route-pool-ids=3 --swap-route-amounts=100stake
 `,
 				version.AppName,
 			),
 		),
-		Args: cobra.ExactArgs(0),
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -320,7 +960,22 @@ $ %s query gamm total-liquidity
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.TotalLiquidity(cmd.Context(), &types.QueryTotalLiquidityRequest{})
+			poolID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			routes, err := swapAmountInRoutes(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateSwapExactAmountIn(cmd.Context(), &types.QuerySwapExactAmountInRequest{
+				Sender:  args[1],        // TODO: where sender is used?
+				PoolId:  uint64(poolID), // TODO: is this poolId used?
+				TokenIn: args[2],
+				Routes:  routes,
+			})
 			if err != nil {
 				return err
 			}
@@ -329,25 +984,28 @@ $ %s query gamm total-liquidity
 		},
 	}
 
+	cmd.Flags().AddFlagSet(FlagSetQuerySwapRoutes())
 	flags.AddQueryFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSwapRoutePoolIds)
+	_ = cmd.MarkFlagRequired(FlagSwapRouteDenoms)
 
 	return cmd
 }
 
-// GetCmdPoolAssets return pool-assets for a pool
-func GetCmdPoolAssets() *cobra.Command {
+// GetCmdEstimateSwapExactAmountOut returns estimation of input coin to get exact amount of x token output
+func GetCmdEstimateSwapExactAmountOut() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "pool-assets <poolID>",
-		Short: "Query pool-assets",
+		Use:   "estimate-swap-exact-amount-out <poolID> <sender> <tokenOut>",
+		Short: "Query estimate-swap-exact-amount-out",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query pool assets.
+			fmt.Sprintf(`Query estimate-swap-exact-amount-out.
 Example:
-$ %s query gamm pool-assets 1
+$ %s query gamm estimate-swap-exact-amount-out 1 osm11vmx8jtggpd9u7qr0t8vxclycz85u925sazglr7 stake --swap-route-pool-ids=2 --swap-route-amounts=100stake2 --swap-route-pool-ids=3 --swap-route-amounts=100stake
 `,
 				version.AppName,
 			),
 		),
-		Args: cobra.ExactArgs(1),
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -360,8 +1018,16 @@ $ %s query gamm pool-assets 1
 				return err
 			}
 
-			res, err := queryClient.PoolAssets(cmd.Context(), &types.QueryPoolAssetsRequest{
-				PoolId: uint64(poolID),
+			routes, err := swapAmountOutRoutes(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateSwapExactAmountOut(cmd.Context(), &types.QuerySwapExactAmountOutRequest{
+				Sender:   args[1],        // TODO: where sender is used?
+				PoolId:   uint64(poolID), // TODO: is this poolId used?
+				Routes:   routes,
+				TokenOut: args[2],
 			})
 			if err != nil {
 				return err
@@ -371,20 +1037,23 @@ $ %s query gamm pool-assets 1
 		},
 	}
 
+	cmd.Flags().AddFlagSet(FlagSetQuerySwapRoutes())
 	flags.AddQueryFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSwapRoutePoolIds)
+	_ = cmd.MarkFlagRequired(FlagSwapRouteDenoms)
 
 	return cmd
 }
 
-// GetCmdSpotPrice returns spot price
-func GetCmdSpotPrice() *cobra.Command {
+// GetCmdEstimateJoinPool returns an estimate of the tokens needed to join a pool for an exact amount of shares
+func GetCmdEstimateJoinPool() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "spot-price <poolID> <tokenInDenom> <tokenOutDenom>",
-		Short: "Query spot-price",
+		Use:   "estimate-join-pool <poolID> <sender> <shareOutAmount>",
+		Short: "Query the tokens needed to join a pool for an exact amount of shares",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query spot-price.
+			fmt.Sprintf(`Query the tokens needed to join a pool for an exact amount of shares, by running it against the sender's balances.
 Example:
-$ %s query gamm spot-price 1 stake stake2
+$ %s query gamm estimate-join-pool 1 osm11vmx8jtggpd9u7qr0t8vxclycz85u925sazglr7 1000000000000000000
 `,
 				version.AppName,
 			),
@@ -397,15 +1066,15 @@ $ %s query gamm spot-price 1 stake stake2
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			poolID, err := strconv.Atoi(args[0])
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.SpotPrice(cmd.Context(), &types.QuerySpotPriceRequest{
-				PoolId:        uint64(poolID),
-				TokenInDenom:  args[1],
-				TokenOutDenom: args[2],
+			res, err := queryClient.EstimateJoinPool(cmd.Context(), &types.QueryJoinPoolRequest{
+				Sender:         args[1],
+				PoolId:         poolID,
+				ShareOutAmount: args[2],
 			})
 			if err != nil {
 				return err
@@ -420,15 +1089,15 @@ $ %s query gamm spot-price 1 stake stake2
 	return cmd
 }
 
-// GetCmdEstimateSwapExactAmountIn returns estimation of output coin when amount of x token input
-func GetCmdEstimateSwapExactAmountIn() *cobra.Command {
+// GetCmdEstimateJoinSwapExternAmountIn returns an estimate of the shares received for joining a pool with a single token
+func GetCmdEstimateJoinSwapExternAmountIn() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "estimate-swap-exact-amount-in <poolID> <sender> <tokenIn>",
-		Short: "Query estimate-swap-exact-amount-in",
+		Use:   "estimate-join-swap-extern-amount-in <poolID> <sender> <tokenIn>",
+		Short: "Query the shares received for joining a pool with a single token",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query estimate-swap-exact-amount-in.
+			fmt.Sprintf(`Query the shares received for joining a pool with a single token, by running it against the sender's balances.
 Example:
-$ %s query gamm estimate-swap-exact-amount-in 1 osm11vmx8jtggpd9u7qr0t8vxclycz85u925sazglr7 stake --swap-route-pool-ids=2 --swap-route-amounts=100stake2 --swap-route-pool-ids=3 --swap-route-amounts=100stake
+$ %s query gamm estimate-join-swap-extern-amount-in 1 osm11vmx8jtggpd9u7qr0t8vxclycz85u925sazglr7 100stake
 `,
 				version.AppName,
 			),
@@ -441,21 +1110,60 @@ $ %s query gamm estimate-swap-exact-amount-in 1 osm11vmx8jtggpd9u7qr0t8vxclycz85
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			poolID, err := strconv.Atoi(args[0])
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
 				return err
 			}
 
-			routes, err := swapAmountInRoutes(cmd.Flags())
+			res, err := queryClient.EstimateJoinSwapExternAmountIn(cmd.Context(), &types.QueryJoinSwapExternAmountInRequest{
+				Sender:  args[1],
+				PoolId:  poolID,
+				TokenIn: args[2],
+			})
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.EstimateSwapExactAmountIn(cmd.Context(), &types.QuerySwapExactAmountInRequest{
-				Sender:  args[1],        // TODO: where sender is used?
-				PoolId:  uint64(poolID), // TODO: is this poolId used?
-				TokenIn: args[2],
-				Routes:  routes,
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdEstimateJoinSwapShareAmountOut returns an estimate of the single token needed to join a pool for an exact amount of shares
+func GetCmdEstimateJoinSwapShareAmountOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-join-swap-share-amount-out <poolID> <sender> <tokenInDenom> <shareOutAmount>",
+		Short: "Query the single token needed to join a pool for an exact amount of shares",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the single token needed to join a pool for an exact amount of shares, by running it against the sender's balances.
+Example:
+$ %s query gamm estimate-join-swap-share-amount-out 1 osm11vmx8jtggpd9u7qr0t8vxclycz85u925sazglr7 stake 1000000000000000000
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateJoinSwapShareAmountOut(cmd.Context(), &types.QueryJoinSwapShareAmountOutRequest{
+				Sender:         args[1],
+				PoolId:         poolID,
+				TokenInDenom:   args[2],
+				ShareOutAmount: args[3],
 			})
 			if err != nil {
 				return err
@@ -465,23 +1173,20 @@ $ %s query gamm estimate-swap-exact-amount-in 1 osm11vmx8jtggpd9u7qr0t8vxclycz85
 		},
 	}
 
-	cmd.Flags().AddFlagSet(FlagSetQuerySwapRoutes())
 	flags.AddQueryFlagsToCmd(cmd)
-	_ = cmd.MarkFlagRequired(FlagSwapRoutePoolIds)
-	_ = cmd.MarkFlagRequired(FlagSwapRouteDenoms)
 
 	return cmd
 }
 
-// GetCmdEstimateSwapExactAmountOut returns estimation of input coin to get exact amount of x token output
-func GetCmdEstimateSwapExactAmountOut() *cobra.Command {
+// GetCmdEstimateExitPool returns an estimate of the tokens received and the exit fee for exiting a pool with an exact amount of shares
+func GetCmdEstimateExitPool() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "estimate-swap-exact-amount-out <poolID> <sender> <tokenOut>",
-		Short: "Query estimate-swap-exact-amount-out",
+		Use:   "estimate-exit-pool <poolID> <sender> <shareInAmount>",
+		Short: "Query the tokens received and the exit fee for exiting a pool with an exact amount of shares",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query estimate-swap-exact-amount-out.
+			fmt.Sprintf(`Query the tokens received and the exit fee for exiting a pool with an exact amount of shares, by running it against the sender's balances.
 Example:
-$ %s query gamm estimate-swap-exact-amount-out 1 osm11vmx8jtggpd9u7qr0t8vxclycz85u925sazglr7 stake --swap-route-pool-ids=2 --swap-route-amounts=100stake2 --swap-route-pool-ids=3 --swap-route-amounts=100stake
+$ %s query gamm estimate-exit-pool 1 osm11vmx8jtggpd9u7qr0t8vxclycz85u925sazglr7 1000000000000000000
 `,
 				version.AppName,
 			),
@@ -494,20 +1199,103 @@ $ %s query gamm estimate-swap-exact-amount-out 1 osm11vmx8jtggpd9u7qr0t8vxclycz8
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			poolID, err := strconv.Atoi(args[0])
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
 			if err != nil {
 				return err
 			}
 
-			routes, err := swapAmountOutRoutes(cmd.Flags())
+			res, err := queryClient.EstimateExitPool(cmd.Context(), &types.QueryExitPoolRequest{
+				Sender:        args[1],
+				PoolId:        poolID,
+				ShareInAmount: args[2],
+			})
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.EstimateSwapExactAmountOut(cmd.Context(), &types.QuerySwapExactAmountOutRequest{
-				Sender:   args[1],        // TODO: where sender is used?
-				PoolId:   uint64(poolID), // TODO: is this poolId used?
-				Routes:   routes,
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdEstimateExitSwapShareAmountIn returns an estimate of the single token received and the exit fee for exiting a pool with an exact amount of shares
+func GetCmdEstimateExitSwapShareAmountIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-exit-swap-share-amount-in <poolID> <sender> <tokenOutDenom> <shareInAmount>",
+		Short: "Query the single token received and the exit fee for exiting a pool with an exact amount of shares",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the single token received and the exit fee for exiting a pool with an exact amount of shares, by running it against the sender's balances.
+Example:
+$ %s query gamm estimate-exit-swap-share-amount-in 1 osm11vmx8jtggpd9u7qr0t8vxclycz85u925sazglr7 stake 1000000000000000000
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateExitSwapShareAmountIn(cmd.Context(), &types.QueryExitSwapShareAmountInRequest{
+				Sender:        args[1],
+				PoolId:        poolID,
+				TokenOutDenom: args[2],
+				ShareInAmount: args[3],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdEstimateExitSwapExternAmountOut returns an estimate of the shares and the exit fee needed to exit a pool for an exact single token
+func GetCmdEstimateExitSwapExternAmountOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-exit-swap-extern-amount-out <poolID> <sender> <tokenOut>",
+		Short: "Query the shares and the exit fee needed to exit a pool for an exact single token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the shares and the exit fee needed to exit a pool for an exact single token, by running it against the sender's balances.
+Example:
+$ %s query gamm estimate-exit-swap-extern-amount-out 1 osm11vmx8jtggpd9u7qr0t8vxclycz85u925sazglr7 100stake
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateExitSwapExternAmountOut(cmd.Context(), &types.QueryExitSwapExternAmountOutRequest{
+				Sender:   args[1],
+				PoolId:   poolID,
 				TokenOut: args[2],
 			})
 			if err != nil {
@@ -518,10 +1306,58 @@ $ %s query gamm estimate-swap-exact-amount-out 1 osm11vmx8jtggpd9u7qr0t8vxclycz8
 		},
 	}
 
-	cmd.Flags().AddFlagSet(FlagSetQuerySwapRoutes())
 	flags.AddQueryFlagsToCmd(cmd)
-	_ = cmd.MarkFlagRequired(FlagSwapRoutePoolIds)
-	_ = cmd.MarkFlagRequired(FlagSwapRouteDenoms)
+
+	return cmd
+}
+
+// GetCmdEstimateBestRoute returns the routes with the best output for swapping the input token
+func GetCmdEstimateBestRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-best-route <tokenIn> <tokenOutDenom>",
+		Short: "Query the best routes to swap a token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the routes, possibly split, that return the most of the output denom for the input token.
+Example:
+$ %s query gamm estimate-best-route 1000stake stake2 --max-hops=2 --max-splits=3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			maxHops, err := cmd.Flags().GetUint32(FlagMaxHops)
+			if err != nil {
+				return err
+			}
+
+			maxSplits, err := cmd.Flags().GetUint32(FlagMaxSplits)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateBestRoute(cmd.Context(), &types.QueryEstimateBestRouteRequest{
+				TokenIn:       args[0],
+				TokenOutDenom: args[1],
+				MaxHops:       maxHops,
+				MaxSplits:     maxSplits,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetEstimateBestRoute())
+	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
@@ -15,6 +15,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/osmosis-labs/osmosis/x/gamm/types"
 )
 
@@ -29,14 +31,31 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewCreatePoolCmd(),
+		NewCreateStableswapPoolCmd(),
+		NewCreateConcentratedPoolCmd(),
+		NewCreatePositionCmd(),
+		NewWithdrawPositionCmd(),
+		NewCollectFeesCmd(),
 		NewJoinPoolCmd(),
 		NewExitPoolCmd(),
 		NewSwapExactAmountInCmd(),
+		NewSplitRouteSwapExactAmountInCmd(),
 		NewSwapExactAmountOutCmd(),
+		NewSwapExactAmountInWithPriceImpactCmd(),
+		NewSwapExactAmountOutWithPriceImpactCmd(),
 		NewJoinSwapExternAmountIn(),
 		NewJoinSwapShareAmountOut(),
 		NewExitSwapExternAmountOut(),
 		NewExitSwapShareAmountIn(),
+		NewSetPoolSwapFeeCmd(),
+		NewSetPoolExitFeeCmd(),
+		NewScheduleWeightChangeCmd(),
+		NewSetPoolBatchModeCmd(),
+		NewPlaceLimitOrderCmd(),
+		NewCancelLimitOrderCmd(),
+		NewFlashSwapCmd(),
+		NewFinalizeLBPCmd(),
+		NewMigratePoolSharesCmd(),
 	)
 
 	return txCmd
@@ -59,8 +78,21 @@ Where pool.json contains:
 	"initial-deposit": "100uatom,5osmo,20uakt",
 	"swap-fee": "0.01",
 	"exit-fee": "0.01",
-	"future-governor": "168h"
+	"future-governor": "168h",
+	"share-symbol": "ATOMOSMOAKT"
 }
+
+The share symbol is optional, the pool shares are displayed as GAMM-<pool id> without it.
+
+The pool creation fee can be paid in another denom allowed by the params with --fee-denom.
+
+A liquidity bootstrapping pool only open to swaps during its sale is created by adding
+its sale window, where the start time is optional and defaults to the creation time:
+	"lbp-sale": {
+		"sale-start-time": "2021-10-01T00:00:00Z",
+		"sale-end-time": "2021-10-04T00:00:00Z"
+	}
+Only the creator can join the pool until it's finalized.
 `,
 				version.AppName,
 			),
@@ -91,6 +123,183 @@ Where pool.json contains:
 	return cmd
 }
 
+func NewCreateStableswapPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-stableswap-pool [flags]",
+		Short: "create a new stableswap pool and provide the liquidity to it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`create a new stableswap pool and provide the liquidity to it.
+Pool initialization parameters must be provided through a pool JSON file.
+
+Example:
+$ %s tx gamm create-stableswap-pool --pool-file="path/to/pool.json" --from mykey
+
+Where pool.json contains:
+{
+	"initial-deposit": "100uusdc,100uusdt",
+	"swap-fee": "0.001",
+	"exit-fee": "0",
+	"amplification-parameter": "100",
+	"future-governor": "168h"
+}
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildCreateStableswapPoolMsg(clientCtx, txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetCreatePool())
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagPoolFile)
+
+	return cmd
+}
+
+func NewCreateConcentratedPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-concentrated-pool [denom0] [denom1] [tick-spacing] [initial-price]",
+		Short: "create a new concentrated liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`create a new concentrated liquidity pool, at the initial price of denom0 in units of denom1.
+Liquidity is then provided to the pool with positions.
+Example:
+$ %s tx gamm create-concentrated-pool uatom uosmo 10 12.5 --swap-fee=0.003 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildCreateConcentratedPoolMsg(clientCtx, args, txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetCreateConcentratedPool())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCreatePositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-position [pool-id] [lower-tick] [upper-tick] [token-desired0] [token-desired1]",
+		Short: "provide liquidity to a concentrated pool between two ticks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`provide as much liquidity between the lower and upper tick as the desired amounts of
+the pool's denoms allow at the current price. Negative ticks have to follow "--".
+Example:
+$ %s tx gamm create-position --from mykey -- 1 -1000 1000 1000000 1000000
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildCreatePositionMsg(clientCtx, args, txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetCreatePosition())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewWithdrawPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-position [position-id] [liquidity]",
+		Short: "withdraw liquidity from a concentrated liquidity position, along with its fees",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildWithdrawPositionMsg(clientCtx, args[0], args[1], txf)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCollectFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collect-fees [position-id]",
+		Short: "collect the fees earned by a concentrated liquidity position",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildCollectFeesMsg(clientCtx, args[0], txf)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewJoinPoolCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "join-pool",
@@ -185,6 +394,44 @@ func NewSwapExactAmountInCmd() *cobra.Command {
 	return cmd
 }
 
+func NewSplitRouteSwapExactAmountInCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-split-route [token-in-denom] [token-out-min-amount]",
+		Short: "swap exact amount in, split across several routes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap the token in denom along each route, which all have to end in the same denom.
+The swap fails if the sum of the outputs of the routes is lesser than the min amount.
+Example:
+$ %s tx gamm swap-split-route uosmo 140 --split-routes=100=1:uion --split-routes=50=2:uatom,3:uion
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildSplitRouteSwapExactAmountInMsg(clientCtx, args[0], args[1], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSplitRoutes())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSplitRoutes)
+
+	return cmd
+}
+
 func NewSwapExactAmountOutCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "swap-exact-amount-out [token-out] [token-in-max-amount]",
@@ -215,6 +462,86 @@ func NewSwapExactAmountOutCmd() *cobra.Command {
 	return cmd
 }
 
+func NewSwapExactAmountInWithPriceImpactCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-amount-in-with-price-impact [token-in] [max-price-impact]",
+		Short: "swap exact amount in, bounded by the price impact of the swap",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap the token in along the route. The swap fails if its effective price is more than
+the max price impact above the spot price of the route before the swap, swap fees included.
+Example:
+$ %s tx gamm swap-exact-amount-in-with-price-impact 100uosmo 0.01 --swap-route-pool-ids=1 --swap-route-denoms=uion
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildSwapExactAmountInWithPriceImpactMsg(clientCtx, args[0], args[1], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetQuerySwapRoutes())
+	cmd.Flags().AddFlagSet(FlagSetMaxSpotPrice())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSwapRoutePoolIds)
+	_ = cmd.MarkFlagRequired(FlagSwapRouteDenoms)
+
+	return cmd
+}
+
+func NewSwapExactAmountOutWithPriceImpactCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-amount-out-with-price-impact [token-out] [max-price-impact]",
+		Short: "swap exact amount out, bounded by the price impact of the swap",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap for the token out along the route. The swap fails if its effective price is more than
+the max price impact above the spot price of the route before the swap, swap fees included.
+Example:
+$ %s tx gamm swap-exact-amount-out-with-price-impact 100uion 0.01 --swap-route-pool-ids=1 --swap-route-denoms=uosmo
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildSwapExactAmountOutWithPriceImpactMsg(clientCtx, args[0], args[1], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSwapAmountOutRoutes())
+	cmd.Flags().AddFlagSet(FlagSetMaxSpotPrice())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSwapRoutePoolIds)
+	_ = cmd.MarkFlagRequired(FlagSwapRouteDenoms)
+
+	return cmd
+}
+
 func NewJoinSwapExternAmountIn() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "join-swap-extern-amount-in [token-in] [share-out-min-amount]",
@@ -331,19 +658,275 @@ func NewExitSwapShareAmountIn() *cobra.Command {
 	return cmd
 }
 
-func NewBuildCreatePoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+func NewSetPoolSwapFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-swap-fee [pool-id] [swap-fee]",
+		Short: "set the swap fee of a pool, as its future governor",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
 
-	pool, err := parseCreatePoolFlags(fs)
-	if err != nil {
-		return txf, nil, fmt.Errorf("failed to parse pool: %w", err)
-	}
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
 
-	deposit, err := sdk.ParseCoinsNormalized(pool.InitialDeposit)
-	if err != nil {
-		return txf, nil, err
-	}
+			txf, msg, err := NewBuildSetPoolSwapFeeMsg(clientCtx, args[0], args[1], txf)
+			if err != nil {
+				return err
+			}
 
-	poolAssetCoins, err := sdk.ParseDecCoins(pool.Weights)
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetPoolExitFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-exit-fee [pool-id] [exit-fee]",
+		Short: "set the exit fee of a pool, as its future governor",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildSetPoolExitFeeMsg(clientCtx, args[0], args[1], txf)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewScheduleWeightChangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-weight-change [pool-id] [target-weights] [duration]",
+		Short: "schedule a smooth change of the weights of a pool, as its future governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Schedule a smooth change of the weights of a pool, from its current weights to the target weights.
+Example:
+$ %s tx gamm schedule-weight-change 1 1uatom,4uosmo 72h --start-time 2021-12-01T00:00:00Z
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildScheduleWeightChangeMsg(clientCtx, args[0], args[1], args[2], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetScheduleWeightChange())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetPoolBatchModeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-batch-mode [pool-id] [enabled]",
+		Short: "queue the swaps on a pool to execute them together at the end of each block, as its future governor",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildSetPoolBatchModeMsg(clientCtx, args[0], args[1], txf)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewPlaceLimitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-limit-order [pool-id] [token-in] [token-out-denom] [trigger-price]",
+		Short: "sell token-in for token-out-denom on a pool once its spot price reaches trigger-price, in units of token-out-denom",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildPlaceLimitOrderMsg(clientCtx, args[0], args[1], args[2], args[3], txf)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCancelLimitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-limit-order [order-id]",
+		Short: "cancel a limit order that isn't filled yet, and get its tokens back",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildCancelLimitOrderMsg(clientCtx, args[0], txf)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewFlashSwapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flash-swap [pool-id] [token-out] [token-in-denom] [token-in-max-amount] [msgs-file]",
+		Short: "swap token-out out of a pool, execute gamm msgs with it, and then pay for it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap token-out out of a pool, execute the gamm msgs of a json file, and then pay for token-out.
+The msgs are swaps, joins and exits, signed by the sender. The file is of the form:
+
+{
+  "msgs": [
+    {
+      "@type": "/osmosis.gamm.v1beta1.MsgSwapExactAmountIn",
+      "sender": "osmo1...",
+      "routes": [{"poolId": "2", "tokenOutDenom": "uion"}],
+      "tokenIn": {"denom": "uatom", "amount": "1000"},
+      "tokenOutMinAmount": "1000"
+    }
+  ]
+}
+
+Example:
+$ %s tx gamm flash-swap 1 1000uatom uion 1100 msgs.json --from mykey
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildFlashSwapMsg(clientCtx, args[0], args[1], args[2], args[3], args[4], txf)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewFinalizeLBPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize-lbp [pool-id] [share-in-amount]",
+		Short: "withdraw the proceeds of a liquidity bootstrapping pool after its sale, as its creator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the proceeds of a liquidity bootstrapping pool after its sale, without exit fee.
+The pool is then closed, unless it's reopened as a regular pool with new weights, in which case
+the share amount can be zero.
+Example:
+$ %s tx gamm finalize-lbp 1 50000000000000000000 --reopen-weights 1uatom,1uosmo
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildFinalizeLBPMsg(clientCtx, args[0], args[1], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetFinalizeLBP())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewBuildCreatePoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+
+	pool, err := parseCreatePoolFlags(fs)
+	if err != nil {
+		return txf, nil, fmt.Errorf("failed to parse pool: %w", err)
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(pool.InitialDeposit)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	poolAssetCoins, err := sdk.ParseDecCoins(pool.Weights)
 	if err != nil {
 		return txf, nil, err
 	}
@@ -383,6 +966,12 @@ func NewBuildCreatePoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.Fl
 		},
 		PoolAssets:         poolAssets,
 		FuturePoolGovernor: pool.FutureGovernor,
+		ShareSymbol:        pool.ShareSymbol,
+	}
+
+	msg.FeeDenom, err = fs.GetString(FlagFeeDenom)
+	if err != nil {
+		return txf, nil, err
 	}
 
 	if (pool.SmoothWeightChangeParams != smoothWeightChangeParamsInputs{}) {
@@ -428,63 +1017,261 @@ func NewBuildCreatePoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.Fl
 		msg.PoolParams.SmoothWeightChangeParams = &smoothWeightParams
 	}
 
+	if (pool.LBPSale != lbpSaleInputs{}) {
+		saleEndTime, err := time.Parse(time.RFC3339, pool.LBPSale.SaleEndTime)
+		if err != nil {
+			return txf, nil, fmt.Errorf("could not parse sale end time: %w", err)
+		}
+
+		lbpParams := types.LBPParams{
+			SaleEndTime: saleEndTime,
+		}
+
+		if pool.LBPSale.SaleStartTime != "" {
+			saleStartTime, err := time.Parse(time.RFC3339, pool.LBPSale.SaleStartTime)
+			if err != nil {
+				return txf, nil, fmt.Errorf("could not parse sale start time: %w", err)
+			}
+
+			lbpParams.SaleStartTime = saleStartTime
+		}
+
+		msg.PoolParams.LbpParams = &lbpParams
+	}
+
 	return txf, msg, nil
 }
 
-func NewBuildJoinPoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
-	poolId, err := fs.GetUint64(FlagPoolId)
+func NewBuildCreateStableswapPoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	pool, err := parseCreateStableswapPoolFlags(fs)
 	if err != nil {
-		return txf, nil, err
+		return txf, nil, fmt.Errorf("failed to parse pool: %w", err)
 	}
 
-	shareAmountOutStr, err := fs.GetString(FlagShareAmountOut)
+	deposit, err := sdk.ParseCoinsNormalized(pool.InitialDeposit)
 	if err != nil {
 		return txf, nil, err
 	}
 
-	shareAmountOut, ok := sdk.NewIntFromString(shareAmountOutStr)
-	if !ok {
-		return txf, nil, fmt.Errorf("invalid share amount out")
+	swapFee, err := sdk.NewDecFromStr(pool.SwapFee)
+	if err != nil {
+		return txf, nil, err
 	}
 
-	maxAmountsInStrs, err := fs.GetStringArray(FlagMaxAmountsIn)
+	exitFee, err := sdk.NewDecFromStr(pool.ExitFee)
 	if err != nil {
 		return txf, nil, err
 	}
 
-	maxAmountsIn := sdk.Coins{}
-	for i := 0; i < len(maxAmountsInStrs); i++ {
-		parsed, err := sdk.ParseCoinNormalized(maxAmountsInStrs[i])
-		if err != nil {
-			return txf, nil, err
-		}
-		maxAmountsIn = append(maxAmountsIn, parsed)
+	amplificationParameter, err := strconv.ParseUint(pool.AmplificationParameter, 10, 64)
+	if err != nil {
+		return txf, nil, fmt.Errorf("could not parse amplification parameter: %w", err)
 	}
 
-	msg := &types.MsgJoinPool{
-		Sender:         clientCtx.GetFromAddress().String(),
-		PoolId:         poolId,
-		ShareOutAmount: shareAmountOut,
-		TokenInMaxs:    maxAmountsIn,
+	msg := &types.MsgCreateStableswapPool{
+		Sender: clientCtx.GetFromAddress().String(),
+		PoolParams: types.StableswapPoolParams{
+			SwapFee: swapFee,
+			ExitFee: exitFee,
+		},
+		InitialPoolLiquidity:   deposit,
+		AmplificationParameter: amplificationParameter,
+		FuturePoolGovernor:     pool.FutureGovernor,
+	}
+
+	msg.FeeDenom, err = fs.GetString(FlagFeeDenom)
+	if err != nil {
+		return txf, nil, err
 	}
 
 	return txf, msg, nil
 }
 
-func NewBuildExitPoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
-	poolId, err := fs.GetUint64(FlagPoolId)
+func NewBuildCreateConcentratedPoolMsg(clientCtx client.Context, args []string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	tickSpacing, err := strconv.ParseUint(args[2], 10, 64)
 	if err != nil {
 		return txf, nil, err
 	}
 
-	shareAmountInStr, err := fs.GetString(FlagShareAmountIn)
+	initialPrice, err := sdk.NewDecFromStr(args[3])
 	if err != nil {
 		return txf, nil, err
 	}
 
-	shareAmountIn, ok := sdk.NewIntFromString(shareAmountInStr)
-	if !ok {
-		return txf, nil, fmt.Errorf("invalid share amount in")
+	swapFeeStr, err := fs.GetString(FlagSwapFee)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	swapFee, err := sdk.NewDecFromStr(swapFeeStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	futureGovernor, err := fs.GetString(FlagFutureGovernor)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	feeDenom, err := fs.GetString(FlagFeeDenom)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &types.MsgCreateConcentratedPool{
+		Sender: clientCtx.GetFromAddress().String(),
+		PoolParams: types.ConcentratedPoolParams{
+			SwapFee: swapFee,
+		},
+		Denom0:             args[0],
+		Denom1:             args[1],
+		TickSpacing:        tickSpacing,
+		InitialPrice:       initialPrice,
+		FuturePoolGovernor: futureGovernor,
+		FeeDenom:           feeDenom,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildCreatePositionMsg(clientCtx client.Context, args []string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	poolId, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	lowerTick, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	upperTick, err := strconv.ParseInt(args[2], 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	amounts := make([]sdk.Int, 4)
+	for i, amountStr := range args[3:5] {
+		amount, ok := sdk.NewIntFromString(amountStr)
+		if !ok {
+			return txf, nil, fmt.Errorf("invalid token desired amount: %s", amountStr)
+		}
+		amounts[i] = amount
+	}
+	for i, flagName := range []string{FlagMinAmount0, FlagMinAmount1} {
+		amountStr, err := fs.GetString(flagName)
+		if err != nil {
+			return txf, nil, err
+		}
+		amount, ok := sdk.NewIntFromString(amountStr)
+		if !ok {
+			return txf, nil, fmt.Errorf("invalid %s: %s", flagName, amountStr)
+		}
+		amounts[2+i] = amount
+	}
+
+	msg := &types.MsgCreatePosition{
+		Sender:          clientCtx.GetFromAddress().String(),
+		PoolId:          poolId,
+		LowerTick:       lowerTick,
+		UpperTick:       upperTick,
+		TokenDesired0:   amounts[0],
+		TokenDesired1:   amounts[1],
+		TokenMinAmount0: amounts[2],
+		TokenMinAmount1: amounts[3],
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildWithdrawPositionMsg(clientCtx client.Context, positionIdStr, liquidityStr string, txf tx.Factory) (tx.Factory, sdk.Msg, error) {
+	positionId, err := strconv.ParseUint(positionIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	liquidity, err := sdk.NewDecFromStr(liquidityStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &types.MsgWithdrawPosition{
+		Sender:     clientCtx.GetFromAddress().String(),
+		PositionId: positionId,
+		Liquidity:  liquidity,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildCollectFeesMsg(clientCtx client.Context, positionIdStr string, txf tx.Factory) (tx.Factory, sdk.Msg, error) {
+	positionId, err := strconv.ParseUint(positionIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &types.MsgCollectFees{
+		Sender:     clientCtx.GetFromAddress().String(),
+		PositionId: positionId,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildJoinPoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	poolId, err := fs.GetUint64(FlagPoolId)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	shareAmountOutStr, err := fs.GetString(FlagShareAmountOut)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	shareAmountOut, ok := sdk.NewIntFromString(shareAmountOutStr)
+	if !ok {
+		return txf, nil, fmt.Errorf("invalid share amount out")
+	}
+
+	maxAmountsInStrs, err := fs.GetStringArray(FlagMaxAmountsIn)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	maxAmountsIn := sdk.Coins{}
+	for i := 0; i < len(maxAmountsInStrs); i++ {
+		parsed, err := sdk.ParseCoinNormalized(maxAmountsInStrs[i])
+		if err != nil {
+			return txf, nil, err
+		}
+		maxAmountsIn = append(maxAmountsIn, parsed)
+	}
+
+	msg := &types.MsgJoinPool{
+		Sender:         clientCtx.GetFromAddress().String(),
+		PoolId:         poolId,
+		ShareOutAmount: shareAmountOut,
+		TokenInMaxs:    maxAmountsIn,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildExitPoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	poolId, err := fs.GetUint64(FlagPoolId)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	shareAmountInStr, err := fs.GetString(FlagShareAmountIn)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	shareAmountIn, ok := sdk.NewIntFromString(shareAmountInStr)
+	if !ok {
+		return txf, nil, fmt.Errorf("invalid share amount in")
 	}
 
 	minAmountsOutStrs, err := fs.GetStringArray(FlagMinAmountsOut)
@@ -540,6 +1327,46 @@ func swapAmountInRoutes(fs *flag.FlagSet) ([]types.SwapAmountInRoute, error) {
 	return routes, nil
 }
 
+// splitRoutes parses the split routes, formatted as <token-in-amount>=<pool-id>:<token-out-denom>,...
+func splitRoutes(fs *flag.FlagSet) ([]types.SwapAmountInSplitRoute, error) {
+	splitRouteStrs, err := fs.GetStringArray(FlagSplitRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := []types.SwapAmountInSplitRoute{}
+	for _, splitRouteStr := range splitRouteStrs {
+		parts := strings.Split(splitRouteStr, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid split route %s, expected <token-in-amount>=<pool-id>:<token-out-denom>,...", splitRouteStr)
+		}
+
+		tokenInAmount, ok := sdk.NewIntFromString(parts[0])
+		if !ok {
+			return nil, fmt.Errorf("invalid token in amount %s", parts[0])
+		}
+
+		route := types.SwapAmountInSplitRoute{TokenInAmount: tokenInAmount}
+		for _, hopStr := range strings.Split(parts[1], ",") {
+			hop := strings.Split(hopStr, ":")
+			if len(hop) != 2 {
+				return nil, fmt.Errorf("invalid route %s, expected <pool-id>:<token-out-denom>", hopStr)
+			}
+
+			poolID, err := strconv.ParseUint(hop[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			route.Pools = append(route.Pools, types.SwapAmountInRoute{
+				PoolId:        poolID,
+				TokenOutDenom: hop[1],
+			})
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
 func swapAmountOutRoutes(fs *flag.FlagSet) ([]types.SwapAmountOutRoute, error) {
 	swapRoutePoolIds, err := fs.GetStringArray(FlagSwapRoutePoolIds)
 	if err != nil {
@@ -594,6 +1421,26 @@ func NewBuildSwapExactAmountInMsg(clientCtx client.Context, tokenInStr, tokenOut
 	return txf, msg, nil
 }
 
+func NewBuildSplitRouteSwapExactAmountInMsg(clientCtx client.Context, tokenInDenom, tokenOutMinAmtStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	routes, err := splitRoutes(fs)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tokenOutMinAmt, ok := sdk.NewIntFromString(tokenOutMinAmtStr)
+	if !ok {
+		return txf, nil, errors.New("invalid token out min amount")
+	}
+	msg := &types.MsgSplitRouteSwapExactAmountIn{
+		Sender:            clientCtx.GetFromAddress().String(),
+		Routes:            routes,
+		TokenInDenom:      tokenInDenom,
+		TokenOutMinAmount: tokenOutMinAmt,
+	}
+
+	return txf, msg, nil
+}
+
 func NewBuildSwapExactAmountOutMsg(clientCtx client.Context, tokenOutStr, tokenInMaxAmountStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
 	routes, err := swapAmountOutRoutes(fs)
 	if err != nil {
@@ -619,6 +1466,79 @@ func NewBuildSwapExactAmountOutMsg(clientCtx client.Context, tokenOutStr, tokenI
 	return txf, msg, nil
 }
 
+func NewBuildSwapExactAmountInWithPriceImpactMsg(clientCtx client.Context, tokenInStr, maxPriceImpactStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	routes, err := swapAmountInRoutes(fs)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(tokenInStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	maxPriceImpact, maxSpotPrice, err := parsePriceImpactLimits(maxPriceImpactStr, fs)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &types.MsgSwapExactAmountInWithPriceImpact{
+		Sender:         clientCtx.GetFromAddress().String(),
+		Routes:         routes,
+		TokenIn:        tokenIn,
+		MaxPriceImpact: maxPriceImpact,
+		MaxSpotPrice:   maxSpotPrice,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildSwapExactAmountOutWithPriceImpactMsg(clientCtx client.Context, tokenOutStr, maxPriceImpactStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	routes, err := swapAmountOutRoutes(fs)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tokenOut, err := sdk.ParseCoinNormalized(tokenOutStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	maxPriceImpact, maxSpotPrice, err := parsePriceImpactLimits(maxPriceImpactStr, fs)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &types.MsgSwapExactAmountOutWithPriceImpact{
+		Sender:         clientCtx.GetFromAddress().String(),
+		Routes:         routes,
+		TokenOut:       tokenOut,
+		MaxPriceImpact: maxPriceImpact,
+		MaxSpotPrice:   maxSpotPrice,
+	}
+
+	return txf, msg, nil
+}
+
+// parsePriceImpactLimits parses the max price impact of a swap, and its max spot price from the flags.
+func parsePriceImpactLimits(maxPriceImpactStr string, fs *flag.FlagSet) (sdk.Dec, sdk.Dec, error) {
+	maxPriceImpact, err := sdk.NewDecFromStr(maxPriceImpactStr)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("invalid max price impact: %w", err)
+	}
+
+	maxSpotPriceStr, err := fs.GetString(FlagMaxSpotPrice)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	maxSpotPrice, err := sdk.NewDecFromStr(maxSpotPriceStr)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("invalid max spot price: %w", err)
+	}
+
+	return maxPriceImpact, maxSpotPrice, nil
+}
+
 func NewBuildJoinSwapExternAmountInMsg(clientCtx client.Context, tokenInStr, shareOutMinAmountStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
 	poolID, err := fs.GetUint64(FlagPoolId)
 	if err != nil {
@@ -723,3 +1643,423 @@ func NewBuildExitSwapShareAmountInMsg(clientCtx client.Context, tokenOutDenom, s
 
 	return txf, msg, nil
 }
+
+func NewBuildSetPoolSwapFeeMsg(clientCtx client.Context, poolIdStr, swapFeeStr string, txf tx.Factory) (tx.Factory, sdk.Msg, error) {
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	swapFee, err := sdk.NewDecFromStr(swapFeeStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &types.MsgSetPoolSwapFee{
+		Sender:  clientCtx.GetFromAddress().String(),
+		PoolId:  poolId,
+		SwapFee: swapFee,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildSetPoolExitFeeMsg(clientCtx client.Context, poolIdStr, exitFeeStr string, txf tx.Factory) (tx.Factory, sdk.Msg, error) {
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	exitFee, err := sdk.NewDecFromStr(exitFeeStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &types.MsgSetPoolExitFee{
+		Sender:  clientCtx.GetFromAddress().String(),
+		PoolId:  poolId,
+		ExitFee: exitFee,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildScheduleWeightChangeMsg(clientCtx client.Context, poolIdStr, targetWeightsStr, durationStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	targetWeightCoins, err := sdk.ParseDecCoins(targetWeightsStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	targetWeights := []types.PoolAsset{}
+	for _, weight := range targetWeightCoins {
+		targetWeights = append(targetWeights, types.PoolAsset{
+			Weight: weight.Amount.RoundInt(),
+			Token:  sdk.NewCoin(weight.Denom, sdk.ZeroInt()),
+		})
+	}
+
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return txf, nil, fmt.Errorf("could not parse duration: %w", err)
+	}
+
+	msg := &types.MsgScheduleWeightChange{
+		Sender:            clientCtx.GetFromAddress().String(),
+		PoolId:            poolId,
+		Duration:          duration,
+		TargetPoolWeights: targetWeights,
+	}
+
+	startTimeStr, err := fs.GetString(FlagStartTime)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	if startTimeStr != "" {
+		startTime, err := time.Parse(time.RFC3339, startTimeStr)
+		if err != nil {
+			return txf, nil, fmt.Errorf("could not parse time: %w", err)
+		}
+
+		msg.StartTime = startTime
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildSetPoolBatchModeMsg(clientCtx client.Context, poolIdStr, enabledStr string, txf tx.Factory) (tx.Factory, sdk.Msg, error) {
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	enabled, err := strconv.ParseBool(enabledStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &types.MsgSetPoolBatchMode{
+		Sender:  clientCtx.GetFromAddress().String(),
+		PoolId:  poolId,
+		Enabled: enabled,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildPlaceLimitOrderMsg(clientCtx client.Context, poolIdStr, tokenInStr, tokenOutDenom, triggerPriceStr string, txf tx.Factory) (tx.Factory, sdk.Msg, error) {
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(tokenInStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	triggerPrice, err := sdk.NewDecFromStr(triggerPriceStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &types.MsgPlaceLimitOrder{
+		Sender:        clientCtx.GetFromAddress().String(),
+		PoolId:        poolId,
+		TokenIn:       tokenIn,
+		TokenOutDenom: tokenOutDenom,
+		TriggerPrice:  triggerPrice,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildCancelLimitOrderMsg(clientCtx client.Context, orderIdStr string, txf tx.Factory) (tx.Factory, sdk.Msg, error) {
+	orderId, err := strconv.ParseUint(orderIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &types.MsgCancelLimitOrder{
+		Sender:  clientCtx.GetFromAddress().String(),
+		OrderId: orderId,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildFlashSwapMsg(clientCtx client.Context, poolIdStr, tokenOutStr, tokenInDenom, tokenInMaxAmountStr, msgsFile string, txf tx.Factory) (tx.Factory, sdk.Msg, error) {
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tokenOut, err := sdk.ParseCoinNormalized(tokenOutStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tokenInMaxAmount, ok := sdk.NewIntFromString(tokenInMaxAmountStr)
+	if !ok {
+		return txf, nil, errors.New("invalid token in max amount")
+	}
+
+	msg, err := parseFlashSwapMsgsFile(clientCtx.JSONMarshaler, msgsFile)
+	if err != nil {
+		return txf, nil, err
+	}
+	msg.Sender = clientCtx.GetFromAddress().String()
+	msg.PoolId = poolId
+	msg.TokenInDenom = tokenInDenom
+	msg.TokenInMaxAmount = tokenInMaxAmount
+	msg.TokenOut = tokenOut
+
+	return txf, msg, nil
+}
+
+func NewMigratePoolSharesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-pool-shares [pool-id]",
+		Short: "replace the shares of a migrated pool by shares of the pool its liquidity was moved to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildMigratePoolSharesMsg(clientCtx, args[0], txf)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewBuildFinalizeLBPMsg(clientCtx client.Context, poolIdStr, shareInAmountStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	shareInAmount, ok := sdk.NewIntFromString(shareInAmountStr)
+	if !ok {
+		return txf, nil, errors.New("invalid share in amount")
+	}
+
+	reopenWeightsStr, err := fs.GetString(FlagReopenWeights)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	reopenWeights := []types.PoolAsset{}
+	if reopenWeightsStr != "" {
+		reopenWeightCoins, err := sdk.ParseDecCoins(reopenWeightsStr)
+		if err != nil {
+			return txf, nil, err
+		}
+
+		for _, weight := range reopenWeightCoins {
+			reopenWeights = append(reopenWeights, types.PoolAsset{
+				Weight: weight.Amount.RoundInt(),
+				Token:  sdk.NewCoin(weight.Denom, sdk.ZeroInt()),
+			})
+		}
+	}
+
+	msg := &types.MsgFinalizeLBP{
+		Sender:            clientCtx.GetFromAddress().String(),
+		PoolId:            poolId,
+		ShareInAmount:     shareInAmount,
+		ReopenPoolWeights: reopenWeights,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildMigratePoolSharesMsg(clientCtx client.Context, poolIdStr string, txf tx.Factory) (tx.Factory, sdk.Msg, error) {
+	poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &types.MsgMigratePoolShares{
+		Sender: clientCtx.GetFromAddress().String(),
+		PoolId: poolId,
+	}
+
+	return txf, msg, nil
+}
+
+func NewCmdSubmitSetPoolStatusProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pool-status [pool-id] [status]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to pause, reactivate or deprecate a pool",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s tx gov submit-proposal set-pool-status 1 PoolPaused --title="pause pool 1" --description="..." --deposit=10000000uosmo`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			status, ok := types.PoolStatus_value[args[1]]
+			if !ok {
+				return fmt.Errorf("unknown pool status %s", args[1])
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetPoolStatusProposal(title, description, poolId, types.PoolStatus(status))
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func NewCmdSubmitMigratePoolProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-pool [pool-id] [weights] [swap-fee] [exit-fee]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit a proposal to migrate the liquidity of a pool to a new balancer pool",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s tx gov submit-proposal migrate-pool 1 1uatom,1uosmo 0.002 0 --title="migrate pool 1" --description="..." --deposit=10000000uosmo`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			weights, err := sdk.ParseDecCoins(args[1])
+			if err != nil {
+				return err
+			}
+			var poolAssets []types.PoolAsset
+			for _, weight := range weights {
+				poolAssets = append(poolAssets, types.PoolAsset{
+					Weight: weight.Amount.RoundInt(),
+					Token:  sdk.NewCoin(weight.Denom, sdk.ZeroInt()),
+				})
+			}
+
+			swapFee, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			exitFee, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			futureGovernor, err := cmd.Flags().GetString(FlagFutureGovernor)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			poolParams := types.BalancerPoolParams{
+				SwapFee: swapFee,
+				ExitFee: exitFee,
+			}
+			content := types.NewMigratePoolProposal(title, description, poolId, poolParams, poolAssets, futureGovernor)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagFutureGovernor, "", "future governor of the new pool")
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+}
+
+func parseProposalFlags(fs *flag.FlagSet) (title string, description string, deposit sdk.Coins, err error) {
+	title, err = fs.GetString(govcli.FlagTitle)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	description, err = fs.GetString(govcli.FlagDescription)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	depositStr, err := fs.GetString(govcli.FlagDeposit)
+	if err != nil {
+		return "", "", nil, err
+	}
+	deposit, err = sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	return title, description, deposit, nil
+}
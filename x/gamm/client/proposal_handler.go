@@ -0,0 +1,12 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/osmosis-labs/osmosis/x/gamm/client/cli"
+	"github.com/osmosis-labs/osmosis/x/gamm/client/rest"
+)
+
+var (
+	SetPoolStatusHandler = govclient.NewProposalHandler(cli.NewCmdSubmitSetPoolStatusProposal, rest.ProposalSetPoolStatusRESTHandler)
+	MigratePoolHandler   = govclient.NewProposalHandler(cli.NewCmdSubmitMigratePoolProposal, rest.ProposalMigratePoolRESTHandler)
+)
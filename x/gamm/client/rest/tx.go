@@ -1 +1,112 @@
 package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+type SetPoolStatusRequest struct {
+	BaseReq     rest.BaseReq     `json:"base_req" yaml:"base_req"`
+	Title       string           `json:"title" yaml:"title"`
+	Description string           `json:"description" yaml:"description"`
+	Deposit     sdk.Coins        `json:"deposit" yaml:"deposit"`
+	PoolId      uint64           `json:"pool_id" yaml:"pool_id"`
+	Status      types.PoolStatus `json:"status" yaml:"status"`
+}
+
+func ProposalSetPoolStatusRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set-pool-status",
+		Handler:  newSetPoolStatusHandler(clientCtx),
+	}
+}
+
+func newSetPoolStatusHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetPoolStatusRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewSetPoolStatusProposal(req.Title, req.Description, req.PoolId, req.Status)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type MigratePoolRequest struct {
+	BaseReq            rest.BaseReq             `json:"base_req" yaml:"base_req"`
+	Title              string                   `json:"title" yaml:"title"`
+	Description        string                   `json:"description" yaml:"description"`
+	Deposit            sdk.Coins                `json:"deposit" yaml:"deposit"`
+	PoolId             uint64                   `json:"pool_id" yaml:"pool_id"`
+	PoolParams         types.BalancerPoolParams `json:"pool_params" yaml:"pool_params"`
+	PoolAssets         []types.PoolAsset        `json:"pool_assets" yaml:"pool_assets"`
+	FuturePoolGovernor string                   `json:"future_pool_governor" yaml:"future_pool_governor"`
+}
+
+func ProposalMigratePoolRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "migrate-pool",
+		Handler:  newMigratePoolHandler(clientCtx),
+	}
+}
+
+func newMigratePoolHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MigratePoolRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewMigratePoolProposal(
+			req.Title, req.Description, req.PoolId, req.PoolParams, req.PoolAssets, req.FuturePoolGovernor,
+		)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -12,6 +12,10 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState, unpacker codectypes.AnyUnpacker) {
+	// Genesis files exported before the protocol fee share existed don't divert swap fees.
+	if genState.Params.ProtocolFeeShare.IsNil() {
+		genState.Params.ProtocolFeeShare = sdk.ZeroDec()
+	}
 	k.SetParams(ctx, genState.Params)
 	k.SetNextPoolNumber(ctx, genState.NextPoolNumber)
 
@@ -34,6 +38,49 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState,
 	}
 
 	k.SetTotalLiquidity(ctx, liquidity)
+	k.SetProtocolFees(ctx, genState.ProtocolFees)
+
+	// Historical records are ordered by time within each asset pair,
+	// so the most recent record of each pair is set last.
+	for _, record := range genState.TwapRecords {
+		k.SetTwapRecord(ctx, record)
+	}
+
+	for _, position := range genState.Positions {
+		k.SetPosition(ctx, position)
+	}
+	// Genesis files exported before positions existed have no next position id,
+	// in which case ids start at 1.
+	if genState.NextPositionId != 0 {
+		k.SetNextPositionId(ctx, genState.NextPositionId)
+	}
+
+	for _, poolId := range genState.BatchModePoolIds {
+		k.SetBatchModePool(ctx, poolId, true)
+	}
+
+	for _, order := range genState.LimitOrders {
+		k.SetLimitOrder(ctx, order)
+	}
+	if genState.NextLimitOrderId != 0 {
+		k.SetNextLimitOrderId(ctx, genState.NextLimitOrderId)
+	}
+
+	for _, stats := range genState.PoolStats {
+		k.SetPoolStats(ctx, stats)
+	}
+
+	for _, record := range genState.PoolStatuses {
+		k.SetPoolStatusRecord(ctx, record)
+	}
+
+	for _, record := range genState.PoolCreationFeeRecords {
+		k.SetPoolCreationFeeRecord(ctx, record)
+	}
+
+	for _, tick := range genState.Ticks {
+		k.SetTick(ctx, tick)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -44,19 +91,41 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 	poolAnys := []*codectypes.Any{}
 	for _, poolI := range pools {
-		pool, ok := poolI.(*types.BalancerPool)
-		if !ok {
-			panic(fmt.Errorf("pool (%d) is not basic pool", poolI.GetId()))
+		switch poolI.(type) {
+		case *types.BalancerPool, *types.StableswapPool, *types.ConcentratedPool:
+		default:
+			panic(fmt.Errorf("pool (%d) is of an unknown pool type", poolI.GetId()))
 		}
-		any, err := codectypes.NewAnyWithValue(pool)
+		any, err := codectypes.NewAnyWithValue(poolI)
 		if err != nil {
 			panic(err)
 		}
 		poolAnys = append(poolAnys, any)
 	}
+	positions, err := k.GetAllPositions(ctx)
+	if err != nil {
+		panic(err)
+	}
+	limitOrders, err := k.GetAllLimitOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
 	return &types.GenesisState{
 		NextPoolNumber: k.GetNextPoolNumberAndIncrement(ctx),
 		Pools:          poolAnys,
 		Params:         k.GetParams(ctx),
+		TwapRecords:    k.GetAllHistoricalTwapRecords(ctx),
+		Positions:      positions,
+		NextPositionId: k.GetNextPositionIdAndIncrement(ctx),
+		ProtocolFees:   k.GetProtocolFees(ctx),
+		// Queued swaps are executed at the end of every block, so there are none to export.
+		BatchModePoolIds: k.GetBatchModePoolIds(ctx),
+		LimitOrders:      limitOrders,
+		NextLimitOrderId: k.GetNextLimitOrderIdAndIncrement(ctx),
+		PoolStats:        k.GetAllPoolStats(ctx),
+		PoolStatuses:     k.GetPoolStatusRecords(ctx),
+
+		PoolCreationFeeRecords: k.GetPoolCreationFeeRecords(ctx),
+		Ticks:                  k.GetAllTicks(ctx),
 	}
 }
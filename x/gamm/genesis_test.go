@@ -44,7 +44,8 @@ func TestGammInitGenesis(t *testing.T) {
 		Pools:          []*codectypes.Any{any},
 		NextPoolNumber: 2,
 		Params: types.Params{
-			PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)},
+			PoolCreationFee:      sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)},
+			StatsEpochIdentifier: "day",
 		},
 	}, app.AppCodec())
 
@@ -106,6 +107,7 @@ func TestGammExportGenesis(t *testing.T) {
 	genesis := gamm.ExportGenesis(ctx, app.GAMMKeeper)
 	require.Equal(t, genesis.NextPoolNumber, uint64(3))
 	require.Len(t, genesis.Pools, 2)
+	require.Len(t, genesis.TwapRecords, 2)
 }
 
 func TestMarshalUnmarshalGenesis(t *testing.T) {
@@ -140,5 +142,9 @@ func TestMarshalUnmarshalGenesis(t *testing.T) {
 		ctx := app.BaseApp.NewContext(false, tmproto.Header{})
 		am := gamm.NewAppModule(appCodec, app.GAMMKeeper, app.AccountKeeper, app.BankKeeper)
 		am.InitGenesis(ctx, appCodec, genesis)
+
+		record, err := app.GAMMKeeper.GetMostRecentTwapRecord(ctx, 1, "bar", "foo")
+		require.NoError(t, err)
+		require.Equal(t, sdk.OneDec(), record.P0LastSpotPrice)
 	})
 }
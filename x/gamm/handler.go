@@ -3,6 +3,7 @@ package gamm
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/osmosis-labs/osmosis/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/x/gamm/types"
 )
@@ -27,10 +28,34 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.CreateBalancerPool(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgCreateStableswapPool:
+			res, err := msgServer.CreateStableswapPool(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCreateConcentratedPool:
+			res, err := msgServer.CreateConcentratedPool(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCreatePosition:
+			res, err := msgServer.CreatePosition(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgWithdrawPosition:
+			res, err := msgServer.WithdrawPosition(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCollectFees:
+			res, err := msgServer.CollectFees(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgSwapExactAmountIn:
 			res, err := msgServer.SwapExactAmountIn(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSplitRouteSwapExactAmountIn:
+			res, err := msgServer.SplitRouteSwapExactAmountIn(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgSwapExactAmountOut:
 			res, err := msgServer.SwapExactAmountOut(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -51,8 +76,67 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.ExitSwapShareAmountIn(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSetPoolSwapFee:
+			res, err := msgServer.SetPoolSwapFee(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetPoolExitFee:
+			res, err := msgServer.SetPoolExitFee(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgScheduleWeightChange:
+			res, err := msgServer.ScheduleWeightChange(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetPoolBatchMode:
+			res, err := msgServer.SetPoolBatchMode(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgPlaceLimitOrder:
+			res, err := msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCancelLimitOrder:
+			res, err := msgServer.CancelLimitOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgFlashSwap:
+			res, err := msgServer.FlashSwap(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgFinalizeLBP:
+			res, err := msgServer.FinalizeLBP(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgMigratePoolShares:
+			res, err := msgServer.MigratePoolShares(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSwapExactAmountInWithPriceImpact:
+			res, err := msgServer.SwapExactAmountInWithPriceImpact(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSwapExactAmountOutWithPriceImpact:
+			res, err := msgServer.SwapExactAmountOutWithPriceImpact(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
 	}
 }
+
+// NewPoolLifecycleProposalHandler returns a handler for the gov proposals changing the status of pools.
+func NewPoolLifecycleProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetPoolStatusProposal:
+			return k.HandleSetPoolStatusProposal(ctx, c)
+		case *types.MigratePoolProposal:
+			return k.HandleMigratePoolProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gamm proposal content type: %T", c)
+		}
+	}
+}
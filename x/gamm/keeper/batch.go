@@ -0,0 +1,341 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+// SetPoolBatchMode turns the batch mode of a pool on or off, on behalf of its governor.
+// Swaps already queued on the pool are still executed at the end of the block.
+func (k Keeper) SetPoolBatchMode(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enabled bool) error {
+	_, err := k.getGovernedPool(ctx, sender, poolId)
+	if err != nil {
+		return err
+	}
+
+	k.SetBatchModePool(ctx, poolId, enabled)
+	return nil
+}
+
+func (k Keeper) SetBatchModePool(ctx sdk.Context, poolId uint64, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enabled {
+		store.Set(types.GetKeyBatchModePool(poolId), []byte{})
+	} else {
+		store.Delete(types.GetKeyBatchModePool(poolId))
+	}
+}
+
+// IsBatchModePool returns whether the swaps on the pool are queued, and executed together at the end of the block.
+func (k Keeper) IsBatchModePool(ctx sdk.Context, poolId uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetKeyBatchModePool(poolId))
+}
+
+// GetBatchModePoolIds returns the ids of the pools in batch mode, in increasing order.
+func (k Keeper) GetBatchModePoolIds(ctx sdk.Context) []uint64 {
+	iter := k.iterator(ctx, types.KeyPrefixBatchModePools)
+	defer iter.Close()
+
+	poolIds := []uint64{}
+	for ; iter.Valid(); iter.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixBatchModePools):]))
+	}
+	return poolIds
+}
+
+// QueueSwap escrows tokenIn, and queues the swap on a pool in batch mode, to be executed at the end of the block.
+// For exact in swaps, tokenIn is swapped for at least tokenOut.
+// For exact out swaps, tokenOut is swapped for at most tokenIn, and the rest of tokenIn is refunded.
+func (k Keeper) QueueSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokenIn, tokenOut sdk.Coin, exactOut bool) (uint64, error) {
+	if tokenIn.Denom == tokenOut.Denom {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidBatchSwap, "cannot trade same denomination in and out")
+	}
+	if !tokenIn.IsPositive() || tokenOut.IsNegative() || (exactOut && !tokenOut.IsPositive()) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidBatchSwap, "invalid swap of %s for %s", tokenIn, tokenOut)
+	}
+
+	if !k.IsBatchModePool(ctx, poolId) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidBatchSwap, "pool %d is not in batch mode", poolId)
+	}
+	if err := k.checkPoolOpen(ctx, poolId); err != nil {
+		return 0, err
+	}
+
+	pool, _, _, err := k.getPoolAndInOutAssets(ctx, poolId, tokenIn.Denom, tokenOut.Denom)
+	if err != nil {
+		return 0, err
+	}
+
+	if !pool.IsActive(ctx.BlockTime()) {
+		return 0, sdkerrors.Wrapf(types.ErrPoolLocked, "swap on inactive pool")
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.Coins{tokenIn})
+	if err != nil {
+		return 0, err
+	}
+
+	swap := types.QueuedSwap{
+		Id:       k.GetNextQueuedSwapIdAndIncrement(ctx),
+		PoolId:   poolId,
+		Sender:   sender.String(),
+		TokenIn:  tokenIn,
+		TokenOut: tokenOut,
+		ExactOut: exactOut,
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyQueuedSwap(poolId, swap.Id), k.cdc.MustMarshalBinaryBare(&swap))
+
+	return swap.Id, nil
+}
+
+// SetNextQueuedSwapId sets next queued swap id
+func (k Keeper) SetNextQueuedSwapId(ctx sdk.Context, swapId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&gogotypes.UInt64Value{Value: swapId})
+	store.Set(types.KeyNextQueuedSwapId, bz)
+}
+
+// GetNextQueuedSwapIdAndIncrement returns the next queued swap id, and increments the corresponding state entry.
+// Queued swap ids start at 1.
+func (k Keeper) GetNextQueuedSwapIdAndIncrement(ctx sdk.Context) uint64 {
+	swapId := uint64(1)
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyNextQueuedSwapId)
+	if bz != nil {
+		val := gogotypes.UInt64Value{}
+
+		err := k.cdc.UnmarshalBinaryBare(bz, &val)
+		if err != nil {
+			panic(err)
+		}
+
+		swapId = val.GetValue()
+	}
+
+	k.SetNextQueuedSwapId(ctx, swapId+1)
+	return swapId
+}
+
+// GetQueuedSwaps returns the swaps queued on the pool, in the order they were queued.
+func (k Keeper) GetQueuedSwaps(ctx sdk.Context, poolId uint64) ([]types.QueuedSwap, error) {
+	iter := k.iterator(ctx, types.GetKeyPrefixQueuedSwaps(poolId))
+	defer iter.Close()
+
+	swaps := []types.QueuedSwap{}
+	for ; iter.Valid(); iter.Next() {
+		swap := types.QueuedSwap{}
+		err := k.cdc.UnmarshalBinaryBare(iter.Value(), &swap)
+		if err != nil {
+			return nil, err
+		}
+		swaps = append(swaps, swap)
+	}
+	return swaps, nil
+}
+
+func (k Keeper) deleteQueuedSwaps(ctx sdk.Context, poolId uint64) {
+	iter := k.iterator(ctx, types.GetKeyPrefixQueuedSwaps(poolId))
+	defer iter.Close()
+
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetBatchResult returns the result of the last batch of swaps executed on the pool.
+func (k Keeper) GetBatchResult(ctx sdk.Context, poolId uint64) (types.BatchResult, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyBatchResult(poolId))
+	if bz == nil {
+		return types.BatchResult{}, sdkerrors.Wrapf(types.ErrBatchResultMissing, "pool %d", poolId)
+	}
+
+	result := types.BatchResult{}
+	err := k.cdc.UnmarshalBinaryBare(bz, &result)
+	if err != nil {
+		return types.BatchResult{}, err
+	}
+	return result, nil
+}
+
+func (k Keeper) setBatchResult(ctx sdk.Context, result types.BatchResult) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyBatchResult(result.PoolId), k.cdc.MustMarshalBinaryBare(&result))
+}
+
+// executeBatches executes the swaps queued on every pool in batch mode.
+// A batch that fails is not executed, and its swaps are refunded.
+func (k Keeper) executeBatches(ctx sdk.Context) {
+	for _, poolId := range k.getQueuedPoolIds(ctx) {
+		swaps, err := k.GetQueuedSwaps(ctx, poolId)
+		if err != nil {
+			panic(err)
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		err = k.executeBatch(cacheCtx, poolId, swaps)
+		if err == nil {
+			write()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		} else {
+			k.Logger(ctx).Error("failed to execute batch of swaps", "pool_id", poolId, "error", err.Error())
+			k.refundQueuedSwaps(ctx, swaps)
+		}
+
+		k.deleteQueuedSwaps(ctx, poolId)
+	}
+}
+
+// getQueuedPoolIds returns the ids of the pools with queued swaps, in increasing order.
+// It includes pools taken out of batch mode since their swaps were queued.
+func (k Keeper) getQueuedPoolIds(ctx sdk.Context) []uint64 {
+	iter := k.iterator(ctx, types.KeyPrefixQueuedSwaps)
+	defer iter.Close()
+
+	poolIds := []uint64{}
+	for ; iter.Valid(); iter.Next() {
+		poolId := sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixQueuedSwaps) : len(types.KeyPrefixQueuedSwaps)+8])
+		if len(poolIds) == 0 || poolIds[len(poolIds)-1] != poolId {
+			poolIds = append(poolIds, poolId)
+		}
+	}
+	return poolIds
+}
+
+func (k Keeper) refundQueuedSwaps(ctx sdk.Context, swaps []types.QueuedSwap) {
+	for _, swap := range swaps {
+		sender, err := sdk.AccAddressFromBech32(swap.Sender)
+		if err != nil {
+			panic(err)
+		}
+
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.Coins{swap.TokenIn})
+		if err != nil {
+			panic(err)
+		}
+
+		k.createBatchSwapSettledEvent(ctx, swap.PoolId, types.BatchSwapResult{
+			QueuedSwapId: swap.Id,
+			Sender:       swap.Sender,
+			TokenIn:      sdk.NewCoin(swap.TokenIn.Denom, sdk.ZeroInt()),
+			TokenOut:     sdk.NewCoin(swap.TokenOut.Denom, sdk.ZeroInt()),
+			Refund:       swap.TokenIn,
+		})
+	}
+}
+
+// executeBatch executes the swaps queued on the pool, settles them from the escrowed tokens, and stores the result.
+func (k Keeper) executeBatch(ctx sdk.Context, poolId uint64, swaps []types.QueuedSwap) error {
+	pool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	// The swaps queued before the pool was paused are refunded.
+	if err := k.checkPoolOpen(ctx, poolId); err != nil {
+		return err
+	}
+
+	execution, err := types.ExecuteBatch(pool, swaps, k.GetParams(ctx).ProtocolFeeShare)
+	if err != nil {
+		return err
+	}
+
+	err = k.SetPool(ctx, pool)
+	if err != nil {
+		return err
+	}
+
+	if !execution.PoolTokensIn.Empty() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, pool.GetAddress(), execution.PoolTokensIn)
+		if err != nil {
+			return err
+		}
+	}
+	if !execution.PoolTokensOut.Empty() {
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, pool.GetAddress(), types.ModuleName, execution.PoolTokensOut)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The protocol's share of the swap fee goes from the pool to the community pool.
+	protocolFees := execution.ProtocolFees
+	if !protocolFees.Empty() {
+		err = k.distrKeeper.FundCommunityPool(ctx, protocolFees, pool.GetAddress())
+		if err != nil {
+			return err
+		}
+		k.RecordProtocolFees(ctx, protocolFees)
+	}
+
+	filledIn, filledOut, numFilled := sdk.Coins{}, sdk.Coins{}, uint64(0)
+	for _, result := range execution.Swaps {
+		sender, err := sdk.AccAddressFromBech32(result.Sender)
+		if err != nil {
+			return err
+		}
+
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(result.TokenOut, result.Refund))
+		if err != nil {
+			return err
+		}
+
+		if result.Filled {
+			tokensIn := sdk.Coins{result.TokenIn}
+			tokensOut := sdk.Coins{result.TokenOut}
+			k.createSwapEvent(ctx, sender, poolId, tokensIn, tokensOut)
+			k.hooks.AfterSwap(ctx, sender, poolId, tokensIn, tokensOut)
+			filledIn = filledIn.Add(tokensIn...)
+			filledOut = filledOut.Add(tokensOut...)
+			numFilled++
+		}
+		k.createBatchSwapSettledEvent(ctx, poolId, result)
+	}
+	if numFilled > 0 {
+		k.recordSwapStats(ctx, poolId, filledIn, filledOut, execution.SwapFees, numFilled)
+	}
+
+	k.trackChangedPool(ctx, poolId)
+	k.RecordTotalLiquidityIncrease(ctx, execution.PoolTokensIn)
+	k.RecordTotalLiquidityDecrease(ctx, execution.PoolTokensOut.Add(protocolFees...))
+
+	k.setBatchResult(ctx, types.BatchResult{
+		PoolId:         poolId,
+		Height:         ctx.BlockHeight(),
+		ClearingPrices: execution.ClearingPrices,
+		Swaps:          execution.Swaps,
+	})
+	return nil
+}
+
+func (k Keeper) createBatchSwapSettledEvent(ctx sdk.Context, poolId uint64, result types.BatchSwapResult) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtBatchSwapSettled,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, result.Sender),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeySwapId, strconv.FormatUint(result.QueuedSwapId, 10)),
+			sdk.NewAttribute(types.AttributeKeyFilled, strconv.FormatBool(result.Filled)),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, result.TokenIn.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, result.TokenOut.String()),
+			sdk.NewAttribute(types.AttributeKeyRefund, result.Refund.String()),
+		),
+	})
+}
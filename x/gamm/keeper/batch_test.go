@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestBatchSwaps() {
+	poolId := suite.prepareBalancerPoolWithFutureGovernor(acc1.String())
+	gammKeeper := suite.app.GAMMKeeper
+	msgServer := keeper.NewMsgServerImpl(gammKeeper)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	// Only the governor can put the pool in batch mode.
+	err := gammKeeper.SetPoolBatchMode(suite.ctx, acc2, poolId, true)
+	suite.Require().ErrorIs(err, types.ErrNotPoolGovernor)
+	_, err = msgServer.SetPoolBatchMode(goCtx, &types.MsgSetPoolBatchMode{Sender: acc1.String(), PoolId: poolId, Enabled: true})
+	suite.Require().NoError(err)
+	suite.Require().True(gammKeeper.IsBatchModePool(suite.ctx, poolId))
+	suite.Require().Equal([]uint64{poolId}, gammKeeper.GetBatchModePoolIds(suite.ctx))
+
+	// Swaps on the pool can't be executed right away.
+	_, _, err = gammKeeper.SwapExactAmountIn(suite.ctx, acc1, poolId, sdk.NewCoin("foo", sdk.NewInt(1000)), "bar", sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolInBatchMode)
+	// Nor can single token joins and exits, which swap against the pool.
+	_, err = gammKeeper.JoinSwapExternAmountIn(suite.ctx, acc2, poolId, sdk.NewCoin("foo", sdk.NewInt(1000)), sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolInBatchMode)
+	_, err = gammKeeper.JoinSwapShareAmountOut(suite.ctx, acc2, poolId, "foo", types.OneShare, sdk.NewInt(1000000))
+	suite.Require().ErrorIs(err, types.ErrPoolInBatchMode)
+	_, err = gammKeeper.ExitSwapShareAmountIn(suite.ctx, acc1, poolId, "foo", types.OneShare, sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolInBatchMode)
+	_, err = gammKeeper.ExitSwapExternAmountOut(suite.ctx, acc1, poolId, sdk.NewCoin("foo", sdk.NewInt(1000)), types.OneShare)
+	suite.Require().ErrorIs(err, types.ErrPoolInBatchMode)
+
+	_, err = suite.queryClient.BatchResult(goCtx, &types.QueryBatchResultRequest{PoolId: poolId})
+	suite.Require().Error(err)
+
+	barBalance1 := suite.app.BankKeeper.GetBalance(suite.ctx, acc1, "bar")
+	fooBalance2 := suite.app.BankKeeper.GetBalance(suite.ctx, acc2, "foo")
+	fooBalance3 := suite.app.BankKeeper.GetBalance(suite.ctx, acc3, "foo")
+	barBalance3 := suite.app.BankKeeper.GetBalance(suite.ctx, acc3, "bar")
+
+	for _, msg := range []interface{}{
+		&types.MsgSwapExactAmountIn{
+			Sender:            acc1.String(),
+			Routes:            []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "bar"}},
+			TokenIn:           sdk.NewCoin("foo", sdk.NewInt(100000)),
+			TokenOutMinAmount: sdk.OneInt(),
+		},
+		&types.MsgSwapExactAmountIn{
+			Sender:            acc2.String(),
+			Routes:            []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "foo"}},
+			TokenIn:           sdk.NewCoin("bar", sdk.NewInt(50000)),
+			TokenOutMinAmount: sdk.OneInt(),
+		},
+		// Can't be filled at a price of at most 1.
+		&types.MsgSwapExactAmountIn{
+			Sender:            acc3.String(),
+			Routes:            []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "bar"}},
+			TokenIn:           sdk.NewCoin("foo", sdk.NewInt(10000)),
+			TokenOutMinAmount: sdk.NewInt(20000),
+		},
+		&types.MsgSwapExactAmountOut{
+			Sender:           acc3.String(),
+			Routes:           []types.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: "foo"}},
+			TokenInMaxAmount: sdk.NewInt(30000),
+			TokenOut:         sdk.NewCoin("bar", sdk.NewInt(20000)),
+		},
+	} {
+		switch msg := msg.(type) {
+		case *types.MsgSwapExactAmountIn:
+			_, err = msgServer.SwapExactAmountIn(goCtx, msg)
+		case *types.MsgSwapExactAmountOut:
+			_, err = msgServer.SwapExactAmountOut(goCtx, msg)
+		}
+		suite.Require().NoError(err)
+	}
+
+	// The tokens in are escrowed until the end of the block.
+	swaps, err := gammKeeper.GetQueuedSwaps(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Len(swaps, 4)
+	suite.Require().Equal(fooBalance3.Amount.SubRaw(40000), suite.app.BankKeeper.GetBalance(suite.ctx, acc3, "foo").Amount)
+
+	gammKeeper.EndBlock(suite.ctx)
+
+	swaps, err = gammKeeper.GetQueuedSwaps(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Len(swaps, 0)
+
+	res, err := suite.queryClient.BatchResult(goCtx, &types.QueryBatchResultRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	result := res.BatchResult
+	suite.Require().Equal(poolId, result.PoolId)
+	suite.Require().Len(result.Swaps, 4)
+	suite.Require().Len(result.ClearingPrices, 2)
+
+	// The opposing swaps are matched at the spot price of 1, without swap fee,
+	// and the rest of the foo is swapped through the pool.
+	suite.Require().True(result.Swaps[1].Filled)
+	suite.Require().Equal(sdk.NewCoin("foo", sdk.NewInt(50000)), result.Swaps[1].TokenOut)
+	suite.Require().Equal(fooBalance2.Amount.AddRaw(50000), suite.app.BankKeeper.GetBalance(suite.ctx, acc2, "foo").Amount)
+
+	// The swaps from foo to bar get the same price, between the swap fee and 1.
+	var fooPrice sdk.Dec
+	for _, price := range result.ClearingPrices {
+		if price.TokenInDenom == "foo" {
+			fooPrice = price.Price
+		}
+	}
+	suite.Require().True(fooPrice.GT(sdk.NewDecWithPrec(98, 2)), fooPrice.String())
+	suite.Require().True(fooPrice.LT(sdk.OneDec()), fooPrice.String())
+	suite.Require().True(result.Swaps[0].Filled)
+	suite.Require().Equal(fooPrice.MulInt64(100000).TruncateInt(), result.Swaps[0].TokenOut.Amount)
+	suite.Require().Equal(barBalance1.Amount.Add(result.Swaps[0].TokenOut.Amount), suite.app.BankKeeper.GetBalance(suite.ctx, acc1, "bar").Amount)
+
+	// The swap whose limit isn't met is refunded.
+	suite.Require().False(result.Swaps[2].Filled)
+	suite.Require().Equal(sdk.NewCoin("foo", sdk.NewInt(10000)), result.Swaps[2].Refund)
+
+	// The exact out swap gets exactly its amount out, and the rest of its amount in back.
+	exactOut := result.Swaps[3]
+	suite.Require().True(exactOut.Filled)
+	suite.Require().Equal(sdk.NewCoin("bar", sdk.NewInt(20000)), exactOut.TokenOut)
+	suite.Require().True(exactOut.TokenIn.Amount.LT(sdk.NewInt(21000)), exactOut.TokenIn.String())
+	suite.Require().Equal(sdk.NewInt(30000).Sub(exactOut.TokenIn.Amount), exactOut.Refund.Amount)
+	suite.Require().Equal(fooBalance3.Amount.Sub(exactOut.TokenIn.Amount), suite.app.BankKeeper.GetBalance(suite.ctx, acc3, "foo").Amount)
+	suite.Require().Equal(barBalance3.Amount.AddRaw(20000), suite.app.BankKeeper.GetBalance(suite.ctx, acc3, "bar").Amount)
+
+	// Nothing is left in escrow, and the pool holds its balances.
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, moduleAddr).Empty())
+	suite.requireGammInvariants()
+
+	// Batch pools are not routed through, and can go back to executing swaps right away.
+	_, _, err = gammKeeper.FindBestSplitRoutes(suite.ctx, sdk.NewCoin("foo", sdk.NewInt(1000)), "bar", 1, 1)
+	suite.Require().ErrorIs(err, types.ErrNoRouteFound)
+
+	err = gammKeeper.SetPoolBatchMode(suite.ctx, acc1, poolId, false)
+	suite.Require().NoError(err)
+	_, _, err = gammKeeper.SwapExactAmountIn(suite.ctx, acc1, poolId, sdk.NewCoin("foo", sdk.NewInt(1000)), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestConcentratedPoolBatchSwaps() {
+	poolId := suite.prepareConcentratedPool()
+	gammKeeper := suite.app.GAMMKeeper
+	suite.setProtocolFeeShare(sdk.NewDecWithPrec(5, 1))
+
+	_, _, _, err := gammKeeper.CreatePosition(suite.ctx, acc1, poolId, -1000, 1000,
+		sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().NoError(err)
+
+	gammKeeper.SetBatchModePool(suite.ctx, poolId, true)
+	_, err = gammKeeper.QueueSwap(suite.ctx, acc2, poolId, sdk.NewCoin("foo", sdk.NewInt(100000)), sdk.NewCoin("bar", sdk.OneInt()), false)
+	suite.Require().NoError(err)
+	_, err = gammKeeper.QueueSwap(suite.ctx, acc3, poolId, sdk.NewCoin("bar", sdk.NewInt(30000)), sdk.NewCoin("foo", sdk.OneInt()), false)
+	suite.Require().NoError(err)
+
+	// Swaps on pools not in batch mode can't be queued.
+	_, err = gammKeeper.QueueSwap(suite.ctx, acc2, poolId+1, sdk.NewCoin("foo", sdk.NewInt(100000)), sdk.NewCoin("bar", sdk.OneInt()), false)
+	suite.Require().ErrorIs(err, types.ErrInvalidBatchSwap)
+
+	gammKeeper.EndBlock(suite.ctx)
+
+	result, err := gammKeeper.GetBatchResult(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().True(result.Swaps[0].Filled)
+	suite.Require().True(result.Swaps[1].Filled)
+
+	// Only the part swapped through the pool pays the swap fee.
+	suite.Require().True(gammKeeper.GetProtocolFees(suite.ctx).AmountOf("foo").IsPositive())
+	suite.Require().True(gammKeeper.GetProtocolFees(suite.ctx).AmountOf("foo").LT(sdk.NewInt(500)))
+	suite.requireGammInvariants()
+}
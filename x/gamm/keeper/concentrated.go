@@ -0,0 +1,521 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+// newConcentratedPool is an internal function that creates a new Concentrated Pool object with the provided
+// parameters, assets, tick spacing, initial price and future governor.
+func (k Keeper) newConcentratedPool(ctx sdk.Context, concentratedPoolParams types.ConcentratedPoolParams, denom0, denom1 string, tickSpacing uint64, initialPrice sdk.Dec, futureGovernor string) (types.PoolI, error) {
+	poolId := k.GetNextPoolNumberAndIncrement(ctx)
+
+	pool, err := types.NewConcentratedPool(poolId, concentratedPoolParams, denom0, denom1, tickSpacing, initialPrice, futureGovernor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.createPoolAccount(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// CreateConcentratedPool creates a concentrated pool without liquidity, at the price initialPrice
+// of denom0 in units of denom1. Liquidity is then provided with positions.
+func (k Keeper) CreateConcentratedPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	concentratedPoolParams types.ConcentratedPoolParams,
+	denom0, denom1 string,
+	tickSpacing uint64,
+	initialPrice sdk.Dec,
+	futurePoolGovernor string,
+) (uint64, error) {
+	return k.createConcentratedPool(ctx, sender, concentratedPoolParams, denom0, denom1, tickSpacing, initialPrice, futurePoolGovernor, "")
+}
+
+// createConcentratedPool creates a concentrated pool whose creation fee is paid in feeDenom.
+func (k Keeper) createConcentratedPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	concentratedPoolParams types.ConcentratedPoolParams,
+	denom0, denom1 string,
+	tickSpacing uint64,
+	initialPrice sdk.Dec,
+	futurePoolGovernor string,
+	feeDenom string,
+) (uint64, error) {
+	pool, err := k.newConcentratedPool(ctx, concentratedPoolParams, denom0, denom1, tickSpacing, initialPrice, futurePoolGovernor)
+	if err != nil {
+		return 0, err
+	}
+
+	err = k.createTwapRecords(ctx, pool)
+	if err != nil {
+		return 0, err
+	}
+
+	k.hooks.AfterPoolCreated(ctx, sender, pool.GetId())
+
+	err = k.chargePoolCreationFee(ctx, sender, pool.GetId(), feeDenom)
+	if err != nil {
+		return 0, err
+	}
+
+	return pool.GetId(), nil
+}
+
+func (k Keeper) getConcentratedPool(ctx sdk.Context, poolId uint64) (*types.ConcentratedPool, error) {
+	pool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	concentratedPool, ok := pool.(*types.ConcentratedPool)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrUnsupportedPoolOperation, "pool %d is not a concentrated pool", poolId)
+	}
+	return concentratedPool, nil
+}
+
+// CreatePosition provides the most liquidity between lowerTick and upperTick that amount0Desired and
+// amount1Desired allow at the current price of the pool. It returns the new position's id, its liquidity,
+// and the coins deposited, which have to be at least amount0Min and amount1Min.
+func (k Keeper) CreatePosition(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	poolId uint64,
+	lowerTick, upperTick int64,
+	amount0Desired, amount1Desired sdk.Int,
+	amount0Min, amount1Min sdk.Int,
+) (positionId uint64, liquidity sdk.Dec, deposit sdk.Coins, err error) {
+	pool, err := k.getConcentratedPool(ctx, poolId)
+	if err != nil {
+		return 0, sdk.Dec{}, nil, err
+	}
+
+	err = k.checkPoolOpen(ctx, poolId)
+	if err != nil {
+		return 0, sdk.Dec{}, nil, err
+	}
+
+	err = pool.ValidatePositionTicks(lowerTick, upperTick)
+	if err != nil {
+		return 0, sdk.Dec{}, nil, err
+	}
+
+	liquidity, amount0Int, amount1Int, err := positionDeposit(pool, lowerTick, upperTick, amount0Desired, amount1Desired)
+	if err != nil {
+		return 0, sdk.Dec{}, nil, err
+	}
+	if amount0Int.LT(amount0Min) {
+		return 0, sdk.Dec{}, nil, sdkerrors.Wrapf(types.ErrLimitMinAmount, "%s token is lesser than min amount", pool.Token0.Denom)
+	}
+	if amount1Int.LT(amount1Min) {
+		return 0, sdk.Dec{}, nil, sdkerrors.Wrapf(types.ErrLimitMinAmount, "%s token is lesser than min amount", pool.Token1.Denom)
+	}
+
+	feeGrowthInside0, feeGrowthInside1, err := pool.UpdatePosition(lowerTick, upperTick, liquidity)
+	if err != nil {
+		return 0, sdk.Dec{}, nil, err
+	}
+
+	err = pool.UpdatePoolAssetBalances(sdk.Coins{
+		sdk.NewCoin(pool.Token0.Denom, pool.Token0.Amount.Add(amount0Int)),
+		sdk.NewCoin(pool.Token1.Denom, pool.Token1.Amount.Add(amount1Int)),
+	})
+	if err != nil {
+		return 0, sdk.Dec{}, nil, err
+	}
+
+	deposit = sdk.NewCoins(
+		sdk.NewCoin(pool.Token0.Denom, amount0Int),
+		sdk.NewCoin(pool.Token1.Denom, amount1Int),
+	)
+	err = k.bankKeeper.SendCoins(ctx, owner, pool.GetAddress(), deposit)
+	if err != nil {
+		return 0, sdk.Dec{}, nil, err
+	}
+
+	err = k.SetPool(ctx, pool)
+	if err != nil {
+		return 0, sdk.Dec{}, nil, err
+	}
+
+	position := types.Position{
+		Id:                   k.GetNextPositionIdAndIncrement(ctx),
+		PoolId:               poolId,
+		Owner:                owner.String(),
+		LowerTick:            lowerTick,
+		UpperTick:            upperTick,
+		Liquidity:            liquidity,
+		FeeGrowthInside0Last: feeGrowthInside0,
+		FeeGrowthInside1Last: feeGrowthInside1,
+		UncollectedFees:      sdk.DecCoins{},
+	}
+	k.SetPosition(ctx, position)
+
+	k.createAddLiquidityEvent(ctx, owner, poolId, deposit)
+	k.hooks.AfterJoinPool(ctx, owner, poolId, deposit, liquidity.TruncateInt())
+	k.recordJoinStats(ctx, poolId, deposit)
+	k.trackChangedPool(ctx, poolId)
+	k.RecordTotalLiquidityIncrease(ctx, deposit)
+
+	return position.Id, liquidity, deposit, nil
+}
+
+// positionDeposit returns the most liquidity between lowerTick and upperTick that amount0Desired and
+// amount1Desired allow, and the amounts to deposit for it.
+// Deposits are rounded up, so the liquidity is lowered when the rounding would exceed the desired amounts,
+// rather than letting the position hold more liquidity than was deposited.
+func positionDeposit(pool *types.ConcentratedPool, lowerTick, upperTick int64, amount0Desired, amount1Desired sdk.Int) (liquidity sdk.Dec, amount0, amount1 sdk.Int, err error) {
+	liquidity = pool.LiquidityForAmounts(lowerTick, upperTick, amount0Desired, amount1Desired)
+	amount0, amount1 = depositForLiquidity(pool, lowerTick, upperTick, liquidity)
+
+	if amount0.GT(amount0Desired) || amount1.GT(amount1Desired) {
+		amount0Lowered, amount1Lowered := amount0Desired, amount1Desired
+		if amount0.GT(amount0Desired) {
+			amount0Lowered = sdk.MaxInt(amount0Desired.SubRaw(1), sdk.ZeroInt())
+		}
+		if amount1.GT(amount1Desired) {
+			amount1Lowered = sdk.MaxInt(amount1Desired.SubRaw(1), sdk.ZeroInt())
+		}
+		liquidity = pool.LiquidityForAmounts(lowerTick, upperTick, amount0Lowered, amount1Lowered)
+		amount0, amount1 = depositForLiquidity(pool, lowerTick, upperTick, liquidity)
+		if amount0.GT(amount0Desired) || amount1.GT(amount1Desired) {
+			return sdk.Dec{}, sdk.Int{}, sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox,
+				"the deposit %s%s, %s%s exceeds the desired amounts", amount0, pool.Token0.Denom, amount1, pool.Token1.Denom)
+		}
+	}
+
+	if !liquidity.IsPositive() {
+		return sdk.Dec{}, sdk.Int{}, sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidLiquidity,
+			"the desired amounts provide no liquidity between ticks %d and %d", lowerTick, upperTick)
+	}
+	return liquidity, amount0, amount1, nil
+}
+
+// depositForLiquidity returns the amounts to deposit for liquidity, rounded up.
+func depositForLiquidity(pool *types.ConcentratedPool, lowerTick, upperTick int64, liquidity sdk.Dec) (amount0, amount1 sdk.Int) {
+	amount0Dec, amount1Dec := pool.AmountsForLiquidity(lowerTick, upperTick, liquidity)
+	return amount0Dec.Ceil().TruncateInt(), amount1Dec.Ceil().TruncateInt()
+}
+
+// WithdrawPosition removes liquidity from the position, and sends the tokens it held along with
+// all the fees earned by the position to its owner. The position is deleted once it has no liquidity left.
+func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, liquidity sdk.Dec) (sdk.Coins, error) {
+	position, err := k.getOwnedPosition(ctx, owner, positionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if liquidity.IsNil() || !liquidity.IsPositive() || liquidity.GT(position.Liquidity) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidLiquidity, "position %d has %s liquidity", positionId, position.Liquidity)
+	}
+
+	pool, err := k.getConcentratedPool(ctx, position.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	feeGrowthInside0, feeGrowthInside1, err := pool.UpdatePosition(position.LowerTick, position.UpperTick, liquidity.Neg())
+	if err != nil {
+		return nil, err
+	}
+	position.UpdateFees(feeGrowthInside0, feeGrowthInside1, pool.Token0.Denom, pool.Token1.Denom)
+	position.Liquidity = position.Liquidity.Sub(liquidity)
+
+	// Withdrawals are rounded down.
+	amount0, amount1 := pool.AmountsForLiquidity(position.LowerTick, position.UpperTick, liquidity)
+	withdrawal := sdk.NewCoins(
+		sdk.NewCoin(pool.Token0.Denom, amount0.TruncateInt()),
+		sdk.NewCoin(pool.Token1.Denom, amount1.TruncateInt()),
+	)
+	fees, change := position.UncollectedFees.TruncateDecimal()
+	position.UncollectedFees = change
+
+	coins := withdrawal.Add(fees...)
+	err = k.sendFromConcentratedPool(ctx, pool, owner, coins)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.SetPool(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	if position.Liquidity.IsZero() {
+		k.deletePosition(ctx, position)
+	} else {
+		k.SetPosition(ctx, position)
+	}
+
+	k.createRemoveLiquidityEvent(ctx, owner, pool.GetId(), coins)
+	k.hooks.AfterExitPool(ctx, owner, pool.GetId(), liquidity.TruncateInt(), coins)
+	k.recordExitStats(ctx, pool.GetId(), coins)
+	k.trackChangedPool(ctx, pool.GetId())
+	k.RecordTotalLiquidityDecrease(ctx, coins)
+
+	return coins, nil
+}
+
+// CollectFees sends the fees earned by the position to its owner.
+func (k Keeper) CollectFees(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (sdk.Coins, error) {
+	position, err := k.getOwnedPosition(ctx, owner, positionId)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := k.getConcentratedPool(ctx, position.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	feeGrowthInside0, feeGrowthInside1 := pool.FeeGrowthInside(position.LowerTick, position.UpperTick)
+	position.UpdateFees(feeGrowthInside0, feeGrowthInside1, pool.Token0.Denom, pool.Token1.Denom)
+	fees, change := position.UncollectedFees.TruncateDecimal()
+	position.UncollectedFees = change
+
+	err = k.sendFromConcentratedPool(ctx, pool, owner, fees)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.SetPool(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+	k.SetPosition(ctx, position)
+
+	k.RecordTotalLiquidityDecrease(ctx, fees)
+
+	return fees, nil
+}
+
+// sendFromConcentratedPool sends coins from the pool to recipient, and deducts them from the pool's balances.
+func (k Keeper) sendFromConcentratedPool(ctx sdk.Context, pool *types.ConcentratedPool, recipient sdk.AccAddress, coins sdk.Coins) error {
+	for _, coin := range coins {
+		balance, err := pool.GetTokenBalance(coin.Denom)
+		if err != nil {
+			return err
+		}
+		if balance.LT(coin.Amount) {
+			return sdkerrors.Wrapf(types.ErrTooManyTokensOut, "pool %d holds %s%s", pool.GetId(), balance, coin.Denom)
+		}
+
+		err = pool.UpdatePoolAssetBalance(sdk.NewCoin(coin.Denom, balance.Sub(coin.Amount)))
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.bankKeeper.SendCoins(ctx, pool.GetAddress(), recipient, coins)
+}
+
+// SetNextPositionId sets next position id
+func (k Keeper) SetNextPositionId(ctx sdk.Context, positionId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&gogotypes.UInt64Value{Value: positionId})
+	store.Set(types.KeyNextPositionId, bz)
+}
+
+// GetNextPositionIdAndIncrement returns the next position id, and increments the corresponding state entry.
+// Position ids start at 1.
+func (k Keeper) GetNextPositionIdAndIncrement(ctx sdk.Context) uint64 {
+	positionId := uint64(1)
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyNextPositionId)
+	if bz != nil {
+		val := gogotypes.UInt64Value{}
+
+		err := k.cdc.UnmarshalBinaryBare(bz, &val)
+		if err != nil {
+			panic(err)
+		}
+
+		positionId = val.GetValue()
+	}
+
+	k.SetNextPositionId(ctx, positionId+1)
+	return positionId
+}
+
+func (k Keeper) GetPosition(ctx sdk.Context, positionId uint64) (types.Position, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyPosition(positionId))
+	if bz == nil {
+		return types.Position{}, sdkerrors.Wrapf(types.ErrPositionNotFound, "position %d", positionId)
+	}
+
+	position := types.Position{}
+	err := k.cdc.UnmarshalBinaryBare(bz, &position)
+	if err != nil {
+		return types.Position{}, err
+	}
+	return position, nil
+}
+
+func (k Keeper) getOwnedPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (types.Position, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return types.Position{}, err
+	}
+
+	if position.Owner != owner.String() {
+		return types.Position{}, sdkerrors.Wrapf(types.ErrNotPositionOwner, "position %d is owned by %s", positionId, position.Owner)
+	}
+	return position, nil
+}
+
+// SetPosition stores the position, and indexes it by owner.
+func (k Keeper) SetPosition(ctx sdk.Context, position types.Position) {
+	owner, err := sdk.AccAddressFromBech32(position.Owner)
+	if err != nil {
+		panic(fmt.Sprintf("invalid owner of position %d: %s", position.Id, err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyPosition(position.Id), k.cdc.MustMarshalBinaryBare(&position))
+	store.Set(types.GetKeyPositionByOwner(owner, position.Id), sdk.Uint64ToBigEndian(position.Id))
+}
+
+func (k Keeper) deletePosition(ctx sdk.Context, position types.Position) {
+	owner, err := sdk.AccAddressFromBech32(position.Owner)
+	if err != nil {
+		panic(fmt.Sprintf("invalid owner of position %d: %s", position.Id, err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetKeyPosition(position.Id))
+	store.Delete(types.GetKeyPositionByOwner(owner, position.Id))
+}
+
+// GetAccountPositions returns the positions owned by owner, ordered by id.
+func (k Keeper) GetAccountPositions(ctx sdk.Context, owner sdk.AccAddress) ([]types.Position, error) {
+	iter := k.iterator(ctx, types.GetKeyPrefixPositionsByOwner(owner))
+	defer iter.Close()
+
+	positions := []types.Position{}
+	for ; iter.Valid(); iter.Next() {
+		position, err := k.GetPosition(ctx, sdk.BigEndianToUint64(iter.Value()))
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, position)
+	}
+	return positions, nil
+}
+
+// GetAllPositions returns all the positions, ordered by id.
+func (k Keeper) GetAllPositions(ctx sdk.Context) ([]types.Position, error) {
+	iter := k.iterator(ctx, types.KeyPrefixPositions)
+	defer iter.Close()
+
+	positions := []types.Position{}
+	for ; iter.Valid(); iter.Next() {
+		position := types.Position{}
+		err := k.cdc.UnmarshalBinaryBare(iter.Value(), &position)
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, position)
+	}
+	return positions, nil
+}
+
+// tickStore reads the initialized ticks of a concentrated pool from the store.
+type tickStore struct {
+	k      Keeper
+	ctx    sdk.Context
+	poolId uint64
+}
+
+var _ types.TickStore = tickStore{}
+
+// setTickStore sets the store the ticks of the pool are read from, if it is a concentrated pool.
+func (k Keeper) setTickStore(ctx sdk.Context, pool types.PoolI) {
+	if concentratedPool, ok := pool.(*types.ConcentratedPool); ok {
+		concentratedPool.SetTickStore(tickStore{k: k, ctx: ctx, poolId: pool.GetId()})
+	}
+}
+
+func (s tickStore) GetTick(tick int64) (types.TickInfo, bool) {
+	bz := s.ctx.KVStore(s.k.storeKey).Get(types.GetKeyTick(s.poolId, tick))
+	if bz == nil {
+		return types.TickInfo{}, false
+	}
+	return s.k.mustUnmarshalTick(bz), true
+}
+
+func (s tickStore) NextInitializedTick(tick int64, lte bool) (types.TickInfo, bool) {
+	store := prefix.NewStore(s.ctx.KVStore(s.k.storeKey), types.GetKeyPrefixTicks(s.poolId))
+	// The keys of the ticks greater than tick start after the key of tick.
+	after := sdk.PrefixEndBytes(types.TickIndexBytes(tick))
+
+	var iter sdk.Iterator
+	if lte {
+		iter = store.ReverseIterator(nil, after)
+	} else {
+		if after == nil {
+			return types.TickInfo{}, false
+		}
+		iter = store.Iterator(after, nil)
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.TickInfo{}, false
+	}
+	return s.k.mustUnmarshalTick(iter.Value()), true
+}
+
+func (k Keeper) mustUnmarshalTick(bz []byte) types.TickInfo {
+	tick := types.TickInfo{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &tick)
+	return tick
+}
+
+// SetTick stores an initialized tick of a concentrated pool.
+func (k Keeper) SetTick(ctx sdk.Context, tick types.TickInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyTick(tick.PoolId, tick.TickIndex), k.cdc.MustMarshalBinaryBare(&tick))
+}
+
+func (k Keeper) deleteTick(ctx sdk.Context, poolId uint64, tick int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetKeyTick(poolId, tick))
+}
+
+// setTickChanges stores the ticks the concentrated pool changed since it was loaded.
+func (k Keeper) setTickChanges(ctx sdk.Context, pool *types.ConcentratedPool) {
+	updated, removed := pool.TickChanges()
+	for _, tick := range updated {
+		k.SetTick(ctx, tick)
+	}
+	for _, tick := range removed {
+		k.deleteTick(ctx, pool.GetId(), tick)
+	}
+}
+
+// GetAllTicks returns the initialized ticks of all the concentrated pools, ordered by pool and tick index.
+func (k Keeper) GetAllTicks(ctx sdk.Context) []types.TickInfo {
+	iter := k.iterator(ctx, types.KeyPrefixTicks)
+	defer iter.Close()
+
+	ticks := []types.TickInfo{}
+	for ; iter.Valid(); iter.Next() {
+		ticks = append(ticks, k.mustUnmarshalTick(iter.Value()))
+	}
+	return ticks
+}
@@ -0,0 +1,243 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) prepareConcentratedPool() uint64 {
+	// Mint some assets to the accounts.
+	for _, acc := range []sdk.AccAddress{acc1, acc2, acc3} {
+		err := suite.app.BankKeeper.AddCoins(
+			suite.ctx,
+			acc,
+			sdk.NewCoins(
+				sdk.NewCoin("uosmo", sdk.NewInt(10000000000)),
+				sdk.NewCoin("foo", sdk.NewInt(10000000)),
+				sdk.NewCoin("bar", sdk.NewInt(10000000)),
+			),
+		)
+		suite.Require().NoError(err)
+	}
+
+	poolId, err := suite.app.GAMMKeeper.CreateConcentratedPool(suite.ctx, acc1, types.ConcentratedPoolParams{
+		SwapFee: sdk.NewDecWithPrec(1, 2),
+	}, "bar", "foo", 10, sdk.OneDec(), "")
+	suite.Require().NoError(err)
+	return poolId
+}
+
+func (suite *KeeperTestSuite) TestCreateConcentratedPool() {
+	poolId := suite.prepareConcentratedPool()
+	keeper := suite.app.GAMMKeeper
+
+	pool, err := keeper.GetPool(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.ZeroInt(), pool.GetTotalShares().Amount)
+	suite.Require().Equal(2, pool.NumAssets())
+
+	spotPrice, err := keeper.CalculateSpotPrice(suite.ctx, poolId, "foo", "bar")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.OneDec(), spotPrice)
+
+	// Swaps fail without liquidity.
+	_, _, err = keeper.SwapExactAmountIn(suite.ctx, acc2, poolId, sdk.NewCoin("bar", sdk.NewInt(1000)), "foo", sdk.OneInt())
+	suite.Require().Error(err)
+
+	// Shares based operations are not supported.
+	err = keeper.JoinPool(suite.ctx, acc2, poolId, types.OneShare.MulRaw(10), sdk.Coins{})
+	suite.Require().ErrorIs(err, types.ErrUnsupportedPoolOperation)
+
+	for _, test := range []struct {
+		denom0, denom1 string
+		tickSpacing    uint64
+		initialPrice   sdk.Dec
+	}{
+		{"foo", "bar", 10, sdk.OneDec()},
+		{"bar", "bar", 10, sdk.OneDec()},
+		{"bar", "foo", 0, sdk.OneDec()},
+		{"bar", "foo", 10, sdk.ZeroDec()},
+		{"bar", "foo", 10, sdk.NewDec(1000000000000)},
+	} {
+		_, err := keeper.CreateConcentratedPool(suite.ctx, acc1, types.ConcentratedPoolParams{
+			SwapFee: sdk.NewDecWithPrec(1, 2),
+		}, test.denom0, test.denom1, test.tickSpacing, test.initialPrice, "")
+		suite.Require().Error(err, "denoms %s/%s, tick spacing %d, price %s", test.denom0, test.denom1, test.tickSpacing, test.initialPrice)
+	}
+}
+
+func (suite *KeeperTestSuite) TestConcentratedPositions() {
+	poolId := suite.prepareConcentratedPool()
+	keeper := suite.app.GAMMKeeper
+
+	// A position around the current price deposits both denoms.
+	positionId, liquidity, deposit, err := keeper.CreatePosition(suite.ctx, acc1, poolId, -1000, 1000,
+		sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), positionId)
+	suite.Require().True(liquidity.IsPositive())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bar", sdk.NewInt(1000000)), sdk.NewCoin("foo", sdk.NewInt(1000000))), deposit)
+
+	// A position above the current price only deposits denom0.
+	outOfRangeId, _, deposit, err := keeper.CreatePosition(suite.ctx, acc2, poolId, 2000, 3000,
+		sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("bar", sdk.NewInt(1000000))), deposit)
+
+	// The bounds of the positions are stored apart from the pool, as its initialized ticks.
+	ticks := keeper.GetAllTicks(suite.ctx)
+	suite.Require().Len(ticks, 4)
+	for i, tick := range []int64{-1000, 1000, 2000, 3000} {
+		suite.Require().Equal(poolId, ticks[i].PoolId)
+		suite.Require().Equal(tick, ticks[i].TickIndex)
+	}
+
+	// Swaps cross the stored ticks, up to the out of range position.
+	cacheCtx, _ := suite.ctx.CacheContext()
+	_, _, err = keeper.SwapExactAmountIn(cacheCtx, acc3, poolId, sdk.NewCoin("foo", sdk.NewInt(2000000)), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	pool, err := keeper.GetPool(cacheCtx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().True(pool.(*types.ConcentratedPool).CurrentTick >= 2000)
+	suite.Require().Equal(suite.positionLiquidity(outOfRangeId), pool.(*types.ConcentratedPool).Liquidity)
+
+	// Deposits never exceed the desired amounts.
+	_, _, deposit, err = keeper.CreatePosition(cacheCtx, acc3, poolId, -1000, 1000,
+		sdk.NewInt(333333), sdk.NewInt(777777), sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().NoError(err)
+	suite.Require().True(deposit.AmountOf("bar").LTE(sdk.NewInt(333333)))
+	suite.Require().True(deposit.AmountOf("foo").LTE(sdk.NewInt(777777)))
+
+	for _, test := range []struct {
+		name                 string
+		lowerTick, upperTick int64
+		amount1Min           sdk.Int
+	}{
+		{"ticks not multiple of tick spacing", -1005, 1000, sdk.ZeroInt()},
+		{"inverted ticks", 1000, -1000, sdk.ZeroInt()},
+		{"out of range tick", -1000, types.MaxTick + 10, sdk.ZeroInt()},
+		{"min amount not met", -1000, 1000, sdk.NewInt(2000000)},
+	} {
+		_, _, _, err := keeper.CreatePosition(suite.ctx, acc1, poolId, test.lowerTick, test.upperTick,
+			sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.ZeroInt(), test.amount1Min)
+		suite.Require().Error(err, test.name)
+	}
+
+	// Swap in both directions, the out of range position doesn't earn fees.
+	_, _, err = keeper.SwapExactAmountIn(suite.ctx, acc3, poolId, sdk.NewCoin("foo", sdk.NewInt(100000)), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	_, _, err = keeper.SwapExactAmountOut(suite.ctx, acc3, poolId, "bar", sdk.NewInt(200000), sdk.NewCoin("foo", sdk.NewInt(100000)))
+	suite.Require().NoError(err)
+
+	fees, err := keeper.CollectFees(suite.ctx, acc1, positionId)
+	suite.Require().NoError(err)
+	suite.Require().True(fees.AmountOf("foo").IsPositive())
+	suite.Require().True(fees.AmountOf("bar").IsPositive())
+	// 1% of the 100000 foo swapped in.
+	suite.Require().True(fees.AmountOf("foo").LTE(sdk.NewInt(1000)))
+
+	// Fees can only be collected once.
+	fees, err = keeper.CollectFees(suite.ctx, acc1, positionId)
+	suite.Require().NoError(err)
+	suite.Require().True(fees.IsZero())
+
+	fees, err = keeper.CollectFees(suite.ctx, acc2, outOfRangeId)
+	suite.Require().NoError(err)
+	suite.Require().True(fees.IsZero())
+
+	// Only the owner can withdraw a position.
+	_, err = keeper.WithdrawPosition(suite.ctx, acc2, positionId, liquidity)
+	suite.Require().ErrorIs(err, types.ErrNotPositionOwner)
+	_, err = keeper.WithdrawPosition(suite.ctx, acc1, positionId, liquidity.MulInt64(2))
+	suite.Require().ErrorIs(err, types.ErrInvalidLiquidity)
+
+	// Partial withdrawals keep the position.
+	coins, err := keeper.WithdrawPosition(suite.ctx, acc1, positionId, liquidity.QuoInt64(2))
+	suite.Require().NoError(err)
+	suite.Require().True(coins.AmountOf("foo").IsPositive())
+	position, err := keeper.GetPosition(suite.ctx, positionId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(liquidity.Sub(liquidity.QuoInt64(2)), position.Liquidity)
+
+	_, err = keeper.WithdrawPosition(suite.ctx, acc1, positionId, position.Liquidity)
+	suite.Require().NoError(err)
+	_, err = keeper.GetPosition(suite.ctx, positionId)
+	suite.Require().ErrorIs(err, types.ErrPositionNotFound)
+
+	// The out of range position gets its deposit back in full.
+	coins, err = keeper.WithdrawPosition(suite.ctx, acc2, outOfRangeId, suite.positionLiquidity(outOfRangeId))
+	suite.Require().NoError(err)
+	suite.Require().True(sdk.NewInt(1000000).Sub(coins.AmountOf("bar")).LTE(sdk.OneInt()))
+
+	positions, err := keeper.GetAllPositions(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(positions, 0)
+	suite.Require().Len(keeper.GetAllTicks(suite.ctx), 0)
+}
+
+func (suite *KeeperTestSuite) positionLiquidity(positionId uint64) sdk.Dec {
+	position, err := suite.app.GAMMKeeper.GetPosition(suite.ctx, positionId)
+	suite.Require().NoError(err)
+	return position.Liquidity
+}
+
+func (suite *KeeperTestSuite) TestConcentratedPoolMultihop() {
+	balancerPoolId := suite.preparePool()
+	concentratedPoolId := suite.prepareConcentratedPool()
+	keeper := suite.app.GAMMKeeper
+
+	_, _, _, err := keeper.CreatePosition(suite.ctx, acc1, concentratedPoolId, -1000, 1000,
+		sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().NoError(err)
+
+	routes := []types.SwapAmountInRoute{
+		{PoolId: balancerPoolId, TokenOutDenom: "bar"},
+		{PoolId: concentratedPoolId, TokenOutDenom: "foo"},
+	}
+	tokenIn := sdk.NewCoin("baz", sdk.NewInt(100000))
+
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, acc1, "foo")
+	tokenOutAmount, err := keeper.MultihopSwapExactAmountIn(suite.ctx, acc1, routes, tokenIn, sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().True(tokenOutAmount.IsPositive())
+	balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, acc1, "foo")
+	suite.Require().Equal(tokenOutAmount, balanceAfter.Amount.Sub(balanceBefore.Amount))
+
+	// The pool balances match the bank balances of the pool account.
+	pool, err := keeper.GetPool(suite.ctx, concentratedPoolId)
+	suite.Require().NoError(err)
+	for _, asset := range pool.GetAllPoolAssets() {
+		suite.Require().Equal(asset.Token, suite.app.BankKeeper.GetBalance(suite.ctx, pool.GetAddress(), asset.Token.Denom))
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPositions() {
+	poolId := suite.prepareConcentratedPool()
+	keeper := suite.app.GAMMKeeper
+
+	for i := 0; i < 2; i++ {
+		_, _, _, err := keeper.CreatePosition(suite.ctx, acc1, poolId, -1000, 1000,
+			sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.ZeroInt(), sdk.ZeroInt())
+		suite.Require().NoError(err)
+	}
+	_, _, _, err := keeper.CreatePosition(suite.ctx, acc2, poolId, -100, 100,
+		sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.Position(sdk.WrapSDKContext(suite.ctx), &types.QueryPositionRequest{PositionId: 3})
+	suite.Require().NoError(err)
+	suite.Require().Equal(acc2.String(), res.Position.Owner)
+	suite.Require().Equal(int64(-100), res.Position.LowerTick)
+
+	_, err = suite.queryClient.Position(sdk.WrapSDKContext(suite.ctx), &types.QueryPositionRequest{PositionId: 4})
+	suite.Require().Error(err)
+
+	accountRes, err := suite.queryClient.AccountPositions(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountPositionsRequest{Owner: acc1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(accountRes.Positions, 2)
+
+	accountRes, err = suite.queryClient.AccountPositions(sdk.WrapSDKContext(suite.ctx), &types.QueryAccountPositionsRequest{Owner: acc3.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(accountRes.Positions, 0)
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+// FlashSwap sends tokenOut from the pool to the sender, runs execute, and then takes the
+// tokenInDenom owed for tokenOut, swap fee included, from the sender.
+// The pool is updated as if the swap was paid for before execute runs, so that nested swaps
+// on the pool see its price after the flash swap. Once paid, the pool account should hold
+// the pool assets, and balancer pools should not be worth less per share than before.
+// If any of that fails, none of the state changes, including the ones made by execute, are kept.
+func (k Keeper) FlashSwap(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenInDenom string,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+	execute func(ctx sdk.Context) error,
+) (tokenInAmount sdk.Int, err error) {
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	tokenInAmount, err = k.flashSwap(cacheCtx, sender, poolId, tokenInDenom, tokenInMaxAmount, tokenOut, execute)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return tokenInAmount, nil
+}
+
+func (k Keeper) flashSwap(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenInDenom string,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+	execute func(ctx sdk.Context) error,
+) (sdk.Int, error) {
+	if tokenInDenom == tokenOut.Denom {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidFlashSwap, "cannot trade same denomination in and out")
+	}
+
+	if k.IsBatchModePool(ctx, poolId) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrPoolInBatchMode, "swaps on pool %d are queued", poolId)
+	}
+	if err := k.checkPoolOpen(ctx, poolId); err != nil {
+		return sdk.Int{}, err
+	}
+
+	pool, _, _, err := k.getPoolAndInOutAssets(ctx, poolId, tokenInDenom, tokenOut.Denom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if !pool.IsActive(ctx.BlockTime()) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrPoolLocked, "swap on inactive pool")
+	}
+
+	tokenIn, err := pool.SwapInGivenOut(tokenOut, tokenInDenom, pool.GetPoolSwapFee())
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if !tokenIn.Amount.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
+	}
+	if tokenIn.Amount.GT(tokenInMaxAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "%s token is larger than max amount", tokenInDenom)
+	}
+
+	poolBefore, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	protocolFee, err := pool.ApplySwap(tokenIn, tokenOut, pool.GetPoolSwapFee(), k.GetParams(ctx).ProtocolFeeShare)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	err = k.SetPool(ctx, pool)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	err = k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, sdk.Coins{tokenOut})
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	err = execute(ctx)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	err = k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), sdk.Coins{tokenIn})
+	if err != nil {
+		return sdk.Int{}, sdkerrors.Wrapf(err, "failed to repay %s for the flash swap", tokenIn)
+	}
+
+	protocolFees := sdk.NewCoins(protocolFee)
+	if !protocolFees.Empty() {
+		err = k.distrKeeper.FundCommunityPool(ctx, protocolFees, pool.GetAddress())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		k.RecordProtocolFees(ctx, protocolFees)
+	}
+
+	err = k.checkFlashSwapPoolHealth(ctx, poolBefore)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokensIn := sdk.Coins{tokenIn}
+	tokensOut := sdk.Coins{tokenOut}
+	k.createSwapEvent(ctx, sender, poolId, tokensIn, tokensOut)
+	k.hooks.AfterSwap(ctx, sender, poolId, tokensIn, tokensOut)
+	k.recordSwapStats(ctx, poolId, tokensIn, tokensOut, swapFeeOf(tokenIn, pool.GetPoolSwapFee()), 1)
+	k.trackChangedPool(ctx, poolId)
+	k.RecordTotalLiquidityIncrease(ctx, tokensIn)
+	k.RecordTotalLiquidityDecrease(ctx, tokensOut.Add(protocolFees...))
+
+	return tokenIn.Amount, nil
+}
+
+// checkFlashSwapPoolHealth checks that the pool account holds the pool assets, and, for balancer
+// pools, that the pool constant per share didn't decrease since poolBefore.
+func (k Keeper) checkFlashSwapPoolHealth(ctx sdk.Context, poolBefore types.PoolI) error {
+	pool, err := k.GetPool(ctx, poolBefore.GetId())
+	if err != nil {
+		return err
+	}
+
+	assetCoins, accCoins, ok := poolAccountBalance(ctx, k.bankKeeper, pool)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrFlashSwapUnhealthy, "pool %d asset coins %s don't match account coins %s",
+			pool.GetId(), assetCoins, accCoins)
+	}
+
+	// The weighted product constant only applies to balancer pools
+	if _, ok := pool.(*types.BalancerPool); !ok {
+		return nil
+	}
+	change := constantPerShareChange(poolBefore, pool)
+	if change.GT(sdk.OneDec().Add(errorMargin)) {
+		return sdkerrors.Wrapf(types.ErrFlashSwapUnhealthy, "pool %d product constant per share changed by %s",
+			pool.GetId(), change)
+	}
+	return nil
+}
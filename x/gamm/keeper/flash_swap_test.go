@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestFlashSwap() {
+	// The price of bar is 1 foo in the first pool, and 2 foo in the second pool.
+	poolId := suite.prepareBalancerPoolWithFutureGovernor(acc1.String())
+	otherPoolId := suite.preparePool()
+	gammKeeper := suite.app.GAMMKeeper
+	msgServer := keeper.NewMsgServerImpl(gammKeeper)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	suite.setProtocolFeeShare(sdk.NewDecWithPrec(5, 1))
+
+	// The arbitrageur has no funds to start with.
+	arbitrageur := sdk.AccAddress([]byte("flash_swap_arbitrage"))
+	tokenOut := sdk.NewCoin("bar", sdk.NewInt(10000))
+	arbitrage := func(tokenOutMinAmount sdk.Int) *types.MsgFlashSwap {
+		msg, err := types.NewMsgFlashSwap(arbitrageur, poolId, "foo", sdk.NewInt(20000), tokenOut, []sdk.Msg{
+			&types.MsgSwapExactAmountIn{
+				Sender:            arbitrageur.String(),
+				Routes:            []types.SwapAmountInRoute{{PoolId: otherPoolId, TokenOutDenom: "foo"}},
+				TokenIn:           tokenOut,
+				TokenOutMinAmount: tokenOutMinAmount,
+			},
+		})
+		suite.Require().NoError(err)
+		return msg
+	}
+
+	// The nested swap fails, so nothing changes.
+	poolBefore, err := gammKeeper.GetPool(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	_, err = msgServer.FlashSwap(goCtx, arbitrage(sdk.NewInt(30000)))
+	suite.Require().ErrorIs(err, types.ErrLimitMinAmount)
+	pool, err := gammKeeper.GetPool(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(poolBefore.GetAllPoolAssets(), pool.GetAllPoolAssets())
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, arbitrageur).Empty())
+
+	// Selling the bar in the second pool pays for it in the first pool, swap fee included.
+	res, err := msgServer.FlashSwap(goCtx, arbitrage(sdk.OneInt()))
+	suite.Require().NoError(err)
+	suite.Require().True(res.TokenInAmount.GT(sdk.NewInt(10100)), res.TokenInAmount.String())
+	suite.Require().True(res.TokenInAmount.LT(sdk.NewInt(10300)), res.TokenInAmount.String())
+
+	pool, err = gammKeeper.GetPool(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	protocolFee := gammKeeper.GetProtocolFees(suite.ctx).AmountOf("foo")
+	suite.Require().True(protocolFee.IsPositive())
+	fooBalance, err := pool.GetTokenBalance("foo")
+	suite.Require().NoError(err)
+	suite.Require().Equal(poolBefore.GetAllPoolAssets()[1].Token.Amount.Add(res.TokenInAmount).Sub(protocolFee), fooBalance)
+	barBalance, err := pool.GetTokenBalance("bar")
+	suite.Require().NoError(err)
+	suite.Require().Equal(poolBefore.GetAllPoolAssets()[0].Token.Amount.Sub(tokenOut.Amount), barBalance)
+
+	profit := suite.app.BankKeeper.GetBalance(suite.ctx, arbitrageur, "foo").Amount
+	suite.Require().True(profit.GT(sdk.NewInt(9000)), profit.String())
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, arbitrageur, "bar").IsZero())
+	suite.requireGammInvariants()
+
+	// Selling the bar back to the same pool doesn't pay for it.
+	swapper := sdk.AccAddress([]byte("flash_swap_same_pool"))
+	msg, err := types.NewMsgFlashSwap(swapper, poolId, "foo", sdk.NewInt(20000), tokenOut, []sdk.Msg{
+		&types.MsgSwapExactAmountIn{
+			Sender:            swapper.String(),
+			Routes:            []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: "foo"}},
+			TokenIn:           tokenOut,
+			TokenOutMinAmount: sdk.OneInt(),
+		},
+	})
+	suite.Require().NoError(err)
+	_, err = msgServer.FlashSwap(goCtx, msg)
+	suite.Require().Error(err)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, swapper).Empty())
+
+	// The amount owed is limited by the max amount.
+	_, err = gammKeeper.FlashSwap(suite.ctx, acc2, poolId, "foo", sdk.NewInt(10000), tokenOut, func(ctx sdk.Context) error {
+		return nil
+	})
+	suite.Require().ErrorIs(err, types.ErrLimitMaxAmount)
+
+	// The pool account should hold the pool assets once paid.
+	_, err = gammKeeper.FlashSwap(suite.ctx, acc2, poolId, "foo", sdk.NewInt(20000), tokenOut, func(ctx sdk.Context) error {
+		return suite.app.BankKeeper.SendCoins(ctx, acc2, pool.GetAddress(), sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1))))
+	})
+	suite.Require().ErrorIs(err, types.ErrFlashSwapUnhealthy)
+
+	// Errors of the nested msgs revert the flash swap.
+	errNested := errors.New("nested msg failed")
+	_, err = gammKeeper.FlashSwap(suite.ctx, acc2, poolId, "foo", sdk.NewInt(20000), tokenOut, func(ctx sdk.Context) error {
+		return errNested
+	})
+	suite.Require().ErrorIs(err, errNested)
+	pool, err = gammKeeper.GetPool(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	barBalanceAfter, err := pool.GetTokenBalance("bar")
+	suite.Require().NoError(err)
+	suite.Require().Equal(barBalance, barBalanceAfter)
+	suite.requireGammInvariants()
+
+	// Flash swaps can't be made on pools in batch mode.
+	gammKeeper.SetBatchModePool(suite.ctx, poolId, true)
+	_, err = gammKeeper.FlashSwap(suite.ctx, acc2, poolId, "foo", sdk.NewInt(20000), tokenOut, func(ctx sdk.Context) error {
+		return nil
+	})
+	suite.Require().ErrorIs(err, types.ErrPoolInBatchMode)
+}
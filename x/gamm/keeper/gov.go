@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+func (k Keeper) HandleSetPoolStatusProposal(ctx sdk.Context, p *types.SetPoolStatusProposal) error {
+	return k.UpdatePoolStatus(ctx, p.PoolId, p.Status)
+}
+
+func (k Keeper) HandleMigratePoolProposal(ctx sdk.Context, p *types.MigratePoolProposal) error {
+	_, err := k.MigratePool(ctx, p.PoolId, p.PoolParams, p.PoolAssets, p.FuturePoolGovernor)
+	return err
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+// IsPoolGovernor returns whether the address is the future governor of the pool.
+// The governor is either:
+// * a single address
+// * "<duration>", the holder of the majority of the pool's shares locked for at least duration
+// * "<denom>,<duration>", the holder of the majority of denom locked for at least duration
+// A pool without a governor can't be governed by anyone.
+func (k Keeper) IsPoolGovernor(ctx sdk.Context, pool types.PoolI, addr sdk.AccAddress) bool {
+	governor := pool.GetFuturePoolGovernor()
+	if governor == "" {
+		return false
+	}
+
+	governorAddr, err := sdk.AccAddressFromBech32(governor)
+	if err == nil {
+		return governorAddr.Equals(addr)
+	}
+
+	denom := types.GetPoolShareDenom(pool.GetId())
+	lockTimeStr := governor
+	splits := strings.Split(governor, ",")
+	if len(splits) == 2 {
+		denom = splits[0]
+		lockTimeStr = splits[1]
+	}
+
+	lockTime, err := time.ParseDuration(lockTimeStr)
+	if err != nil {
+		return false
+	}
+
+	lockedByAddr := sdk.ZeroInt()
+	for _, lock := range k.lockupKeeper.GetAccountLockedLongerDurationDenom(ctx, addr, denom, lockTime) {
+		lockedByAddr = lockedByAddr.Add(lock.Coins.AmountOf(denom))
+	}
+
+	totalLocked := k.lockupKeeper.GetLockedDenom(ctx, denom, lockTime)
+	return lockedByAddr.IsPositive() && lockedByAddr.MulRaw(2).GT(totalLocked)
+}
+
+func (k Keeper) getGovernedPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) (types.PoolI, error) {
+	pool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.IsPoolGovernor(ctx, pool, sender) {
+		return nil, sdkerrors.Wrapf(types.ErrNotPoolGovernor, "%s is not the governor of pool %d", sender, poolId)
+	}
+
+	return pool, nil
+}
+
+// SetPoolSwapFee sets the swap fee of a pool, on behalf of its governor.
+func (k Keeper) SetPoolSwapFee(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, swapFee sdk.Dec) error {
+	pool, err := k.getGovernedPool(ctx, sender, poolId)
+	if err != nil {
+		return err
+	}
+
+	err = pool.SetPoolSwapFee(swapFee)
+	if err != nil {
+		return err
+	}
+
+	return k.SetPool(ctx, pool)
+}
+
+// SetPoolExitFee sets the exit fee of a pool, on behalf of its governor.
+func (k Keeper) SetPoolExitFee(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, exitFee sdk.Dec) error {
+	pool, err := k.getGovernedPool(ctx, sender, poolId)
+	if err != nil {
+		return err
+	}
+
+	err = pool.SetPoolExitFee(exitFee)
+	if err != nil {
+		return err
+	}
+
+	return k.SetPool(ctx, pool)
+}
+
+// ScheduleWeightChange schedules a smooth weight change of a pool, on behalf of its governor.
+// The change starts from the pool's weights at the current block, and replaces any change in progress.
+func (k Keeper) ScheduleWeightChange(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, startTime time.Time, duration time.Duration, targetWeights []types.PoolAsset) error {
+	pool, err := k.getGovernedPool(ctx, sender, poolId)
+	if err != nil {
+		return err
+	}
+
+	if startTime.Before(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidWeightChangeStart, "start time %s is before block time %s", startTime, ctx.BlockTime())
+	}
+
+	err = pool.ScheduleWeightChange(startTime, duration, targetWeights)
+	if err != nil {
+		return err
+	}
+
+	return k.SetPool(ctx, pool)
+}
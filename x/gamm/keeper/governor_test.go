@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) prepareBalancerPoolWithFutureGovernor(futureGovernor string) uint64 {
+	// Mint some assets to the accounts.
+	for _, acc := range []sdk.AccAddress{acc1, acc2, acc3} {
+		err := suite.app.BankKeeper.AddCoins(
+			suite.ctx,
+			acc,
+			sdk.NewCoins(
+				sdk.NewCoin("uosmo", sdk.NewInt(10000000000)),
+				sdk.NewCoin("foo", sdk.NewInt(10000000)),
+				sdk.NewCoin("bar", sdk.NewInt(10000000)),
+			),
+		)
+		suite.Require().NoError(err)
+	}
+
+	poolId, err := suite.app.GAMMKeeper.CreateBalancerPool(suite.ctx, acc1, types.BalancerPoolParams{
+		SwapFee: sdk.NewDecWithPrec(1, 2),
+		ExitFee: sdk.NewDecWithPrec(1, 2),
+	}, []types.PoolAsset{
+		{
+			Weight: sdk.NewInt(100),
+			Token:  sdk.NewCoin("foo", sdk.NewInt(5000000)),
+		},
+		{
+			Weight: sdk.NewInt(100),
+			Token:  sdk.NewCoin("bar", sdk.NewInt(5000000)),
+		},
+	}, futureGovernor)
+	suite.Require().NoError(err)
+	return poolId
+}
+
+func (suite *KeeperTestSuite) TestPoolGovernor() {
+	tests := []struct {
+		name           string
+		futureGovernor string
+		// locks the pool shares of acc1 and acc2 for the given durations, if non-zero
+		acc1LockDuration time.Duration
+		acc2LockDuration time.Duration
+		acc2ShareAmount  sdk.Int
+		expectGovernor   sdk.AccAddress
+	}{
+		{
+			name:           "no governor",
+			futureGovernor: "",
+		},
+		{
+			name:           "address governor",
+			futureGovernor: acc2.String(),
+			expectGovernor: acc2,
+		},
+		{
+			name:             "majority of shares locked long enough",
+			futureGovernor:   "24h",
+			acc1LockDuration: 24 * time.Hour,
+			acc2LockDuration: 24 * time.Hour,
+			acc2ShareAmount:  types.InitPoolSharesSupply.QuoRaw(2),
+			expectGovernor:   acc1,
+		},
+		{
+			name:             "majority of shares not locked long enough",
+			futureGovernor:   "24h",
+			acc1LockDuration: time.Hour,
+			acc2LockDuration: 24 * time.Hour,
+			acc2ShareAmount:  types.InitPoolSharesSupply.QuoRaw(2),
+			expectGovernor:   acc2,
+		},
+		{
+			name:             "no majority",
+			futureGovernor:   "24h",
+			acc1LockDuration: 24 * time.Hour,
+			acc2LockDuration: 24 * time.Hour,
+			acc2ShareAmount:  types.InitPoolSharesSupply,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+		keeper := suite.app.GAMMKeeper
+
+		poolId := suite.prepareBalancerPoolWithFutureGovernor(test.futureGovernor)
+		pool, err := keeper.GetPool(suite.ctx, poolId)
+		suite.Require().NoError(err)
+		shareDenom := pool.GetTotalShares().Denom
+
+		if test.acc2LockDuration > 0 {
+			err = keeper.JoinPool(suite.ctx, acc2, poolId, test.acc2ShareAmount, sdk.Coins{})
+			suite.Require().NoError(err, "test: %v", test.name)
+			_, err = suite.app.LockupKeeper.LockTokens(suite.ctx, acc2,
+				sdk.Coins{sdk.NewCoin(shareDenom, test.acc2ShareAmount)}, test.acc2LockDuration)
+			suite.Require().NoError(err, "test: %v", test.name)
+		}
+		if test.acc1LockDuration > 0 {
+			_, err = suite.app.LockupKeeper.LockTokens(suite.ctx, acc1,
+				sdk.Coins{sdk.NewCoin(shareDenom, types.InitPoolSharesSupply)}, test.acc1LockDuration)
+			suite.Require().NoError(err, "test: %v", test.name)
+		}
+
+		for _, acc := range []sdk.AccAddress{acc1, acc2, acc3} {
+			isGovernor := keeper.IsPoolGovernor(suite.ctx, pool, acc)
+			suite.Require().Equal(acc.Equals(test.expectGovernor), isGovernor, "test: %v", test.name)
+
+			err = keeper.SetPoolSwapFee(suite.ctx, acc, poolId, sdk.NewDecWithPrec(2, 2))
+			if isGovernor {
+				suite.Require().NoError(err, "test: %v", test.name)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrNotPoolGovernor, "test: %v", test.name)
+			}
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetPoolFees() {
+	keeper := suite.app.GAMMKeeper
+	poolId := suite.prepareBalancerPoolWithFutureGovernor(acc1.String())
+
+	err := keeper.SetPoolSwapFee(suite.ctx, acc1, poolId, sdk.NewDecWithPrec(3, 2))
+	suite.Require().NoError(err)
+	err = keeper.SetPoolExitFee(suite.ctx, acc1, poolId, sdk.NewDecWithPrec(4, 2))
+	suite.Require().NoError(err)
+
+	pool, err := keeper.GetPool(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(3, 2), pool.GetPoolSwapFee())
+	suite.Require().Equal(sdk.NewDecWithPrec(4, 2), pool.GetPoolExitFee())
+
+	err = keeper.SetPoolSwapFee(suite.ctx, acc1, poolId, sdk.OneDec())
+	suite.Require().ErrorIs(err, types.ErrTooMuchSwapFee)
+	err = keeper.SetPoolExitFee(suite.ctx, acc1, poolId, sdk.NewDec(-1))
+	suite.Require().ErrorIs(err, types.ErrNegativeExitFee)
+}
+
+func (suite *KeeperTestSuite) TestScheduleWeightChange() {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	suite.ctx = suite.ctx.WithBlockTime(startTime)
+	keeper := suite.app.GAMMKeeper
+	poolId := suite.prepareBalancerPoolWithFutureGovernor(acc1.String())
+
+	targetWeights := []types.PoolAsset{
+		{Weight: sdk.NewInt(300), Token: sdk.NewCoin("foo", sdk.ZeroInt())},
+		{Weight: sdk.NewInt(100), Token: sdk.NewCoin("bar", sdk.ZeroInt())},
+	}
+
+	err := keeper.ScheduleWeightChange(suite.ctx, acc2, poolId, startTime, time.Hour, targetWeights)
+	suite.Require().ErrorIs(err, types.ErrNotPoolGovernor)
+	err = keeper.ScheduleWeightChange(suite.ctx, acc1, poolId, startTime.Add(-time.Second), time.Hour, targetWeights)
+	suite.Require().ErrorIs(err, types.ErrInvalidWeightChangeStart)
+	err = keeper.ScheduleWeightChange(suite.ctx, acc1, poolId, startTime, time.Hour, targetWeights[:1])
+	suite.Require().ErrorIs(err, types.ErrPoolParamsInvalidNumDenoms)
+
+	err = keeper.ScheduleWeightChange(suite.ctx, acc1, poolId, startTime, time.Hour, targetWeights)
+	suite.Require().NoError(err)
+
+	weightOf := func(denom string) sdk.Int {
+		pool, err := keeper.GetPool(suite.ctx, poolId)
+		suite.Require().NoError(err)
+		weight, err := pool.GetTokenWeight(denom)
+		suite.Require().NoError(err)
+		return weight.QuoRaw(types.GuaranteedWeightPrecision)
+	}
+
+	// Halfway through, the weights are halfway between the initial and target weights.
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(30 * time.Minute))
+	suite.Require().Equal(sdk.NewInt(200), weightOf("foo"))
+	suite.Require().Equal(sdk.NewInt(100), weightOf("bar"))
+
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(2 * time.Hour))
+	suite.Require().Equal(sdk.NewInt(300), weightOf("foo"))
+	suite.Require().Equal(sdk.NewInt(100), weightOf("bar"))
+
+	// Stableswap pools have no weights to change.
+	stableswapPoolId, err := keeper.CreateStableswapPool(suite.ctx, acc1, types.StableswapPoolParams{
+		SwapFee: sdk.NewDec(0),
+		ExitFee: sdk.NewDec(0),
+	}, sdk.NewCoins(
+		sdk.NewCoin("foo", sdk.NewInt(5000000)),
+		sdk.NewCoin("bar", sdk.NewInt(5000000)),
+	), 100, acc1.String())
+	suite.Require().NoError(err)
+	err = keeper.ScheduleWeightChange(suite.ctx, acc1, stableswapPoolId, suite.ctx.BlockTime(), time.Hour, targetWeights)
+	suite.Require().ErrorIs(err, types.ErrUnsupportedPoolOperation)
+}
@@ -49,12 +49,12 @@ func (k Keeper) Pool(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	switch pool := pool.(type) {
-	case *types.BalancerPool:
+	case *types.BalancerPool, *types.StableswapPool, *types.ConcentratedPool:
 		any, err := codectypes.NewAnyWithValue(pool)
 		if err != nil {
 			return nil, err
 		}
-		return &types.QueryPoolResponse{Pool: any}, nil
+		return &types.QueryPoolResponse{Pool: any, Status: k.GetPoolStatus(sdkCtx, req.PoolId)}, nil
 	default:
 		return nil, status.Error(codes.Internal, "invalid type of pool")
 	}
@@ -73,6 +73,7 @@ func (k Keeper) Pools(
 	poolStore := prefix.NewStore(store, types.KeyPrefixPools)
 
 	var anys []*codectypes.Any
+	var statuses []types.PoolStatus
 	pageRes, err := query.Paginate(poolStore, req.Pagination, func(_, value []byte) error {
 		poolI, err := k.UnmarshalPool(value)
 		if err != nil {
@@ -85,17 +86,18 @@ func (k Keeper) Pools(
 			return err
 		}
 
-		// TODO: pools query should not be balancer specific
-		pool, ok := poolI.(*types.BalancerPool)
-		if !ok {
-			return fmt.Errorf("pool (%d) is not basic pool", pool.GetId())
+		switch poolI.(type) {
+		case *types.BalancerPool, *types.StableswapPool, *types.ConcentratedPool:
+		default:
+			return fmt.Errorf("pool (%d) is of an unknown pool type", poolI.GetId())
 		}
 
-		any, err := codectypes.NewAnyWithValue(pool)
+		any, err := codectypes.NewAnyWithValue(poolI)
 		if err != nil {
 			return err
 		}
 		anys = append(anys, any)
+		statuses = append(statuses, k.GetPoolStatus(sdkCtx, poolI.GetId()))
 		return nil
 	})
 
@@ -106,6 +108,7 @@ func (k Keeper) Pools(
 	return &types.QueryPoolsResponse{
 		Pools:      anys,
 		Pagination: pageRes,
+		Statuses:   statuses,
 	}, nil
 }
 
@@ -135,21 +138,35 @@ func (k Keeper) PoolParams(ctx context.Context, req *types.QueryPoolParamsReques
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	// TODO: add
-	balancerPool, ok := pool.(*types.BalancerPool)
-	if !ok {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
 
-	return &types.QueryPoolParamsResponse{
-		Params: &types.QueryPoolParamsResponse_BalancerPoolParams{
-			BalancerPoolParams: &types.BalancerPoolParams{
-				SwapFee:                  balancerPool.GetPoolExitFee(),
-				ExitFee:                  balancerPool.GetPoolExitFee(),
-				SmoothWeightChangeParams: balancerPool.PoolParams.SmoothWeightChangeParams,
+	switch pool := pool.(type) {
+	case *types.BalancerPool:
+		return &types.QueryPoolParamsResponse{
+			Params: &types.QueryPoolParamsResponse_BalancerPoolParams{
+				BalancerPoolParams: &types.BalancerPoolParams{
+					SwapFee:                  pool.GetPoolSwapFee(),
+					ExitFee:                  pool.GetPoolExitFee(),
+					SmoothWeightChangeParams: pool.PoolParams.SmoothWeightChangeParams,
+				},
 			},
-		},
-	}, nil
+		}, nil
+	case *types.StableswapPool:
+		poolParams := pool.GetPoolParams()
+		return &types.QueryPoolParamsResponse{
+			Params: &types.QueryPoolParamsResponse_StableswapPoolParams{
+				StableswapPoolParams: &poolParams,
+			},
+		}, nil
+	case *types.ConcentratedPool:
+		poolParams := pool.GetPoolParams()
+		return &types.QueryPoolParamsResponse{
+			Params: &types.QueryPoolParamsResponse_ConcentratedPoolParams{
+				ConcentratedPoolParams: &poolParams,
+			},
+		}, nil
+	default:
+		return nil, status.Error(codes.Internal, "invalid type of pool")
+	}
 }
 
 func (k Keeper) TotalShares(ctx context.Context, req *types.QueryTotalSharesRequest) (*types.QueryTotalSharesResponse, error) {
@@ -231,6 +248,48 @@ func (k Keeper) TotalLiquidity(ctx context.Context, req *types.QueryTotalLiquidi
 	}, nil
 }
 
+func (k Keeper) PoolCreationFee(ctx context.Context, req *types.QueryPoolCreationFeeRequest) (*types.QueryPoolCreationFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	record, err := k.GetPoolCreationFeeRecord(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryPoolCreationFeeResponse{Record: record}, nil
+}
+
+func (k Keeper) ProtocolFees(ctx context.Context, req *types.QueryProtocolFeesRequest) (*types.QueryProtocolFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryProtocolFeesResponse{
+		ProtocolFees: k.GetProtocolFees(sdkCtx),
+	}, nil
+}
+
+func (k Keeper) BatchResult(ctx context.Context, req *types.QueryBatchResultRequest) (*types.QueryBatchResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	result, err := k.GetBatchResult(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryBatchResultResponse{BatchResult: result}, nil
+}
+
 func (k Keeper) EstimateSwapExactAmountIn(ctx context.Context, req *types.QuerySwapExactAmountInRequest) (*types.QuerySwapExactAmountInResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -308,3 +367,418 @@ func (k Keeper) EstimateSwapExactAmountOut(ctx context.Context, req *types.Query
 		TokenInAmount: tokenInAmount,
 	}, nil
 }
+
+func (k Keeper) EstimateJoinPool(ctx context.Context, req *types.QueryJoinPoolRequest) (*types.QueryJoinPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := parseEstimateSender(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	shareOutAmount, err := parseEstimateShareAmount(req.ShareOutAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, _ := sdkCtx.CacheContext()
+
+	liquidityBefore, err := k.poolLiquidity(cacheCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = k.JoinPool(cacheCtx, sender, req.PoolId, shareOutAmount, sdk.Coins{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	liquidityAfter, err := k.poolLiquidity(cacheCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryJoinPoolResponse{
+		TokensIn: liquidityAfter.Sub(liquidityBefore),
+	}, nil
+}
+
+func (k Keeper) EstimateJoinSwapExternAmountIn(ctx context.Context, req *types.QueryJoinSwapExternAmountInRequest) (*types.QueryJoinSwapExternAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := parseEstimateSender(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, _ := sdkCtx.CacheContext()
+
+	shareOutAmount, err := k.JoinSwapExternAmountIn(cacheCtx, sender, req.PoolId, tokenIn, sdk.OneInt())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryJoinSwapExternAmountInResponse{
+		ShareOutAmount: shareOutAmount,
+	}, nil
+}
+
+func (k Keeper) EstimateJoinSwapShareAmountOut(ctx context.Context, req *types.QueryJoinSwapShareAmountOutRequest) (*types.QueryJoinSwapShareAmountOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := parseEstimateSender(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sdk.ValidateDenom(req.TokenInDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom: %s", err.Error())
+	}
+
+	shareOutAmount, err := parseEstimateShareAmount(req.ShareOutAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, _ := sdkCtx.CacheContext()
+
+	tokenInAmount, err := k.JoinSwapShareAmountOut(cacheCtx, sender, req.PoolId, req.TokenInDenom, shareOutAmount, sdkIntMaxValue)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryJoinSwapShareAmountOutResponse{
+		TokenInAmount: tokenInAmount,
+	}, nil
+}
+
+func (k Keeper) EstimateExitPool(ctx context.Context, req *types.QueryExitPoolRequest) (*types.QueryExitPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := parseEstimateSender(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	shareInAmount, err := parseEstimateShareAmount(req.ShareInAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, _ := sdkCtx.CacheContext()
+
+	exitFee, err := k.exitFee(cacheCtx, req.PoolId, shareInAmount)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	liquidityBefore, err := k.poolLiquidity(cacheCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = k.ExitPool(cacheCtx, sender, req.PoolId, shareInAmount, sdk.Coins{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	liquidityAfter, err := k.poolLiquidity(cacheCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExitPoolResponse{
+		TokensOut: liquidityBefore.Sub(liquidityAfter),
+		ExitFee:   exitFee,
+	}, nil
+}
+
+func (k Keeper) EstimateExitSwapShareAmountIn(ctx context.Context, req *types.QueryExitSwapShareAmountInRequest) (*types.QueryExitSwapShareAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := parseEstimateSender(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sdk.ValidateDenom(req.TokenOutDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom: %s", err.Error())
+	}
+
+	shareInAmount, err := parseEstimateShareAmount(req.ShareInAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, _ := sdkCtx.CacheContext()
+
+	exitFee, err := k.exitFee(cacheCtx, req.PoolId, shareInAmount)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	tokenOutAmount, err := k.ExitSwapShareAmountIn(cacheCtx, sender, req.PoolId, req.TokenOutDenom, shareInAmount, sdk.OneInt())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExitSwapShareAmountInResponse{
+		TokenOutAmount: tokenOutAmount,
+		ExitFee:        exitFee,
+	}, nil
+}
+
+func (k Keeper) EstimateExitSwapExternAmountOut(ctx context.Context, req *types.QueryExitSwapExternAmountOutRequest) (*types.QueryExitSwapExternAmountOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := parseEstimateSender(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOut, err := sdk.ParseCoinNormalized(req.TokenOut)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, _ := sdkCtx.CacheContext()
+
+	shareInAmount, err := k.ExitSwapExternAmountOut(cacheCtx, sender, req.PoolId, tokenOut, sdkIntMaxValue)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	exitFee, err := k.exitFee(cacheCtx, req.PoolId, shareInAmount)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExitSwapExternAmountOutResponse{
+		ShareInAmount: shareInAmount,
+		ExitFee:       exitFee,
+	}, nil
+}
+
+// parseEstimateSender parses the sender of an estimate query, whose balances the estimated msg uses.
+func parseEstimateSender(sender string) (sdk.AccAddress, error) {
+	if sender == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+	return addr, nil
+}
+
+// parseEstimateShareAmount parses the positive share amount of an estimate query.
+func parseEstimateShareAmount(amount string) (sdk.Int, error) {
+	shareAmount, ok := sdk.NewIntFromString(amount)
+	if !ok || !shareAmount.IsPositive() {
+		return sdk.Int{}, status.Errorf(codes.InvalidArgument, "invalid share amount: %s", amount)
+	}
+	return shareAmount, nil
+}
+
+// poolLiquidity returns the assets of a pool as coins.
+func (k Keeper) poolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
+	pool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	return types.PoolAssetsCoins(pool.GetAllPoolAssets()), nil
+}
+
+// exitFee returns the shares taken as exit fee when exiting a pool with shareInAmount.
+func (k Keeper) exitFee(ctx sdk.Context, poolId uint64, shareInAmount sdk.Int) (sdk.Int, error) {
+	pool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return pool.GetPoolExitFee().MulInt(shareInAmount).TruncateInt(), nil
+}
+
+func (k Keeper) EstimateBestRoute(ctx context.Context, req *types.QueryEstimateBestRouteRequest) (*types.QueryEstimateBestRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	if err := sdk.ValidateDenom(req.TokenOutDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	routes, tokenOutAmount, err := k.FindBestSplitRoutes(sdkCtx, tokenIn, req.TokenOutDenom, int(req.MaxHops), int(req.MaxSplits))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEstimateBestRouteResponse{
+		Routes:         routes,
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
+
+func (k Keeper) Position(ctx context.Context, req *types.QueryPositionRequest) (*types.QueryPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	position, err := k.GetPosition(sdkCtx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryPositionResponse{Position: position}, nil
+}
+
+func (k Keeper) AccountPositions(ctx context.Context, req *types.QueryAccountPositionsRequest) (*types.QueryAccountPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	positions, err := k.GetAccountPositions(sdkCtx, owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountPositionsResponse{Positions: positions}, nil
+}
+
+func (k Keeper) LimitOrder(ctx context.Context, req *types.QueryLimitOrderRequest) (*types.QueryLimitOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	order, err := k.GetLimitOrder(sdkCtx, req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryLimitOrderResponse{LimitOrder: order}, nil
+}
+
+func (k Keeper) AccountLimitOrders(ctx context.Context, req *types.QueryAccountLimitOrdersRequest) (*types.QueryAccountLimitOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	orders, err := k.GetAccountLimitOrders(sdkCtx, owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountLimitOrdersResponse{LimitOrders: orders}, nil
+}
+
+func (k Keeper) PoolLimitOrders(ctx context.Context, req *types.QueryPoolLimitOrdersRequest) (*types.QueryPoolLimitOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	orders, err := k.GetPoolLimitOrders(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolLimitOrdersResponse{LimitOrders: orders}, nil
+}
+
+func (k Keeper) PoolStats(ctx context.Context, req *types.QueryPoolStatsRequest) (*types.QueryPoolStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.FromEpoch < 0 || req.ToEpoch < req.FromEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid epoch range: %d to %d", req.FromEpoch, req.ToEpoch)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	_, err := k.GetPool(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryPoolStatsResponse{
+		Stats:        k.GetPoolStats(sdkCtx, req.PoolId, req.FromEpoch, req.ToEpoch),
+		CurrentEpoch: k.GetCurrentStatsEpoch(sdkCtx),
+	}, nil
+}
+
+func (k Keeper) Twap(ctx context.Context, req *types.QueryTwapRequest) (*types.QueryTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.BaseAsset == "" || req.QuoteAsset == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Default to the current block time if no end time is given.
+	endTime := sdkCtx.BlockTime()
+	if req.EndTime != nil {
+		endTime = *req.EndTime
+	}
+
+	twap, err := k.ArithmeticTwap(sdkCtx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, endTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTwapResponse{
+		ArithmeticTwap: twap,
+	}, nil
+}
@@ -229,3 +229,91 @@ func (suite *KeeperTestSuite) TestQuerySpotPrice() {
 	suite.NoError(err)
 	suite.Equal(sdk.NewDec(1).Quo(sdk.NewDec(3)).String(), res.SpotPrice)
 }
+
+func (suite *KeeperTestSuite) TestQueryEstimateJoinExit() {
+	queryClient := suite.queryClient
+	poolId := suite.prepareBalancerPoolWithFutureGovernor(acc1.String())
+	gammKeeper := suite.app.GAMMKeeper
+	shareDenom := types.GetPoolShareDenom(poolId)
+	shares := types.OneShare.MulRaw(10)
+
+	balances := func() sdk.Coins {
+		return suite.app.BankKeeper.GetAllBalances(suite.ctx, acc2)
+	}
+
+	// Each estimate matches the msg run afterwards, and doesn't change the state itself.
+	joinPool, err := queryClient.EstimateJoinPool(gocontext.Background(), &types.QueryJoinPoolRequest{
+		Sender: acc2.String(), PoolId: poolId, ShareOutAmount: shares.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(joinPool.TokensIn, 2)
+	balancesBefore := balances()
+	suite.Require().True(balancesBefore.AmountOf(shareDenom).IsZero())
+	err = gammKeeper.JoinPool(suite.ctx, acc2, poolId, shares, sdk.Coins{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(balancesBefore.Sub(joinPool.TokensIn).Add(sdk.NewCoin(shareDenom, shares)), balances())
+
+	tokenIn := sdk.NewCoin("foo", sdk.NewInt(100000))
+	joinSwapExternAmountIn, err := queryClient.EstimateJoinSwapExternAmountIn(gocontext.Background(), &types.QueryJoinSwapExternAmountInRequest{
+		Sender: acc2.String(), PoolId: poolId, TokenIn: tokenIn.String(),
+	})
+	suite.Require().NoError(err)
+	shareOutAmount, err := gammKeeper.JoinSwapExternAmountIn(suite.ctx, acc2, poolId, tokenIn, sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(shareOutAmount, joinSwapExternAmountIn.ShareOutAmount)
+
+	balancesBefore = balances()
+	joinSwapShareAmountOut, err := queryClient.EstimateJoinSwapShareAmountOut(gocontext.Background(), &types.QueryJoinSwapShareAmountOutRequest{
+		Sender: acc2.String(), PoolId: poolId, TokenInDenom: "bar", ShareOutAmount: shares.String(),
+	})
+	suite.Require().NoError(err)
+	tokenInAmount, err := gammKeeper.JoinSwapShareAmountOut(suite.ctx, acc2, poolId, "bar", shares, joinSwapShareAmountOut.TokenInAmount)
+	suite.Require().NoError(err)
+	suite.Require().Equal(tokenInAmount, joinSwapShareAmountOut.TokenInAmount)
+	suite.Require().Equal(balancesBefore.AmountOf("bar").Sub(tokenInAmount), balances().AmountOf("bar"))
+
+	// The exit fee is 1% of the shares in.
+	balancesBefore = balances()
+	exitPool, err := queryClient.EstimateExitPool(gocontext.Background(), &types.QueryExitPoolRequest{
+		Sender: acc2.String(), PoolId: poolId, ShareInAmount: shares.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(shares.QuoRaw(100), exitPool.ExitFee)
+	err = gammKeeper.ExitPool(suite.ctx, acc2, poolId, shares, sdk.Coins{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(balancesBefore.Add(exitPool.TokensOut...).Sub(sdk.NewCoins(sdk.NewCoin(shareDenom, shares))), balances())
+
+	exitSwapShareAmountIn, err := queryClient.EstimateExitSwapShareAmountIn(gocontext.Background(), &types.QueryExitSwapShareAmountInRequest{
+		Sender: acc2.String(), PoolId: poolId, TokenOutDenom: "foo", ShareInAmount: shares.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(shares.QuoRaw(100), exitSwapShareAmountIn.ExitFee)
+	tokenOutAmount, err := gammKeeper.ExitSwapShareAmountIn(suite.ctx, acc2, poolId, "foo", shares, sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(tokenOutAmount, exitSwapShareAmountIn.TokenOutAmount)
+
+	tokenOut := sdk.NewCoin("bar", sdk.NewInt(1000))
+	exitSwapExternAmountOut, err := queryClient.EstimateExitSwapExternAmountOut(gocontext.Background(), &types.QueryExitSwapExternAmountOutRequest{
+		Sender: acc2.String(), PoolId: poolId, TokenOut: tokenOut.String(),
+	})
+	suite.Require().NoError(err)
+	shareInAmount, err := gammKeeper.ExitSwapExternAmountOut(suite.ctx, acc2, poolId, tokenOut, types.OneShare.MulRaw(100))
+	suite.Require().NoError(err)
+	suite.Require().Equal(shareInAmount, exitSwapExternAmountOut.ShareInAmount)
+	suite.Require().Equal(sdk.NewDecWithPrec(1, 2).MulInt(shareInAmount).TruncateInt(), exitSwapExternAmountOut.ExitFee)
+	suite.requireGammInvariants()
+
+	// Estimates fail like the msgs do.
+	_, err = queryClient.EstimateExitPool(gocontext.Background(), &types.QueryExitPoolRequest{
+		Sender: acc2.String(), PoolId: poolId, ShareInAmount: types.OneShare.MulRaw(50).String(),
+	})
+	suite.Require().Error(err)
+	_, err = queryClient.EstimateJoinPool(gocontext.Background(), &types.QueryJoinPoolRequest{
+		Sender: acc2.String(), PoolId: poolId, ShareOutAmount: "-1",
+	})
+	suite.Require().Error(err)
+	_, err = queryClient.EstimateJoinSwapExternAmountIn(gocontext.Background(), &types.QueryJoinSwapExternAmountInRequest{
+		PoolId: poolId, TokenIn: tokenIn.String(),
+	})
+	suite.Require().Error(err)
+}
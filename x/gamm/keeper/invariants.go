@@ -11,12 +11,16 @@ import (
 	"github.com/osmosis-labs/osmosis/x/gamm/types"
 )
 
-const poolBalanceInvariantName = "pool-account-balance-equals-expected"
+const (
+	poolBalanceInvariantName    = "pool-account-balance-equals-expected"
+	totalLiquidityInvariantName = "total-liquidity-equals-pool-assets"
+)
 
 // RegisterInvariants registers all governance invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper, bk types.BankKeeper) {
 	ir.RegisterRoute(types.ModuleName, poolBalanceInvariantName, PoolAccountInvariant(keeper, bk))
 	ir.RegisterRoute(types.ModuleName, "pool-total-weight", PoolTotalWeightInvariant(keeper, bk))
+	ir.RegisterRoute(types.ModuleName, totalLiquidityInvariantName, TotalLiquidityInvariant(keeper))
 	// ir.RegisterRoute(types.ModuleName, "pool-product-constant", PoolProductConstantInvariant(keeper))
 	// ir.RegisterRoute(types.ModuleName, "spot-price", SpotPriceInvariant(keeper, bk))
 }
@@ -32,6 +36,10 @@ func AllInvariants(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 		if broke {
 			return msg, broke
 		}
+		msg, broke = TotalLiquidityInvariant(keeper)(ctx)
+		if broke {
+			return msg, broke
+		}
 		return PoolTotalWeightInvariant(keeper, bk)(ctx)
 	}
 }
@@ -47,9 +55,8 @@ func PoolAccountInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 		}
 
 		for _, pool := range pools {
-			assetCoins := types.PoolAssetsCoins(pool.GetAllPoolAssets())
-			accCoins := bk.GetAllBalances(ctx, pool.GetAddress())
-			if !assetCoins.IsEqual(accCoins) {
+			assetCoins, accCoins, ok := poolAccountBalance(ctx, bk, pool)
+			if !ok {
 				return sdk.FormatInvariant(types.ModuleName, poolBalanceInvariantName,
 					fmt.Sprintf("\tgamm pool id %d\n\tasset coins: %s\n\taccount coins: %s\n",
 						pool.GetId(), assetCoins, accCoins)), true
@@ -61,6 +68,41 @@ func PoolAccountInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 	}
 }
 
+// poolAccountBalance returns the pool assets and the pool account balance, and whether they match.
+func poolAccountBalance(ctx sdk.Context, bk types.BankKeeper, pool types.PoolI) (assetCoins, accCoins sdk.Coins, ok bool) {
+	assetCoins = types.PoolAssetsCoins(pool.GetAllPoolAssets())
+	accCoins = bk.GetAllBalances(ctx, pool.GetAddress())
+	return assetCoins, accCoins, assetCoins.IsEqual(accCoins)
+}
+
+// TotalLiquidityInvariant checks that the recorded total liquidity reflects the sum of
+// pool assets. Swap fees diverted to the community pool leave the pools, and are
+// deducted from the total liquidity.
+func TotalLiquidityInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := keeper.GetPools(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, totalLiquidityInvariantName,
+				fmt.Sprintf("\tgamm pool retrieval failed")), true
+		}
+
+		assetCoins := sdk.Coins{}
+		for _, pool := range pools {
+			assetCoins = assetCoins.Add(types.PoolAssetsCoins(pool.GetAllPoolAssets())...)
+		}
+
+		totalLiquidity := keeper.GetTotalLiquidity(ctx)
+		if !assetCoins.IsEqual(totalLiquidity) {
+			return sdk.FormatInvariant(types.ModuleName, totalLiquidityInvariantName,
+				fmt.Sprintf("\tpool asset coins: %s\n\ttotal liquidity: %s\n\tprotocol fees: %s\n",
+					assetCoins, totalLiquidity, keeper.GetProtocolFees(ctx))), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, totalLiquidityInvariantName,
+			fmt.Sprintf("\tgamm total liquidity matches all pool asset coins\n")), false
+	}
+}
+
 // PoolTotalWeightInvariant checks that the pool total weight reflect the sum of
 // pool weights
 func PoolTotalWeightInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
@@ -88,6 +130,9 @@ func PoolTotalWeightInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant
 	}
 }
 
+// Don't EVER change after initializing
+var powPrecision, _ = sdk.NewDecFromStr("0.00000001")
+
 func genericPow(base, exp sdk.Dec) sdk.Dec {
 	if !base.GTE(sdk.NewDec(2)) {
 		return osmomath.Pow(base, exp)
@@ -115,6 +160,12 @@ func constantChange(p1, p2 types.PoolI) sdk.Dec {
 	return product
 }
 
+// constantPerShareChange returns the multiplicative factor difference in the pool constant per share,
+// between two different pools. It is greater than one if the shares of the second pool are worth less.
+func constantPerShareChange(p1, p2 types.PoolI) sdk.Dec {
+	return constantChange(p1, p2).MulInt(p2.GetTotalShares().Amount).QuoInt(p1.GetTotalShares().Amount)
+}
+
 var (
 	errorMargin, _ = sdk.NewDecFromStr("0.0001") // 0.01%
 )
@@ -134,6 +185,11 @@ func PoolProductConstantInvariant(keeper Keeper) sdk.Invariant {
 		}
 
 		for _, pool := range newpools {
+			// The weighted product constant only applies to balancer pools
+			if _, ok := pool.(*types.BalancerPool); !ok {
+				continue
+			}
+
 			oldpool, ok := pools[pool.GetId()]
 			if !ok {
 				pools[pool.GetId()] = pool
@@ -97,3 +97,33 @@ func (suite *KeeperTestSuite) preparePool() uint64 {
 
 	return poolId
 }
+
+func (suite *KeeperTestSuite) prepareStableswapPool(amplificationParameter uint64) uint64 {
+	// Mint some assets to the accounts.
+	for _, acc := range []sdk.AccAddress{acc1, acc2, acc3} {
+		err := suite.app.BankKeeper.AddCoins(
+			suite.ctx,
+			acc,
+			sdk.NewCoins(
+				sdk.NewCoin("uosmo", sdk.NewInt(10000000000)),
+				sdk.NewCoin("foo", sdk.NewInt(10000000)),
+				sdk.NewCoin("bar", sdk.NewInt(10000000)),
+				sdk.NewCoin("baz", sdk.NewInt(10000000)),
+			),
+		)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	poolId, err := suite.app.GAMMKeeper.CreateStableswapPool(suite.ctx, acc1, types.StableswapPoolParams{
+		SwapFee: sdk.NewDec(0),
+		ExitFee: sdk.NewDec(0),
+	}, sdk.NewCoins(
+		sdk.NewCoin("foo", sdk.NewInt(5000000)),
+		sdk.NewCoin("bar", sdk.NewInt(5000000)),
+		sdk.NewCoin("baz", sdk.NewInt(5000000)),
+	), amplificationParameter, "")
+	suite.NoError(err)
+	return poolId
+}
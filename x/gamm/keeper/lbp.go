@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+const (
+	defaultLBPPricePoints = 10
+	maxLBPPricePoints     = 100
+)
+
+// getLBP returns the liquidity bootstrapping pool with the given id.
+func (k Keeper) getLBP(ctx sdk.Context, poolId uint64) (*types.BalancerPool, error) {
+	pool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	balancerPool, ok := pool.(*types.BalancerPool)
+	if !ok || !balancerPool.IsLBP() {
+		return nil, sdkerrors.Wrapf(types.ErrNotLBP, "pool %d", poolId)
+	}
+	return balancerPool, nil
+}
+
+// checkLBPJoin returns an error if the pool is a liquidity bootstrapping pool that wasn't reopened,
+// and the sender is not its creator.
+func checkLBPJoin(pool types.PoolI, sender sdk.AccAddress) error {
+	balancerPool, ok := pool.(*types.BalancerPool)
+	if !ok || !balancerPool.IsLBP() {
+		return nil
+	}
+
+	if balancerPool.PoolParams.LbpParams.Creator != sender.String() {
+		return sdkerrors.Wrapf(types.ErrLBPJoinDenied, "pool %d", pool.GetId())
+	}
+	return nil
+}
+
+// FinalizeLBP ends a liquidity bootstrapping pool once its sale is over. Its creator withdraws
+// shareInAmount shares of the proceeds without paying the exit fee, and the pool is either reopened
+// as a regular balancer pool with reopenWeights, or closed if there are none.
+// A closed pool can still be exited, but can't be swapped against or joined anymore.
+func (k Keeper) FinalizeLBP(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	shareInAmount sdk.Int,
+	reopenWeights []types.PoolAsset,
+) (sdk.Coins, error) {
+	pool, err := k.getLBP(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.checkPoolExitable(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	lbpParams := pool.PoolParams.LbpParams
+	if lbpParams.Finalized {
+		return nil, sdkerrors.Wrapf(types.ErrNotLBP, "pool %d is already finalized", poolId)
+	}
+	if lbpParams.Creator != sender.String() {
+		return nil, sdkerrors.Wrapf(types.ErrNotLBPCreator, "pool %d", poolId)
+	}
+	if lbpParams.Status(ctx.BlockTime()) != types.LBPSaleEnded {
+		return nil, sdkerrors.Wrapf(types.ErrLBPSaleNotEnded, "sale ends at %s", lbpParams.SaleEndTime)
+	}
+
+	reopen := len(reopenWeights) != 0
+	if shareInAmount.IsNegative() || (!reopen && shareInAmount.IsZero()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidLBP, "invalid share amount %s", shareInAmount)
+	}
+
+	coins := sdk.Coins{}
+	if shareInAmount.IsPositive() {
+		coins, err = pool.LBPExitCoins(shareInAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		newPoolCoins := make([]sdk.Coin, 0, len(coins))
+		for _, coin := range coins {
+			poolBalance, err := pool.GetTokenBalance(coin.Denom)
+			if err != nil {
+				return nil, err
+			}
+			newPoolCoins = append(newPoolCoins, sdk.NewCoin(coin.Denom, poolBalance.Sub(coin.Amount)))
+		}
+
+		err = pool.UpdatePoolAssetBalances(newPoolCoins)
+		if err != nil {
+			return nil, err
+		}
+
+		err = k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, coins)
+		if err != nil {
+			return nil, err
+		}
+
+		err = k.BurnPoolShareFromAccount(ctx, pool, sender, shareInAmount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if reopen {
+		err = pool.ReopenLBP(reopenWeights)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		pool.CloseLBP()
+	}
+
+	err = k.SetPool(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	if !coins.Empty() {
+		k.createRemoveLiquidityEvent(ctx, sender, poolId, coins)
+		k.hooks.AfterExitPool(ctx, sender, poolId, shareInAmount, coins)
+		k.recordExitStats(ctx, poolId, coins)
+		k.RecordTotalLiquidityDecrease(ctx, coins)
+	}
+	k.trackChangedPool(ctx, poolId)
+
+	return coins, nil
+}
+
+// LBPStatus returns the sale status of a liquidity bootstrapping pool, and the spot price of its base asset
+// over the sale, projected from the pool weight change.
+func (k Keeper) LBPStatus(ctx context.Context, req *types.QueryLBPStatusRequest) (*types.QueryLBPStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.BaseAssetDenom == "" || req.QuoteAssetDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	numPoints := req.NumPoints
+	if numPoints == 0 {
+		numPoints = defaultLBPPricePoints
+	}
+	if numPoints < 2 || numPoints > maxLBPPricePoints {
+		return nil, status.Errorf(codes.InvalidArgument, "number of points should be between 2 and %d", maxLBPPricePoints)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := k.getLBP(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	lbpParams := *pool.PoolParams.LbpParams
+
+	spotPrice, err := pool.ProjectedSpotPrice(sdkCtx.BlockTime(), req.BaseAssetDenom, req.QuoteAssetDenom)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	saleDuration := lbpParams.SaleEndTime.Sub(lbpParams.SaleStartTime)
+	priceCurve := make([]types.LBPPricePoint, 0, numPoints)
+	for i := uint32(0); i < numPoints; i++ {
+		pointTime := lbpParams.SaleStartTime.Add(saleDuration / time.Duration(numPoints-1) * time.Duration(i))
+		pointPrice, err := pool.ProjectedSpotPrice(pointTime, req.BaseAssetDenom, req.QuoteAssetDenom)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		priceCurve = append(priceCurve, types.LBPPricePoint{Time: pointTime, SpotPrice: pointPrice})
+	}
+
+	return &types.QueryLBPStatusResponse{
+		LbpParams:  lbpParams,
+		Status:     lbpParams.Status(sdkCtx.BlockTime()),
+		SpotPrice:  spotPrice,
+		PriceCurve: priceCurve,
+	}, nil
+}
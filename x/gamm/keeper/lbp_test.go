@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+// prepareLBP creates a liquidity bootstrapping pool as acc1, selling foo for bar during a day starting
+// in an hour, while the weight of foo goes from 90% to 10%.
+func (suite *KeeperTestSuite) prepareLBP() uint64 {
+	for _, acc := range []sdk.AccAddress{acc1, acc2, acc3} {
+		err := suite.app.BankKeeper.AddCoins(
+			suite.ctx,
+			acc,
+			sdk.NewCoins(
+				sdk.NewCoin("uosmo", sdk.NewInt(10000000000)),
+				sdk.NewCoin("foo", sdk.NewInt(10000000)),
+				sdk.NewCoin("bar", sdk.NewInt(10000000)),
+			),
+		)
+		suite.Require().NoError(err)
+	}
+
+	saleStart := suite.ctx.BlockTime().Add(time.Hour)
+	poolId, err := suite.app.GAMMKeeper.CreateBalancerPool(suite.ctx, acc1, types.BalancerPoolParams{
+		SwapFee: sdk.NewDecWithPrec(1, 2),
+		ExitFee: sdk.NewDecWithPrec(1, 2),
+		SmoothWeightChangeParams: &types.SmoothWeightChangeParams{
+			StartTime: saleStart,
+			Duration:  24 * time.Hour,
+			TargetPoolWeights: []types.PoolAsset{
+				{Weight: sdk.NewInt(10), Token: sdk.NewCoin("foo", sdk.ZeroInt())},
+				{Weight: sdk.NewInt(90), Token: sdk.NewCoin("bar", sdk.ZeroInt())},
+			},
+		},
+		LbpParams: &types.LBPParams{
+			SaleStartTime: saleStart,
+			SaleEndTime:   saleStart.Add(24 * time.Hour),
+		},
+	}, []types.PoolAsset{
+		{
+			Weight: sdk.NewInt(90),
+			Token:  sdk.NewCoin("foo", sdk.NewInt(5000000)),
+		},
+		{
+			Weight: sdk.NewInt(10),
+			Token:  sdk.NewCoin("bar", sdk.NewInt(5000000)),
+		},
+	}, "")
+	suite.Require().NoError(err)
+	return poolId
+}
+
+func (suite *KeeperTestSuite) TestLBP() {
+	createTime := time.Unix(1600000000, 0).UTC()
+	suite.ctx = suite.ctx.WithBlockTime(createTime)
+	poolId := suite.prepareLBP()
+	gammKeeper := suite.app.GAMMKeeper
+	msgServer := keeper.NewMsgServerImpl(gammKeeper)
+	saleStart := createTime.Add(time.Hour)
+	saleEnd := saleStart.Add(24 * time.Hour)
+
+	pool, err := gammKeeper.GetPool(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	lbpParams := pool.(*types.BalancerPool).PoolParams.LbpParams
+	suite.Require().Equal(acc1.String(), lbpParams.Creator)
+	suite.Require().False(lbpParams.Finalized)
+
+	// The sale must end after the pool creation.
+	_, err = gammKeeper.CreateBalancerPool(suite.ctx, acc1, types.BalancerPoolParams{
+		SwapFee:   sdk.NewDecWithPrec(1, 2),
+		ExitFee:   sdk.NewDecWithPrec(1, 2),
+		LbpParams: &types.LBPParams{SaleEndTime: createTime},
+	}, pool.GetAllPoolAssets(), "")
+	suite.Require().ErrorIs(err, types.ErrInvalidLBP)
+
+	// The pool can't be swapped against before the sale, and only the creator can join it.
+	tokenIn := sdk.NewCoin("bar", sdk.NewInt(100000))
+	_, _, err = gammKeeper.SwapExactAmountIn(suite.ctx, acc2, poolId, tokenIn, "foo", sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolLocked)
+	err = gammKeeper.JoinPool(suite.ctx, acc2, poolId, types.OneShare.MulRaw(10), nil)
+	suite.Require().ErrorIs(err, types.ErrLBPJoinDenied)
+	err = gammKeeper.JoinPool(suite.ctx, acc1, poolId, types.OneShare.MulRaw(10), nil)
+	suite.Require().NoError(err)
+
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	res, err := suite.queryClient.LBPStatus(goCtx, &types.QueryLBPStatusRequest{PoolId: poolId, BaseAssetDenom: "foo", QuoteAssetDenom: "bar"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.LBPSalePending, res.Status)
+	suite.Require().Equal(saleStart, res.LbpParams.SaleStartTime)
+
+	// The price of foo is 9 bar at the start of the sale, and falls to 1/9 bar at its end.
+	suite.Require().Len(res.PriceCurve, 10)
+	suite.Require().Equal(saleStart, res.PriceCurve[0].Time)
+	suite.Require().Equal(saleEnd, res.PriceCurve[9].Time)
+	suite.Require().Equal(res.SpotPrice, res.PriceCurve[0].SpotPrice)
+	suite.Require().True(res.PriceCurve[0].SpotPrice.Sub(sdk.NewDec(9)).Abs().LT(sdk.NewDecWithPrec(1, 6)))
+	for i := 1; i < len(res.PriceCurve); i++ {
+		suite.Require().True(res.PriceCurve[i].SpotPrice.LT(res.PriceCurve[i-1].SpotPrice))
+	}
+	suite.Require().True(res.PriceCurve[9].SpotPrice.Sub(sdk.OneDec().QuoInt64(9)).Abs().LT(sdk.NewDecWithPrec(1, 6)))
+
+	_, err = suite.queryClient.LBPStatus(goCtx, &types.QueryLBPStatusRequest{PoolId: poolId, BaseAssetDenom: "foo", QuoteAssetDenom: "bar", NumPoints: 1})
+	suite.Require().Error(err)
+	_, err = suite.queryClient.LBPStatus(goCtx, &types.QueryLBPStatusRequest{PoolId: poolId, BaseAssetDenom: "foo", QuoteAssetDenom: "baz"})
+	suite.Require().Error(err)
+
+	// During the sale, the pool can be swapped against, but still only joined by the creator.
+	suite.ctx = suite.ctx.WithBlockTime(saleStart.Add(12 * time.Hour))
+	_, _, err = gammKeeper.SwapExactAmountIn(suite.ctx, acc2, poolId, tokenIn, "foo", sdk.OneInt())
+	suite.Require().NoError(err)
+	_, err = gammKeeper.JoinSwapExternAmountIn(suite.ctx, acc2, poolId, tokenIn, sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrLBPJoinDenied)
+
+	res, err = gammKeeper.LBPStatus(sdk.WrapSDKContext(suite.ctx), &types.QueryLBPStatusRequest{PoolId: poolId, BaseAssetDenom: "foo", QuoteAssetDenom: "bar"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.LBPSaleOngoing, res.Status)
+
+	// The sale can't be finalized before its end, nor by anyone but the creator.
+	shareInAmount := types.OneShare.MulRaw(50)
+	_, err = gammKeeper.FinalizeLBP(suite.ctx, acc1, poolId, shareInAmount, nil)
+	suite.Require().ErrorIs(err, types.ErrLBPSaleNotEnded)
+
+	suite.ctx = suite.ctx.WithBlockTime(saleEnd)
+	_, err = gammKeeper.FinalizeLBP(suite.ctx, acc2, poolId, shareInAmount, nil)
+	suite.Require().ErrorIs(err, types.ErrNotLBPCreator)
+	_, _, err = gammKeeper.SwapExactAmountIn(suite.ctx, acc2, poolId, tokenIn, "foo", sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolLocked)
+
+	// The creator withdraws half of the proceeds without paying the exit fee, and closes the pool.
+	pool, err = gammKeeper.GetPool(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	totalShares := pool.GetTotalShares().Amount
+	barBalance := suite.app.BankKeeper.GetBalance(suite.ctx, acc1, "bar").Amount
+	resFinalize, err := msgServer.FinalizeLBP(sdk.WrapSDKContext(suite.ctx), &types.MsgFinalizeLBP{
+		Sender:        acc1.String(),
+		PoolId:        poolId,
+		ShareInAmount: shareInAmount,
+	})
+	suite.Require().NoError(err)
+	poolBar, err := pool.GetTokenBalance("bar")
+	suite.Require().NoError(err)
+	expectedBar := poolBar.ToDec().MulInt(shareInAmount).QuoInt(totalShares).TruncateInt()
+	suite.Require().Equal(expectedBar, resFinalize.TokensOut.AmountOf("bar"))
+	suite.Require().Equal(barBalance.Add(expectedBar), suite.app.BankKeeper.GetBalance(suite.ctx, acc1, "bar").Amount)
+
+	pool, err = gammKeeper.GetPool(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(totalShares.Sub(shareInAmount), pool.GetTotalShares().Amount)
+	suite.Require().False(pool.IsActive(suite.ctx.BlockTime()))
+	suite.requireGammInvariants()
+
+	res, err = gammKeeper.LBPStatus(sdk.WrapSDKContext(suite.ctx), &types.QueryLBPStatusRequest{PoolId: poolId, BaseAssetDenom: "foo", QuoteAssetDenom: "bar"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.LBPFinalized, res.Status)
+
+	_, err = gammKeeper.FinalizeLBP(suite.ctx, acc1, poolId, shareInAmount, nil)
+	suite.Require().ErrorIs(err, types.ErrNotLBP)
+	err = gammKeeper.JoinPool(suite.ctx, acc2, poolId, types.OneShare.MulRaw(10), nil)
+	suite.Require().ErrorIs(err, types.ErrLBPJoinDenied)
+
+	// The creator can instead reopen the pool as a regular pool with new weights.
+	suite.ctx = suite.ctx.WithBlockTime(createTime)
+	reopenedPoolId := suite.prepareLBP()
+	suite.ctx = suite.ctx.WithBlockTime(saleEnd)
+	_, err = gammKeeper.FinalizeLBP(suite.ctx, acc1, reopenedPoolId, sdk.ZeroInt(), []types.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("baz", sdk.ZeroInt())},
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("foo", sdk.ZeroInt())},
+	})
+	suite.Require().ErrorIs(err, types.ErrPoolParamsInvalidDenom)
+	tokensOut, err := gammKeeper.FinalizeLBP(suite.ctx, acc1, reopenedPoolId, sdk.ZeroInt(), []types.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("bar", sdk.ZeroInt())},
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("foo", sdk.ZeroInt())},
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(tokensOut.Empty())
+
+	pool, err = gammKeeper.GetPool(suite.ctx, reopenedPoolId)
+	suite.Require().NoError(err)
+	suite.Require().True(pool.IsActive(suite.ctx.BlockTime()))
+	suite.Require().Nil(pool.(*types.BalancerPool).PoolParams.SmoothWeightChangeParams)
+	suite.Require().Equal(pool.GetAllPoolAssets()[0].Weight, pool.GetAllPoolAssets()[1].Weight)
+	err = gammKeeper.JoinPool(suite.ctx, acc2, reopenedPoolId, types.OneShare.MulRaw(10), nil)
+	suite.Require().NoError(err)
+
+	_, err = gammKeeper.LBPStatus(sdk.WrapSDKContext(suite.ctx), &types.QueryLBPStatusRequest{PoolId: reopenedPoolId, BaseAssetDenom: "foo", QuoteAssetDenom: "bar"})
+	suite.Require().Error(err)
+	suite.requireGammInvariants()
+}
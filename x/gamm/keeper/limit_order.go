@@ -0,0 +1,354 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+// PlaceLimitOrder escrows tokenIn, and rests an order selling it for tokenOutDenom on the pool,
+// once the pool's spot price of tokenIn in units of tokenOutDenom reaches triggerPrice.
+// Orders are checked at the end of every block, the order placed included.
+func (k Keeper) PlaceLimitOrder(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string, triggerPrice sdk.Dec) (uint64, error) {
+	if tokenIn.Denom == tokenOutDenom {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidLimitOrder, "cannot trade same denomination in and out")
+	}
+	if tokenIn.Amount.LT(sdk.NewInt(types.MinLimitOrderAmount)) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidLimitOrder, "token in should be at least %d", types.MinLimitOrderAmount)
+	}
+	err := types.ValidateTriggerPrice(triggerPrice)
+	if err != nil {
+		return 0, err
+	}
+
+	_, _, _, err = k.getPoolAndInOutAssets(ctx, poolId, tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.checkPoolOpen(ctx, poolId); err != nil {
+		return 0, err
+	}
+
+	// Orders are filled with regular swaps, which pools in batch mode only queue.
+	if k.IsBatchModePool(ctx, poolId) {
+		return 0, sdkerrors.Wrapf(types.ErrPoolInBatchMode, "can't place limit orders on pool %d", poolId)
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.Coins{tokenIn})
+	if err != nil {
+		return 0, err
+	}
+
+	order := types.LimitOrder{
+		Id:            k.GetNextLimitOrderIdAndIncrement(ctx),
+		Owner:         owner.String(),
+		PoolId:        poolId,
+		TokenIn:       tokenIn,
+		TokenOutDenom: tokenOutDenom,
+		TriggerPrice:  triggerPrice,
+	}
+	k.SetLimitOrder(ctx, order)
+
+	return order.Id, nil
+}
+
+// CancelLimitOrder removes a limit order that isn't filled yet, and refunds its tokens to its owner.
+func (k Keeper) CancelLimitOrder(ctx sdk.Context, owner sdk.AccAddress, orderId uint64) (sdk.Coin, error) {
+	order, err := k.GetLimitOrder(ctx, orderId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if order.Owner != owner.String() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrNotLimitOrderOwner, "limit order %d is owned by %s", orderId, order.Owner)
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.Coins{order.TokenIn})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.deleteLimitOrder(ctx, order)
+	return order.TokenIn, nil
+}
+
+// fillLimitOrders fills the limit orders whose trigger price has been reached, on every pool with limit orders.
+// At most MaxLimitOrderFillsPerBlock fills are attempted.
+func (k Keeper) fillLimitOrders(ctx sdk.Context) {
+	remainingFills := types.MaxLimitOrderFillsPerBlock
+	for _, poolId := range k.getLimitOrderPoolIds(ctx) {
+		// Orders rest while their pool is closed, and can be cancelled.
+		if k.checkPoolOpen(ctx, poolId) != nil {
+			continue
+		}
+
+		pool, err := k.GetPool(ctx, poolId)
+		if err != nil {
+			k.Logger(ctx).Error("failed to get pool for limit orders", "pool_id", poolId, "error", err.Error())
+			continue
+		}
+
+		for _, assetIn := range pool.GetAllPoolAssets() {
+			for _, assetOut := range pool.GetAllPoolAssets() {
+				if remainingFills <= 0 {
+					return
+				}
+				if assetIn.Token.Denom != assetOut.Token.Denom {
+					remainingFills -= k.fillPairLimitOrders(ctx, poolId, assetIn.Token.Denom, assetOut.Token.Denom, remainingFills)
+				}
+			}
+		}
+	}
+}
+
+// getLimitOrderPoolIds returns the ids of the pools with limit orders, in increasing order.
+func (k Keeper) getLimitOrderPoolIds(ctx sdk.Context) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.KeyPrefixLimitOrdersByPrice)
+
+	poolIds := []uint64{}
+	start := types.KeyPrefixLimitOrdersByPrice
+	for {
+		iter := store.Iterator(start, end)
+		if !iter.Valid() {
+			iter.Close()
+			return poolIds
+		}
+
+		// Skip past the pool's orders, to the first order of the next pool.
+		poolId := sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixLimitOrdersByPrice) : len(types.KeyPrefixLimitOrdersByPrice)+8])
+		iter.Close()
+		poolIds = append(poolIds, poolId)
+		start = sdk.PrefixEndBytes(types.GetKeyPrefixPoolLimitOrders(poolId))
+	}
+}
+
+// fillPairLimitOrders fills the orders selling tokenInDenom for tokenOutDenom on the pool whose trigger price
+// has been reached, from the lowest trigger price up, and returns the number of fills attempted, up to maxFills.
+// Every fill lowers the price, so that orders are only filled as long as the price stays at their trigger price.
+// Orders whose swap doesn't return enough tokens at their trigger price are cancelled, and their tokens refunded.
+func (k Keeper) fillPairLimitOrders(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string, maxFills int) int {
+	spotPrice, err := k.CalculateSpotPrice(ctx, poolId, tokenOutDenom, tokenInDenom)
+	if err != nil {
+		k.Logger(ctx).Error("failed to compute spot price for limit orders", "pool_id", poolId, "error", err.Error())
+		return 0
+	}
+
+	fills := 0
+	for _, order := range k.getTriggeredLimitOrders(ctx, poolId, tokenInDenom, tokenOutDenom, spotPrice, maxFills) {
+		if order.TriggerPrice.GT(spotPrice) {
+			return fills
+		}
+
+		fills++
+		if k.fillLimitOrder(ctx, order) {
+			spotPrice, err = k.CalculateSpotPrice(ctx, poolId, tokenOutDenom, tokenInDenom)
+			if err != nil {
+				return fills
+			}
+		}
+	}
+	return fills
+}
+
+// getTriggeredLimitOrders returns up to limit orders selling tokenInDenom for tokenOutDenom on the pool
+// with a trigger price of at most spotPrice, by increasing trigger price.
+func (k Keeper) getTriggeredLimitOrders(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string, spotPrice sdk.Dec, limit int) []types.LimitOrder {
+	if spotPrice.GT(sdk.MaxSortableDec) {
+		spotPrice = sdk.MaxSortableDec
+	}
+	prefix := types.GetKeyPrefixLimitOrdersByPrice(poolId, tokenInDenom, tokenOutDenom)
+	end := sdk.PrefixEndBytes(append(prefix, sdk.SortableDecBytes(spotPrice)...))
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(prefix, end)
+	defer iter.Close()
+
+	orders := []types.LimitOrder{}
+	for ; iter.Valid() && len(orders) < limit; iter.Next() {
+		order, err := k.GetLimitOrder(ctx, sdk.BigEndianToUint64(iter.Value()))
+		if err != nil {
+			k.Logger(ctx).Error("failed to get triggered limit order", "pool_id", poolId, "error", err.Error())
+			continue
+		}
+		orders = append(orders, order)
+	}
+	return orders
+}
+
+// fillLimitOrder swaps the tokens of the order on behalf of its owner, and returns whether it was filled.
+// An order that can't be filled is cancelled, and its tokens refunded to its owner.
+func (k Keeper) fillLimitOrder(ctx sdk.Context, order types.LimitOrder) bool {
+	owner, err := sdk.AccAddressFromBech32(order.Owner)
+	if err != nil {
+		k.Logger(ctx).Error("invalid owner of limit order", "order_id", order.Id, "error", err.Error())
+		return false
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, owner, sdk.Coins{order.TokenIn})
+	if err != nil {
+		k.Logger(ctx).Error("failed to release limit order tokens", "order_id", order.Id, "error", err.Error())
+		return false
+	}
+
+	minTokenOut := order.MinTokenOut()
+	tokenOutAmount, _, err := k.SwapExactAmountIn(cacheCtx, owner, order.PoolId, order.TokenIn, order.TokenOutDenom, minTokenOut.Amount)
+	if err != nil {
+		k.cancelUnfilledLimitOrder(ctx, owner, order)
+		return false
+	}
+
+	k.deleteLimitOrder(cacheCtx, order)
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtLimitOrderFilled,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, order.Owner),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(order.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, order.TokenIn.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, sdk.NewCoin(order.TokenOutDenom, tokenOutAmount).String()),
+		),
+	})
+	return true
+}
+
+// cancelUnfilledLimitOrder refunds and removes an order whose fill failed,
+// so that it doesn't take a fill out of every following block.
+func (k Keeper) cancelUnfilledLimitOrder(ctx sdk.Context, owner sdk.AccAddress, order types.LimitOrder) {
+	refund, err := k.CancelLimitOrder(ctx, owner, order.Id)
+	if err != nil {
+		k.Logger(ctx).Error("failed to cancel unfilled limit order", "order_id", order.Id, "error", err.Error())
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtLimitOrderCancelled,
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		),
+	})
+}
+
+// SetNextLimitOrderId sets next limit order id
+func (k Keeper) SetNextLimitOrderId(ctx sdk.Context, orderId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&gogotypes.UInt64Value{Value: orderId})
+	store.Set(types.KeyNextLimitOrderId, bz)
+}
+
+// GetNextLimitOrderIdAndIncrement returns the next limit order id, and increments the corresponding state entry.
+// Limit order ids start at 1.
+func (k Keeper) GetNextLimitOrderIdAndIncrement(ctx sdk.Context) uint64 {
+	orderId := uint64(1)
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyNextLimitOrderId)
+	if bz != nil {
+		val := gogotypes.UInt64Value{}
+
+		err := k.cdc.UnmarshalBinaryBare(bz, &val)
+		if err != nil {
+			panic(err)
+		}
+
+		orderId = val.GetValue()
+	}
+
+	k.SetNextLimitOrderId(ctx, orderId+1)
+	return orderId
+}
+
+func (k Keeper) GetLimitOrder(ctx sdk.Context, orderId uint64) (types.LimitOrder, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyLimitOrder(orderId))
+	if bz == nil {
+		return types.LimitOrder{}, sdkerrors.Wrapf(types.ErrLimitOrderNotFound, "limit order %d", orderId)
+	}
+
+	order := types.LimitOrder{}
+	err := k.cdc.UnmarshalBinaryBare(bz, &order)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+	return order, nil
+}
+
+// SetLimitOrder stores the limit order, and indexes it by owner and by trigger price.
+func (k Keeper) SetLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	owner, err := sdk.AccAddressFromBech32(order.Owner)
+	if err != nil {
+		panic(fmt.Sprintf("invalid owner of limit order %d: %s", order.Id, err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	idBz := sdk.Uint64ToBigEndian(order.Id)
+	store.Set(types.GetKeyLimitOrder(order.Id), k.cdc.MustMarshalBinaryBare(&order))
+	store.Set(types.GetKeyLimitOrderByOwner(owner, order.Id), idBz)
+	store.Set(types.GetKeyLimitOrderByPrice(order.PoolId, order.TokenIn.Denom, order.TokenOutDenom, order.TriggerPrice, order.Id), idBz)
+}
+
+func (k Keeper) deleteLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	owner, err := sdk.AccAddressFromBech32(order.Owner)
+	if err != nil {
+		panic(fmt.Sprintf("invalid owner of limit order %d: %s", order.Id, err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetKeyLimitOrder(order.Id))
+	store.Delete(types.GetKeyLimitOrderByOwner(owner, order.Id))
+	store.Delete(types.GetKeyLimitOrderByPrice(order.PoolId, order.TokenIn.Denom, order.TokenOutDenom, order.TriggerPrice, order.Id))
+}
+
+// GetAccountLimitOrders returns the limit orders of owner, ordered by id.
+func (k Keeper) GetAccountLimitOrders(ctx sdk.Context, owner sdk.AccAddress) ([]types.LimitOrder, error) {
+	return k.getIndexedLimitOrders(ctx, types.GetKeyPrefixLimitOrdersByOwner(owner))
+}
+
+// GetPoolLimitOrders returns the limit orders on a pool, ordered by asset pair and increasing trigger price.
+func (k Keeper) GetPoolLimitOrders(ctx sdk.Context, poolId uint64) ([]types.LimitOrder, error) {
+	return k.getIndexedLimitOrders(ctx, types.GetKeyPrefixPoolLimitOrders(poolId))
+}
+
+func (k Keeper) getIndexedLimitOrders(ctx sdk.Context, prefix []byte) ([]types.LimitOrder, error) {
+	iter := k.iterator(ctx, prefix)
+	defer iter.Close()
+
+	orders := []types.LimitOrder{}
+	for ; iter.Valid(); iter.Next() {
+		order, err := k.GetLimitOrder(ctx, sdk.BigEndianToUint64(iter.Value()))
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+	return orders, nil
+}
+
+// GetAllLimitOrders returns all the limit orders, ordered by id.
+func (k Keeper) GetAllLimitOrders(ctx sdk.Context) ([]types.LimitOrder, error) {
+	iter := k.iterator(ctx, types.KeyPrefixLimitOrders)
+	defer iter.Close()
+
+	orders := []types.LimitOrder{}
+	for ; iter.Valid(); iter.Next() {
+		order := types.LimitOrder{}
+		err := k.cdc.UnmarshalBinaryBare(iter.Value(), &order)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+	return orders, nil
+}
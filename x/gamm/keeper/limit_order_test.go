@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestLimitOrders() {
+	poolId := suite.prepareBalancerPoolWithFutureGovernor(acc1.String())
+	gammKeeper := suite.app.GAMMKeeper
+	msgServer := keeper.NewMsgServerImpl(gammKeeper)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	fooBalance2 := suite.app.BankKeeper.GetBalance(suite.ctx, acc2, "foo")
+	barBalance2 := suite.app.BankKeeper.GetBalance(suite.ctx, acc2, "bar")
+	fooBalance3 := suite.app.BankKeeper.GetBalance(suite.ctx, acc3, "foo")
+
+	// The spot price of foo in bar starts at 1.
+	res, err := msgServer.PlaceLimitOrder(goCtx, &types.MsgPlaceLimitOrder{
+		Sender:        acc2.String(),
+		PoolId:        poolId,
+		TokenIn:       sdk.NewCoin("foo", sdk.NewInt(10000)),
+		TokenOutDenom: "bar",
+		TriggerPrice:  sdk.NewDecWithPrec(105, 2),
+	})
+	suite.Require().NoError(err)
+	orderId := res.OrderId
+	res, err = msgServer.PlaceLimitOrder(goCtx, &types.MsgPlaceLimitOrder{
+		Sender:        acc3.String(),
+		PoolId:        poolId,
+		TokenIn:       sdk.NewCoin("foo", sdk.NewInt(10000)),
+		TokenOutDenom: "bar",
+		TriggerPrice:  sdk.NewDecWithPrec(2, 0),
+	})
+	suite.Require().NoError(err)
+	highOrderId := res.OrderId
+	// Already triggered, so filled at the end of the block.
+	_, err = gammKeeper.PlaceLimitOrder(suite.ctx, acc3, poolId, sdk.NewCoin("bar", sdk.NewInt(10000)), "foo", sdk.NewDecWithPrec(5, 1))
+	suite.Require().NoError(err)
+
+	_, err = gammKeeper.PlaceLimitOrder(suite.ctx, acc3, poolId, sdk.NewCoin("foo", sdk.NewInt(10000)), "baz", sdk.OneDec())
+	suite.Require().Error(err)
+	_, err = gammKeeper.PlaceLimitOrder(suite.ctx, acc3, poolId, sdk.NewCoin("foo", sdk.NewInt(10000)), "bar", sdk.ZeroDec())
+	suite.Require().ErrorIs(err, types.ErrInvalidLimitOrder)
+	_, err = gammKeeper.PlaceLimitOrder(suite.ctx, acc3, poolId, sdk.NewCoin("foo", sdk.NewInt(types.MinLimitOrderAmount-1)), "bar", sdk.OneDec())
+	suite.Require().ErrorIs(err, types.ErrInvalidLimitOrder)
+
+	// The tokens in are escrowed.
+	suite.Require().Equal(fooBalance2.Amount.SubRaw(10000), suite.app.BankKeeper.GetBalance(suite.ctx, acc2, "foo").Amount)
+
+	accountOrders, err := suite.queryClient.AccountLimitOrders(goCtx, &types.QueryAccountLimitOrdersRequest{Owner: acc3.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(accountOrders.LimitOrders, 2)
+
+	// Pool orders are ordered by pair, then by increasing trigger price.
+	poolOrders, err := suite.queryClient.PoolLimitOrders(goCtx, &types.QueryPoolLimitOrdersRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Len(poolOrders.LimitOrders, 3)
+	suite.Require().Equal("bar", poolOrders.LimitOrders[0].TokenIn.Denom)
+	suite.Require().Equal(orderId, poolOrders.LimitOrders[1].Id)
+	suite.Require().Equal(highOrderId, poolOrders.LimitOrders[2].Id)
+
+	gammKeeper.EndBlock(suite.ctx)
+
+	poolOrders, err = suite.queryClient.PoolLimitOrders(goCtx, &types.QueryPoolLimitOrdersRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Len(poolOrders.LimitOrders, 2)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, acc3, "foo").Amount.GT(fooBalance3.Amount.SubRaw(10000).AddRaw(5000)))
+
+	// Push the spot price of foo above 1.05.
+	_, _, err = gammKeeper.SwapExactAmountIn(suite.ctx, acc1, poolId, sdk.NewCoin("bar", sdk.NewInt(300000)), "foo", sdk.OneInt())
+	suite.Require().NoError(err)
+	spotPrice, err := gammKeeper.CalculateSpotPrice(suite.ctx, poolId, "bar", "foo")
+	suite.Require().NoError(err)
+	suite.Require().True(spotPrice.GT(sdk.NewDecWithPrec(105, 2)), spotPrice.String())
+
+	gammKeeper.EndBlock(suite.ctx)
+
+	_, err = suite.queryClient.LimitOrder(goCtx, &types.QueryLimitOrderRequest{OrderId: orderId})
+	suite.Require().Error(err)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, acc2, "bar").Amount.GTE(barBalance2.Amount.AddRaw(10500)))
+
+	// Triggered, but the swap fee keeps the swap from returning enough at the trigger price,
+	// so the order is cancelled and refunded.
+	spotPrice, err = gammKeeper.CalculateSpotPrice(suite.ctx, poolId, "bar", "foo")
+	suite.Require().NoError(err)
+	failingOrderId, err := gammKeeper.PlaceLimitOrder(suite.ctx, acc3, poolId, sdk.NewCoin("foo", sdk.NewInt(10000)), "bar", spotPrice)
+	suite.Require().NoError(err)
+	fooBalance3 = suite.app.BankKeeper.GetBalance(suite.ctx, acc3, "foo")
+
+	gammKeeper.EndBlock(suite.ctx)
+
+	_, err = suite.queryClient.LimitOrder(goCtx, &types.QueryLimitOrderRequest{OrderId: failingOrderId})
+	suite.Require().Error(err)
+	suite.Require().Equal(fooBalance3.Amount.AddRaw(10000), suite.app.BankKeeper.GetBalance(suite.ctx, acc3, "foo").Amount)
+
+	poolOrders, err = suite.queryClient.PoolLimitOrders(goCtx, &types.QueryPoolLimitOrdersRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Len(poolOrders.LimitOrders, 1)
+
+	// Only the owner can cancel an order, and gets its tokens back.
+	_, err = msgServer.CancelLimitOrder(goCtx, &types.MsgCancelLimitOrder{Sender: acc2.String(), OrderId: highOrderId})
+	suite.Require().ErrorIs(err, types.ErrNotLimitOrderOwner)
+	for _, order := range poolOrders.LimitOrders {
+		_, err = msgServer.CancelLimitOrder(goCtx, &types.MsgCancelLimitOrder{Sender: acc3.String(), OrderId: order.Id})
+		suite.Require().NoError(err)
+	}
+	_, err = msgServer.CancelLimitOrder(goCtx, &types.MsgCancelLimitOrder{Sender: acc3.String(), OrderId: highOrderId})
+	suite.Require().ErrorIs(err, types.ErrLimitOrderNotFound)
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, moduleAddr).Empty())
+	suite.requireGammInvariants()
+
+	// Orders can't be placed on pools in batch mode.
+	gammKeeper.SetBatchModePool(suite.ctx, poolId, true)
+	_, err = gammKeeper.PlaceLimitOrder(suite.ctx, acc3, poolId, sdk.NewCoin("foo", sdk.NewInt(10000)), "bar", sdk.OneDec())
+	suite.Require().ErrorIs(err, types.ErrPoolInBatchMode)
+}
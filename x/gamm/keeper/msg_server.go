@@ -2,9 +2,12 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/osmosis-labs/osmosis/x/gamm/types"
 )
@@ -29,7 +32,7 @@ func (server msgServer) CreateBalancerPool(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 
-	poolId, err := server.keeper.CreateBalancerPool(ctx, sender, msg.PoolParams, msg.PoolAssets, msg.FuturePoolGovernor)
+	poolId, err := server.keeper.createBalancerPool(ctx, sender, msg.PoolParams, msg.PoolAssets, msg.FuturePoolGovernor, msg.ShareSymbol, msg.FeeDenom)
 	if err != nil {
 		return nil, err
 	}
@@ -49,6 +52,145 @@ func (server msgServer) CreateBalancerPool(goCtx context.Context, msg *types.Msg
 	return &types.MsgCreateBalancerPoolResponse{}, nil
 }
 
+func (server msgServer) CreateStableswapPool(goCtx context.Context, msg *types.MsgCreateStableswapPool) (*types.MsgCreateStableswapPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	poolId, err := server.keeper.createStableswapPool(ctx, sender, msg.PoolParams, msg.InitialPoolLiquidity, msg.AmplificationParameter, msg.FuturePoolGovernor, msg.FeeDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolCreated,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgCreateStableswapPoolResponse{}, nil
+}
+
+func (server msgServer) CreateConcentratedPool(goCtx context.Context, msg *types.MsgCreateConcentratedPool) (*types.MsgCreateConcentratedPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	poolId, err := server.keeper.createConcentratedPool(ctx, sender, msg.PoolParams, msg.Denom0, msg.Denom1, msg.TickSpacing, msg.InitialPrice, msg.FuturePoolGovernor, msg.FeeDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolCreated,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgCreateConcentratedPoolResponse{}, nil
+}
+
+func (server msgServer) CreatePosition(goCtx context.Context, msg *types.MsgCreatePosition) (*types.MsgCreatePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	positionId, _, _, err := server.keeper.CreatePosition(ctx, sender, msg.PoolId, msg.LowerTick, msg.UpperTick,
+		msg.TokenDesired0, msg.TokenDesired1, msg.TokenMinAmount0, msg.TokenMinAmount1)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPositionCreated,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgCreatePositionResponse{PositionId: positionId}, nil
+}
+
+func (server msgServer) WithdrawPosition(goCtx context.Context, msg *types.MsgWithdrawPosition) (*types.MsgWithdrawPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.keeper.WithdrawPosition(ctx, sender, msg.PositionId, msg.Liquidity)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgWithdrawPositionResponse{}, nil
+}
+
+func (server msgServer) CollectFees(goCtx context.Context, msg *types.MsgCollectFees) (*types.MsgCollectFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	fees, err := server.keeper.CollectFees(ctx, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtFeesCollected,
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(msg.PositionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, fees.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgCollectFeesResponse{}, nil
+}
+
 func (server msgServer) JoinPool(goCtx context.Context, msg *types.MsgJoinPool) (*types.MsgJoinPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -113,6 +255,16 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 		return nil, err
 	}
 
+	// Swaps on pools in batch mode are queued, and executed at the end of the block.
+	if len(msg.Routes) == 1 && server.keeper.IsBatchModePool(ctx, msg.Routes[0].PoolId) {
+		tokenOut := sdk.Coin{Denom: msg.Routes[0].TokenOutDenom, Amount: msg.TokenOutMinAmount}
+		err = server.queueSwap(ctx, sender, msg.Routes[0].PoolId, msg.TokenIn, tokenOut, false)
+		if err != nil {
+			return nil, err
+		}
+		return &types.MsgSwapExactAmountInResponse{}, nil
+	}
+
 	_, err = server.keeper.MultihopSwapExactAmountIn(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount)
 
 	if err != nil {
@@ -131,6 +283,31 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 	return &types.MsgSwapExactAmountInResponse{}, nil
 }
 
+func (server msgServer) SplitRouteSwapExactAmountIn(goCtx context.Context, msg *types.MsgSplitRouteSwapExactAmountIn) (*types.MsgSplitRouteSwapExactAmountInResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.keeper.SplitRouteSwapExactAmountIn(ctx, sender, msg.Routes, msg.TokenInDenom, msg.TokenOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap event is handled elsewhere
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSplitRouteSwapExactAmountInResponse{}, nil
+}
+
 func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.MsgSwapExactAmountOut) (*types.MsgSwapExactAmountOutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -139,6 +316,16 @@ func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 
+	// Swaps on pools in batch mode are queued, and executed at the end of the block.
+	if len(msg.Routes) == 1 && server.keeper.IsBatchModePool(ctx, msg.Routes[0].PoolId) {
+		tokenIn := sdk.Coin{Denom: msg.Routes[0].TokenInDenom, Amount: msg.TokenInMaxAmount}
+		err = server.queueSwap(ctx, sender, msg.Routes[0].PoolId, tokenIn, msg.TokenOut, true)
+		if err != nil {
+			return nil, err
+		}
+		return &types.MsgSwapExactAmountOutResponse{}, nil
+	}
+
 	_, err = server.keeper.MultihopSwapExactAmountOut(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut)
 	if err != nil {
 		return nil, err
@@ -156,6 +343,29 @@ func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.Msg
 	return &types.MsgSwapExactAmountOutResponse{}, nil
 }
 
+func (server msgServer) queueSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokenIn, tokenOut sdk.Coin, exactOut bool) error {
+	swapId, err := server.keeper.QueueSwap(ctx, sender, poolId, tokenIn, tokenOut, exactOut)
+	if err != nil {
+		return err
+	}
+
+	// The swap event is emitted once the batch is executed.
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSwapQueued,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeySwapId, strconv.FormatUint(swapId, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	})
+
+	return nil
+}
+
 func (server msgServer) JoinSwapExternAmountIn(goCtx context.Context, msg *types.MsgJoinSwapExternAmountIn) (*types.MsgJoinSwapExternAmountInResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -255,3 +465,378 @@ func (server msgServer) ExitSwapShareAmountIn(goCtx context.Context, msg *types.
 
 	return &types.MsgExitSwapShareAmountInResponse{}, nil
 }
+
+func (server msgServer) SetPoolSwapFee(goCtx context.Context, msg *types.MsgSetPoolSwapFee) (*types.MsgSetPoolSwapFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.SetPoolSwapFee(ctx, sender, msg.PoolId, msg.SwapFee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolSwapFeeSet,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeySwapFee, msg.SwapFee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSetPoolSwapFeeResponse{}, nil
+}
+
+func (server msgServer) SetPoolExitFee(goCtx context.Context, msg *types.MsgSetPoolExitFee) (*types.MsgSetPoolExitFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.SetPoolExitFee(ctx, sender, msg.PoolId, msg.ExitFee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolExitFeeSet,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyExitFee, msg.ExitFee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSetPoolExitFeeResponse{}, nil
+}
+
+func (server msgServer) ScheduleWeightChange(goCtx context.Context, msg *types.MsgScheduleWeightChange) (*types.MsgScheduleWeightChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start the weight change right away if no start time is given.
+	startTime := msg.StartTime
+	if startTime.Unix() <= 0 {
+		startTime = ctx.BlockTime()
+	}
+
+	err = server.keeper.ScheduleWeightChange(ctx, sender, msg.PoolId, startTime, msg.Duration, msg.TargetPoolWeights)
+	if err != nil {
+		return nil, err
+	}
+
+	targetWeights := make([]string, len(msg.TargetPoolWeights))
+	for i, asset := range msg.TargetPoolWeights {
+		targetWeights[i] = fmt.Sprintf("%s:%s", asset.Token.Denom, asset.Weight)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolWeightChangeScheduled,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyStartTime, startTime.String()),
+			sdk.NewAttribute(types.AttributeKeyDuration, msg.Duration.String()),
+			sdk.NewAttribute(types.AttributeKeyWeights, strings.Join(targetWeights, ",")),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgScheduleWeightChangeResponse{}, nil
+}
+
+func (server msgServer) SetPoolBatchMode(goCtx context.Context, msg *types.MsgSetPoolBatchMode) (*types.MsgSetPoolBatchModeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.SetPoolBatchMode(ctx, sender, msg.PoolId, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolBatchModeSet,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSetPoolBatchModeResponse{}, nil
+}
+
+func (server msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	orderId, err := server.keeper.PlaceLimitOrder(ctx, sender, msg.PoolId, msg.TokenIn, msg.TokenOutDenom, msg.TriggerPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtLimitOrderPlaced,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(orderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, msg.TokenIn.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, msg.TriggerPrice.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgPlaceLimitOrderResponse{OrderId: orderId}, nil
+}
+
+func (server msgServer) CancelLimitOrder(goCtx context.Context, msg *types.MsgCancelLimitOrder) (*types.MsgCancelLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	refund, err := server.keeper.CancelLimitOrder(ctx, sender, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtLimitOrderCancelled,
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(msg.OrderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgCancelLimitOrderResponse{}, nil
+}
+
+func (server msgServer) FlashSwap(goCtx context.Context, msg *types.MsgFlashSwap) (*types.MsgFlashSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInAmount, err := server.keeper.FlashSwap(ctx, sender, msg.PoolId, msg.TokenInDenom, msg.TokenInMaxAmount, msg.TokenOut, func(ctx sdk.Context) error {
+		for _, nested := range msgs {
+			err := server.executeFlashSwapMsg(ctx, nested)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtFlashSwap,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, sdk.NewCoin(msg.TokenInDenom, tokenInAmount).String()),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, msg.TokenOut.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgFlashSwapResponse{TokenInAmount: tokenInAmount}, nil
+}
+
+// executeFlashSwapMsg executes a gamm msg nested in a flash swap.
+func (server msgServer) executeFlashSwapMsg(ctx sdk.Context, msg sdk.Msg) error {
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	var err error
+	switch msg := msg.(type) {
+	case *types.MsgSwapExactAmountIn:
+		_, err = server.SwapExactAmountIn(goCtx, msg)
+	case *types.MsgSwapExactAmountOut:
+		_, err = server.SwapExactAmountOut(goCtx, msg)
+	case *types.MsgSplitRouteSwapExactAmountIn:
+		_, err = server.SplitRouteSwapExactAmountIn(goCtx, msg)
+	case *types.MsgSwapExactAmountInWithPriceImpact:
+		_, err = server.SwapExactAmountInWithPriceImpact(goCtx, msg)
+	case *types.MsgSwapExactAmountOutWithPriceImpact:
+		_, err = server.SwapExactAmountOutWithPriceImpact(goCtx, msg)
+	case *types.MsgJoinPool:
+		_, err = server.JoinPool(goCtx, msg)
+	case *types.MsgExitPool:
+		_, err = server.ExitPool(goCtx, msg)
+	case *types.MsgJoinSwapExternAmountIn:
+		_, err = server.JoinSwapExternAmountIn(goCtx, msg)
+	case *types.MsgJoinSwapShareAmountOut:
+		_, err = server.JoinSwapShareAmountOut(goCtx, msg)
+	case *types.MsgExitSwapExternAmountOut:
+		_, err = server.ExitSwapExternAmountOut(goCtx, msg)
+	case *types.MsgExitSwapShareAmountIn:
+		_, err = server.ExitSwapShareAmountIn(goCtx, msg)
+	default:
+		err = sdkerrors.Wrapf(types.ErrInvalidFlashSwap, "%T can't be executed in a flash swap", msg)
+	}
+	return err
+}
+
+func (server msgServer) FinalizeLBP(goCtx context.Context, msg *types.MsgFinalizeLBP) (*types.MsgFinalizeLBPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensOut, err := server.keeper.FinalizeLBP(ctx, sender, msg.PoolId, msg.ShareInAmount, msg.ReopenPoolWeights)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtLBPFinalized,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, tokensOut.String()),
+			sdk.NewAttribute(types.AttributeKeyReopened, strconv.FormatBool(len(msg.ReopenPoolWeights) != 0)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgFinalizeLBPResponse{TokensOut: tokensOut}, nil
+}
+
+func (server msgServer) MigratePoolShares(goCtx context.Context, msg *types.MsgMigratePoolShares) (*types.MsgMigratePoolSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	sharesOut, err := server.keeper.MigratePoolShares(ctx, sender, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolSharesMigrated,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyShares, sharesOut.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgMigratePoolSharesResponse{SharesOut: sharesOut}, nil
+}
+
+func (server msgServer) SwapExactAmountInWithPriceImpact(goCtx context.Context, msg *types.MsgSwapExactAmountInWithPriceImpact) (*types.MsgSwapExactAmountInWithPriceImpactResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, err := server.keeper.MultihopSwapExactAmountInWithPriceImpact(ctx, sender, msg.Routes, msg.TokenIn, msg.MaxPriceImpact, msg.MaxSpotPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap event is handled elsewhere
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSwapExactAmountInWithPriceImpactResponse{TokenOutAmount: tokenOutAmount}, nil
+}
+
+func (server msgServer) SwapExactAmountOutWithPriceImpact(goCtx context.Context, msg *types.MsgSwapExactAmountOutWithPriceImpact) (*types.MsgSwapExactAmountOutWithPriceImpactResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInAmount, err := server.keeper.MultihopSwapExactAmountOutWithPriceImpact(ctx, sender, msg.Routes, msg.TokenOut, msg.MaxPriceImpact, msg.MaxSpotPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap event is handled elsewhere
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSwapExactAmountOutWithPriceImpactResponse{TokenInAmount: tokenInAmount}, nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/osmosis-labs/osmosis/x/gamm/types"
 )
@@ -31,6 +32,39 @@ func (k Keeper) MultihopSwapExactAmountIn(
 	return
 }
 
+// SplitRouteSwapExactAmountIn swaps the input amount of each route, in tokenInDenom, along that route.
+// All the swaps are reverted unless the sum of the amounts out is at least tokenOutMinAmount.
+func (k Keeper) SplitRouteSwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+	tokenOutMinAmount sdk.Int,
+) (tokenOutAmount sdk.Int, err error) {
+	if err := types.SwapAmountInSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	tokenOutAmount = sdk.ZeroInt()
+	for _, route := range routes {
+		amountOut, err := k.MultihopSwapExactAmountIn(cacheCtx, sender, route.Pools, sdk.NewCoin(tokenInDenom, route.TokenInAmount), sdk.NewInt(1))
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		tokenOutAmount = tokenOutAmount.Add(amountOut)
+	}
+
+	if tokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMinAmount, "%s token is lesser than min amount", routes[0].TokenOutDenom())
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return tokenOutAmount, nil
+}
+
 // MultihopSwapExactAmountOut defines the output denom and output amount for the last pool.
 // Calculation starts by providing the tokenOutAmount of the final pool to calculate the required tokenInAmount
 // the calculated tokenInAmount is used as defined tokenOutAmount of the previous pool, calculating in reverse order of the swap
@@ -68,30 +102,111 @@ func (k Keeper) MultihopSwapExactAmountOut(
 	return
 }
 
+// MultihopSwapExactAmountInWithPriceImpact swaps tokenIn along the routes as MultihopSwapExactAmountIn.
+// Rather than a min amount out, the swaps are reverted if their effective price is more than maxPriceImpact
+// above the spot price of the routes before the swaps, or if that spot price is above maxSpotPrice.
+func (k Keeper) MultihopSwapExactAmountInWithPriceImpact(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	maxPriceImpact sdk.Dec,
+	maxSpotPrice sdk.Dec,
+) (tokenOutAmount sdk.Int, err error) {
+	spotPrice := sdk.OneDec()
+	tokenInDenom := tokenIn.Denom
+	for _, route := range routes {
+		routeSpotPrice, err := k.CalculateSpotPriceWithSwapFee(ctx, route.PoolId, tokenInDenom, route.TokenOutDenom)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		spotPrice = spotPrice.Mul(routeSpotPrice)
+		tokenInDenom = route.TokenOutDenom
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	tokenOutAmount, err = k.MultihopSwapExactAmountIn(cacheCtx, sender, routes, tokenIn, sdk.NewInt(1))
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	err = checkPriceImpact(spotPrice, tokenIn.Amount, tokenOutAmount, maxPriceImpact, maxSpotPrice)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	write()
+	// The swap events were emitted on the cache context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return tokenOutAmount, nil
+}
+
+// MultihopSwapExactAmountOutWithPriceImpact swaps for tokenOut along the routes as MultihopSwapExactAmountOut.
+// Rather than a max amount in, the swaps are reverted if their effective price is more than maxPriceImpact
+// above the spot price of the routes before the swaps, or if that spot price is above maxSpotPrice.
+func (k Keeper) MultihopSwapExactAmountOutWithPriceImpact(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountOutRoute,
+	tokenOut sdk.Coin,
+	maxPriceImpact sdk.Dec,
+	maxSpotPrice sdk.Dec,
+) (tokenInAmount sdk.Int, err error) {
+	spotPrice := sdk.OneDec()
+	for i, route := range routes {
+		tokenOutDenom := tokenOut.Denom
+		if i != len(routes)-1 {
+			tokenOutDenom = routes[i+1].TokenInDenom
+		}
+
+		routeSpotPrice, err := k.CalculateSpotPriceWithSwapFee(ctx, route.PoolId, route.TokenInDenom, tokenOutDenom)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		spotPrice = spotPrice.Mul(routeSpotPrice)
+	}
+
+	insExpected, err := k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	tokenInAmount, err = k.MultihopSwapExactAmountOut(cacheCtx, sender, routes, insExpected[0], tokenOut)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	err = checkPriceImpact(spotPrice, tokenInAmount, tokenOut.Amount, maxPriceImpact, maxSpotPrice)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	write()
+	// The swap events were emitted on the cache context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return tokenInAmount, nil
+}
+
 // TODO: Document this function
 func (k Keeper) createMultihopExpectedSwapOuts(ctx sdk.Context, routes []types.SwapAmountOutRoute, tokenOut sdk.Coin) ([]sdk.Int, error) {
 	insExpected := make([]sdk.Int, len(routes))
 	for i := len(routes) - 1; i >= 0; i-- {
 		route := routes[i]
 
-		pool, inAsset, outAsset, err :=
-			k.getPoolAndInOutAssets(ctx, route.PoolId, route.TokenInDenom, tokenOut.Denom)
+		pool, err := k.GetPool(ctx, route.PoolId)
 		if err != nil {
 			return nil, err
 		}
 
-		tokenInAmount := calcInGivenOut(
-			inAsset.Token.Amount.ToDec(),
-			inAsset.Weight.ToDec(),
-			outAsset.Token.Amount.ToDec(),
-			outAsset.Weight.ToDec(),
-			tokenOut.Amount.ToDec(),
-			pool.GetPoolSwapFee(),
-		).TruncateInt()
+		tokenIn, err := pool.SwapInGivenOut(tokenOut, route.TokenInDenom, pool.GetPoolSwapFee())
+		if err != nil {
+			return nil, err
+		}
 
-		insExpected[i] = tokenInAmount
+		insExpected[i] = tokenIn.Amount
 
-		tokenOut = sdk.NewCoin(route.TokenInDenom, tokenInAmount)
+		tokenOut = tokenIn
 	}
 
 	return insExpected, nil
@@ -181,3 +181,39 @@ func (suite *KeeperTestSuite) TestSimpleMultihopSwapExactAmountOut() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestMultihopSwapWithPriceImpact() {
+	suite.preparePool()
+	suite.preparePool()
+	keeper := suite.app.GAMMKeeper
+
+	// The spot prices are 2 foo per bar in pool 1, and 1.5 bar per baz in pool 2, so 3 foo per baz.
+	inRoutes := []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}, {PoolId: 2, TokenOutDenom: "baz"}}
+	tokenIn := sdk.NewCoin("foo", sdk.NewInt(100000))
+	fooBalance := suite.app.BankKeeper.GetBalance(suite.ctx, acc1, "foo").Amount
+
+	// Swapping 2% of the foo of pool 1 moves the price by about 3%.
+	_, err := keeper.MultihopSwapExactAmountInWithPriceImpact(suite.ctx, acc1, inRoutes, tokenIn, sdk.NewDecWithPrec(1, 2), sdk.Dec{})
+	suite.Require().ErrorIs(err, types.ErrMaxPriceImpactExceeded)
+	suite.Require().Equal(fooBalance, suite.app.BankKeeper.GetBalance(suite.ctx, acc1, "foo").Amount)
+	_, err = keeper.MultihopSwapExactAmountInWithPriceImpact(suite.ctx, acc1, inRoutes, tokenIn, sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(29, 1))
+	suite.Require().ErrorIs(err, types.ErrMaxSpotPriceExceeded)
+
+	tokenOutAmount, err := keeper.MultihopSwapExactAmountInWithPriceImpact(suite.ctx, acc1, inRoutes, tokenIn, sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(31, 1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(fooBalance.Sub(tokenIn.Amount), suite.app.BankKeeper.GetBalance(suite.ctx, acc1, "foo").Amount)
+	effectivePrice := tokenIn.Amount.ToDec().Quo(tokenOutAmount.ToDec())
+	suite.Require().True(effectivePrice.GT(sdk.NewDec(3)))
+	suite.Require().True(effectivePrice.LT(sdk.NewDecWithPrec(315, 2)))
+
+	// Swapping for a small amount out barely moves the price.
+	outRoutes := []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: "foo"}, {PoolId: 2, TokenInDenom: "bar"}}
+	tokenOut := sdk.NewCoin("baz", sdk.NewInt(1000))
+	_, err = keeper.MultihopSwapExactAmountOutWithPriceImpact(suite.ctx, acc1, outRoutes, tokenOut, sdk.ZeroDec(), sdk.Dec{})
+	suite.Require().ErrorIs(err, types.ErrMaxPriceImpactExceeded)
+
+	bazBalance := suite.app.BankKeeper.GetBalance(suite.ctx, acc1, "baz").Amount
+	_, err = keeper.MultihopSwapExactAmountOutWithPriceImpact(suite.ctx, acc1, outRoutes, tokenOut, sdk.NewDecWithPrec(1, 2), sdk.Dec{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(bazBalance.Add(tokenOut.Amount), suite.app.BankKeeper.GetBalance(suite.ctx, acc1, "baz").Amount)
+}
@@ -37,6 +37,7 @@ func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
 	}
 
 	pool.PokeTokenWeights(ctx.BlockTime())
+	k.setTickStore(ctx, pool)
 
 	return pool, nil
 }
@@ -59,6 +60,7 @@ func (k Keeper) GetPools(ctx sdk.Context) (res []types.PoolI, err error) {
 		}
 
 		pool.PokeTokenWeights(ctx.BlockTime())
+		k.setTickStore(ctx, pool)
 
 		res = append(res, pool)
 	}
@@ -76,6 +78,12 @@ func (k Keeper) SetPool(ctx sdk.Context, pool types.PoolI) error {
 	poolKey := types.GetKeyPrefixPools(pool.GetId())
 	store.Set(poolKey, bz)
 
+	// The ticks of concentrated pools are stored apart from them.
+	if concentratedPool, ok := pool.(*types.ConcentratedPool); ok {
+		k.setTickChanges(ctx, concentratedPool)
+		k.setTickStore(ctx, pool)
+	}
+
 	return nil
 }
 
@@ -89,14 +97,42 @@ func (k Keeper) newBalancerPool(ctx sdk.Context, balancerPoolParams types.Balanc
 		return nil, err
 	}
 
+	err = k.createPoolAccount(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// newStableswapPool is an internal function that creates a new Stableswap Pool object with the provided
+// parameters, initial liquidity, amplification parameter and future governor.
+func (k Keeper) newStableswapPool(ctx sdk.Context, stableswapPoolParams types.StableswapPoolParams, initialLiquidity sdk.Coins, amplificationParameter uint64, futureGovernor string) (types.PoolI, error) {
+	poolId := k.GetNextPoolNumberAndIncrement(ctx)
+
+	pool, err := types.NewStableswapPool(poolId, stableswapPoolParams, initialLiquidity, amplificationParameter, futureGovernor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.createPoolAccount(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// createPoolAccount saves a newly constructed pool, and creates the module account holding its liquidity.
+func (k Keeper) createPoolAccount(ctx sdk.Context, pool types.PoolI) error {
 	acc := k.accountKeeper.GetAccount(ctx, pool.GetAddress())
 	if acc != nil {
-		return nil, sdkerrors.Wrapf(types.ErrPoolAlreadyExist, "pool %d already exist", poolId)
+		return sdkerrors.Wrapf(types.ErrPoolAlreadyExist, "pool %d already exist", pool.GetId())
 	}
 
-	err = k.SetPool(ctx, pool)
+	err := k.SetPool(ctx, pool)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Create and save corresponding module account to the account keeper
@@ -108,7 +144,7 @@ func (k Keeper) newBalancerPool(ctx sdk.Context, balancerPoolParams types.Balanc
 	))
 	k.accountKeeper.SetAccount(ctx, acc)
 
-	return pool, nil
+	return nil
 }
 
 // SetNextPoolNumber sets next pool number
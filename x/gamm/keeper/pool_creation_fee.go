@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+// GetPoolCreationFeeRecord returns the record of the creation fee paid for a pool.
+func (k Keeper) GetPoolCreationFeeRecord(ctx sdk.Context, poolId uint64) (types.PoolCreationFeeRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyPoolCreationFee(poolId))
+	if bz == nil {
+		return types.PoolCreationFeeRecord{}, sdkerrors.Wrapf(types.ErrPoolCreationFeeRecordNotFound, "pool %d", poolId)
+	}
+
+	var record types.PoolCreationFeeRecord
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+	return record, nil
+}
+
+// SetPoolCreationFeeRecord stores the record of the creation fee paid for a pool.
+func (k Keeper) SetPoolCreationFeeRecord(ctx sdk.Context, record types.PoolCreationFeeRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyPoolCreationFee(record.PoolId), k.cdc.MustMarshalBinaryBare(&record))
+}
+
+// GetPoolCreationFeeRecords returns the records of the creation fees paid for pools, in increasing pool id order.
+func (k Keeper) GetPoolCreationFeeRecords(ctx sdk.Context) []types.PoolCreationFeeRecord {
+	iter := k.iterator(ctx, types.KeyPrefixPoolCreationFees)
+	defer iter.Close()
+
+	records := []types.PoolCreationFeeRecord{}
+	for ; iter.Valid(); iter.Next() {
+		var record types.PoolCreationFeeRecord
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// getPoolCreationFee returns the pool creation fee to pay in feeDenom.
+// The fee is paid as set in the params if feeDenom is empty. Otherwise feeDenom must be allowed by the params,
+// and the fee is converted at the TWAP over the last PoolCreationFeeTwapWindow of the pool they set for it,
+// rounded up.
+func (k Keeper) getPoolCreationFee(ctx sdk.Context, params types.Params, feeDenom string) (sdk.Coins, error) {
+	if feeDenom == "" || (len(params.PoolCreationFee) == 1 && params.PoolCreationFee[0].Denom == feeDenom) {
+		return params.PoolCreationFee, nil
+	}
+	if len(params.PoolCreationFee) != 1 {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPoolCreationFeeDenom, "the pool creation fee is %s", params.PoolCreationFee)
+	}
+
+	for _, allowed := range params.PoolCreationFeeDenoms {
+		if allowed.Denom != feeDenom {
+			continue
+		}
+
+		if err := k.checkPoolOpen(ctx, allowed.PoolId); err != nil {
+			return nil, err
+		}
+		fee := params.PoolCreationFee[0]
+		price, err := k.ArithmeticTwap(ctx, allowed.PoolId, fee.Denom, feeDenom,
+			ctx.BlockTime().Add(-types.PoolCreationFeeTwapWindow), ctx.BlockTime())
+		if err != nil {
+			return nil, err
+		}
+
+		amount := price.MulInt(fee.Amount).Ceil().TruncateInt()
+		if !amount.IsPositive() {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidPoolCreationFeeDenom, "%s is priced at zero by pool %d", fee, allowed.PoolId)
+		}
+		return sdk.Coins{sdk.NewCoin(feeDenom, amount)}, nil
+	}
+
+	return nil, sdkerrors.Wrapf(types.ErrInvalidPoolCreationFeeDenom, "%s", feeDenom)
+}
+
+// chargePoolCreationFee makes sender pay the creation fee of a pool in feeDenom, sends it where the params set,
+// and records it.
+// It is only called once the pool is initialized, so that no fee is taken for a pool that failed to be created.
+func (k Keeper) chargePoolCreationFee(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, feeDenom string) error {
+	params := k.GetParams(ctx)
+	fee, err := k.getPoolCreationFee(ctx, params, feeDenom)
+	if err != nil {
+		return err
+	}
+
+	if !fee.Empty() {
+		switch params.PoolCreationFeeDestination {
+		case types.FeeToCommunityPool:
+			err = k.distrKeeper.FundCommunityPool(ctx, fee, sender)
+		case types.FeeBurned:
+			err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, fee)
+			if err == nil {
+				err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, fee)
+			}
+		case types.FeeToPoolIncentives:
+			err = k.poolIncentivesKeeper.AddToPoolGauge(ctx, sender, poolId, fee)
+		default:
+			err = sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown pool creation fee destination %d", params.PoolCreationFeeDestination)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	k.SetPoolCreationFeeRecord(ctx, types.PoolCreationFeeRecord{
+		PoolId:      poolId,
+		Payer:       sender.String(),
+		Fee:         fee,
+		Destination: params.PoolCreationFeeDestination,
+	})
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPoolCreationFeePaid,
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPayer, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		sdk.NewAttribute(types.AttributeKeyFeeTo, params.PoolCreationFeeDestination.String()),
+	))
+	return nil
+}
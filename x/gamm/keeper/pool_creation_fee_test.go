@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestPoolCreationFee() {
+	pricingPoolId := suite.preparePool()
+	gammKeeper := suite.app.GAMMKeeper
+	msgServer := keeper.NewMsgServerImpl(gammKeeper)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	createPool := func(sender sdk.AccAddress, feeDenom string, deposit int64) (uint64, error) {
+		poolId := gammKeeper.GetNextPoolNumberAndIncrement(suite.ctx)
+		gammKeeper.SetNextPoolNumber(suite.ctx, poolId)
+		_, err := msgServer.CreateBalancerPool(goCtx, &types.MsgCreateBalancerPool{
+			Sender: sender.String(),
+			PoolParams: types.BalancerPoolParams{
+				SwapFee: sdk.NewDecWithPrec(1, 2),
+				ExitFee: sdk.ZeroDec(),
+			},
+			PoolAssets: []types.PoolAsset{{
+				Weight: sdk.NewInt(1),
+				Token:  sdk.NewCoin("foo", sdk.NewInt(deposit)),
+			}, {
+				Weight: sdk.NewInt(1),
+				Token:  sdk.NewCoin("baz", sdk.NewInt(deposit)),
+			}},
+			FeeDenom: feeDenom,
+		})
+		return poolId, err
+	}
+
+	params := types.DefaultParams()
+	params.PoolCreationFee = sdk.Coins{sdk.NewInt64Coin("bar", 1000)}
+	params.PoolCreationFeeDenoms = []types.PoolCreationFeeDenom{{Denom: "foo", PoolId: pricingPoolId}}
+	gammKeeper.SetParams(suite.ctx, params)
+
+	// The fee goes to the community pool by default.
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+	poolId, err := createPool(acc2, "", 10000)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(1000), suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).Sub(communityPool).AmountOf("bar"))
+	res, err := gammKeeper.PoolCreationFee(goCtx, &types.QueryPoolCreationFeeRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.PoolCreationFeeRecord{
+		PoolId:      poolId,
+		Payer:       acc2.String(),
+		Fee:         params.PoolCreationFee,
+		Destination: types.FeeToCommunityPool,
+	}, res.Record)
+
+	// It can be burned instead, and paid in foo at the TWAP of the pricing pool, 2 foo per bar,
+	// once the pool has a price history over the whole window.
+	params.PoolCreationFeeDestination = types.FeeBurned
+	gammKeeper.SetParams(suite.ctx, params)
+	_, err = createPool(acc2, "foo", 10000)
+	suite.Require().ErrorIs(err, types.ErrTwapHistoryUnavailable)
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(types.PoolCreationFeeTwapWindow))
+	goCtx = sdk.WrapSDKContext(suite.ctx)
+
+	// Moving the spot price within the block doesn't change the fee.
+	_, _, err = gammKeeper.SwapExactAmountIn(suite.ctx, acc1, pricingPoolId, sdk.NewInt64Coin("bar", 1000000), "foo", sdk.OneInt())
+	suite.Require().NoError(err)
+	// The test accounts are funded without minting, so the foo to burn is minted first.
+	err = suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.Coins{sdk.NewInt64Coin("foo", 2000)})
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, acc2, sdk.Coins{sdk.NewInt64Coin("foo", 2000)})
+	suite.Require().NoError(err)
+	fooBalance := suite.app.BankKeeper.GetBalance(suite.ctx, acc2, "foo").Amount
+	fooSupply := suite.app.BankKeeper.GetSupply(suite.ctx).GetTotal().AmountOf("foo")
+	poolId, err = createPool(acc2, "foo", 10000)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(12000), fooBalance.Sub(suite.app.BankKeeper.GetBalance(suite.ctx, acc2, "foo").Amount))
+	suite.Require().Equal(sdk.NewInt(2000), fooSupply.Sub(suite.app.BankKeeper.GetSupply(suite.ctx).GetTotal().AmountOf("foo")))
+	record, err := gammKeeper.GetPoolCreationFeeRecord(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("foo", 2000)}, record.Fee)
+	suite.Require().Equal(types.FeeBurned, record.Destination)
+
+	// Or seed the gauge of the new pool for the longest lockable duration.
+	params.PoolCreationFeeDestination = types.FeeToPoolIncentives
+	gammKeeper.SetParams(suite.ctx, params)
+	poolId, err = createPool(acc3, "bar", 10000)
+	suite.Require().NoError(err)
+	lockableDurations := suite.app.PoolIncentivesKeeper.GetLockableDurations(suite.ctx)
+	gaugeId, err := suite.app.PoolIncentivesKeeper.GetPoolGaugeId(suite.ctx, poolId, lockableDurations[len(lockableDurations)-1])
+	suite.Require().NoError(err)
+	gauge, err := suite.app.IncentivesKeeper.GetGaugeByID(suite.ctx, gaugeId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.PoolCreationFee, gauge.Coins)
+
+	// The fee can't be paid in denoms that aren't allowed.
+	_, err = createPool(acc3, "uosmo", 10000)
+	suite.Require().ErrorIs(err, types.ErrInvalidPoolCreationFeeDenom)
+
+	// Nothing is charged for a pool that failed to be created.
+	barBalance := suite.app.BankKeeper.GetBalance(suite.ctx, acc3, "bar").Amount
+	poolId, err = createPool(acc3, "bar", 1_000_000_000)
+	suite.Require().Error(err)
+	suite.Require().Equal(barBalance, suite.app.BankKeeper.GetBalance(suite.ctx, acc3, "bar").Amount)
+	_, err = gammKeeper.PoolCreationFee(goCtx, &types.QueryPoolCreationFeeRequest{PoolId: poolId})
+	suite.Require().Error(err)
+
+	// The pricing pool was created with a fee as well.
+	suite.Require().Len(gammKeeper.GetPoolCreationFeeRecords(suite.ctx), 4)
+}
@@ -1,11 +1,8 @@
 package keeper
 
 import (
-	"fmt"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/osmosis-labs/osmosis/x/gamm/types"
 )
@@ -17,6 +14,37 @@ func (k Keeper) CreateBalancerPool(
 	poolAssets []types.PoolAsset,
 	futurePoolGovernor string,
 ) (uint64, error) {
+	return k.createBalancerPool(ctx, sender, BalancerPoolParams, poolAssets, futurePoolGovernor, "", "")
+}
+
+// createBalancerPool creates a balancer pool whose shares are displayed as shareSymbol,
+// or GAMM-<pool id> if shareSymbol is empty. The pool creation fee is paid in feeDenom.
+func (k Keeper) createBalancerPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	BalancerPoolParams types.BalancerPoolParams,
+	poolAssets []types.PoolAsset,
+	futurePoolGovernor string,
+	shareSymbol string,
+	feeDenom string,
+) (uint64, error) {
+	if err := types.ValidatePoolShareSymbol(shareSymbol); err != nil {
+		return 0, err
+	}
+	if shareSymbol != "" && k.isDenom(ctx, shareSymbol) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidPoolShareSymbol, "symbol %s is already a denom", shareSymbol)
+	}
+
+	// The creator of a liquidity bootstrapping pool is the only one allowed to join it during the sale.
+	if BalancerPoolParams.LbpParams != nil {
+		lbpParams := *BalancerPoolParams.LbpParams
+		if lbpParams.Finalized {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidLBP, "cannot create a finalized pool")
+		}
+		lbpParams.Creator = sender.String()
+		BalancerPoolParams.LbpParams = &lbpParams
+	}
+
 	if len(poolAssets) < types.MinPoolAssets {
 		return 0, types.ErrTooFewPoolAssets
 	}
@@ -28,13 +56,6 @@ func (k Keeper) CreateBalancerPool(
 		)
 	}
 
-	// send pool creation fee to community pool
-	params := k.GetParams(ctx)
-	err := k.distrKeeper.FundCommunityPool(ctx, params.PoolCreationFee, sender)
-	if err != nil {
-		return 0, err
-	}
-
 	pool, err := k.newBalancerPool(ctx, BalancerPoolParams, poolAssets, futurePoolGovernor)
 	if err != nil {
 		return 0, err
@@ -49,50 +70,99 @@ func (k Keeper) CreateBalancerPool(
 		return 0, types.ErrTooFewPoolAssets
 	}
 
-	coins = coins.Sort()
-	err = k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), coins)
+	err = k.initializePool(ctx, sender, pool, coins.Sort(), shareSymbol)
 	if err != nil {
 		return 0, err
 	}
 
+	err = k.chargePoolCreationFee(ctx, sender, pool.GetId(), feeDenom)
+	if err != nil {
+		return 0, err
+	}
+
+	return pool.GetId(), nil
+}
+
+func (k Keeper) CreateStableswapPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	stableswapPoolParams types.StableswapPoolParams,
+	initialLiquidity sdk.Coins,
+	amplificationParameter uint64,
+	futurePoolGovernor string,
+) (uint64, error) {
+	return k.createStableswapPool(ctx, sender, stableswapPoolParams, initialLiquidity, amplificationParameter, futurePoolGovernor, "")
+}
+
+// createStableswapPool creates a stableswap pool whose creation fee is paid in feeDenom.
+func (k Keeper) createStableswapPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	stableswapPoolParams types.StableswapPoolParams,
+	initialLiquidity sdk.Coins,
+	amplificationParameter uint64,
+	futurePoolGovernor string,
+	feeDenom string,
+) (uint64, error) {
+	if len(initialLiquidity) < types.MinPoolAssets {
+		return 0, types.ErrTooFewPoolAssets
+	}
+	if len(initialLiquidity) > types.MaxPoolAssets {
+		return 0, sdkerrors.Wrapf(
+			types.ErrTooManyPoolAssets,
+			"pool has too many PoolAssets (%d)", len(initialLiquidity),
+		)
+	}
+
+	pool, err := k.newStableswapPool(ctx, stableswapPoolParams, initialLiquidity, amplificationParameter, futurePoolGovernor)
+	if err != nil {
+		return 0, err
+	}
+
+	err = k.initializePool(ctx, sender, pool, initialLiquidity.Sort(), "")
+	if err != nil {
+		return 0, err
+	}
+
+	err = k.chargePoolCreationFee(ctx, sender, pool.GetId(), feeDenom)
+	if err != nil {
+		return 0, err
+	}
+
+	return pool.GetId(), nil
+}
+
+// initializePool funds a newly created pool with the initial liquidity coins from the sender,
+// mints the initial pool shares to the sender, and registers the share denom metadata.
+func (k Keeper) initializePool(ctx sdk.Context, sender sdk.AccAddress, pool types.PoolI, coins sdk.Coins, shareSymbol string) error {
+	err := k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), coins)
+	if err != nil {
+		return err
+	}
+
 	// Mint the initial 100.000000000000000000 share token to the sender
 	err = k.MintPoolShareToAccount(ctx, pool, sender, types.InitPoolSharesSupply)
 	if err != nil {
-		return 0, err
+		return err
 	}
 
 	// Finally, add the share token's meta data to the bank keeper.
-	poolShareBaseDenom := types.GetPoolShareDenom(pool.GetId())
-	poolShareDisplayDenom := fmt.Sprintf("GAMM-%d", pool.GetId())
-	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
-		Description: fmt.Sprintf("The share token of the gamm pool %d", pool.GetId()),
-		DenomUnits: []*banktypes.DenomUnit{
-			{
-				Denom:    poolShareBaseDenom,
-				Exponent: 0,
-				Aliases: []string{
-					"attopoolshare",
-				},
-			},
-			{
-				Denom:    poolShareDisplayDenom,
-				Exponent: types.OneShareExponent,
-				Aliases:  nil,
-			},
-		},
-		Base:    poolShareBaseDenom,
-		Display: poolShareDisplayDenom,
-	})
+	k.setPoolShareMetadata(ctx, pool, shareSymbol)
 
 	err = k.SetPool(ctx, pool)
 	if err != nil {
-		return 0, err
+		return err
+	}
+
+	err = k.createTwapRecords(ctx, pool)
+	if err != nil {
+		return err
 	}
 
 	k.hooks.AfterPoolCreated(ctx, sender, pool.GetId())
 	k.RecordTotalLiquidityIncrease(ctx, coins)
 
-	return pool.GetId(), nil
+	return nil
 }
 
 func (k Keeper) JoinPool(
@@ -107,13 +177,19 @@ func (k Keeper) JoinPool(
 		return err
 	}
 
-	totalSharesAmount := pool.GetTotalShares().Amount
-	// shareRatio is the desired number of shares, divided by the total number of
-	// shares currently in the pool. It is intended to be used in scenarios where you want
-	// (tokens per share) * number of shares out = # tokens * (# shares out / cur total shares)
-	shareRatio := shareOutAmount.ToDec().QuoInt(totalSharesAmount)
-	if shareRatio.LTE(sdk.ZeroDec()) {
-		return sdkerrors.Wrapf(types.ErrInvalidMathApprox, "share ratio is zero or negative")
+	err = k.checkPoolOpen(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	err = checkLBPJoin(pool, sender)
+	if err != nil {
+		return err
+	}
+
+	coins, err := pool.JoinPoolCoins(shareOutAmount)
+	if err != nil {
+		return err
 	}
 
 	// Assume that the tokenInMaxAmounts is validated.
@@ -122,23 +198,18 @@ func (k Keeper) JoinPool(
 		tokenInMaxMap[max.Denom] = max.Amount
 	}
 
-	PoolAssets := pool.GetAllPoolAssets()
-	newPoolCoins := make([]sdk.Coin, 0, len(PoolAssets))
+	newPoolCoins := make([]sdk.Coin, 0, len(coins))
 	// Transfer the PoolAssets tokens to the pool's module account from the user account.
-	var coins sdk.Coins
-	for _, PoolAsset := range PoolAssets {
-		tokenInAmount := shareRatio.MulInt(PoolAsset.Token.Amount).TruncateInt()
-		if tokenInAmount.LTE(sdk.ZeroInt()) {
-			return sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
+	for _, coin := range coins {
+		if tokenInMaxAmount, ok := tokenInMaxMap[coin.Denom]; ok && coin.Amount.GT(tokenInMaxAmount) {
+			return sdkerrors.Wrapf(types.ErrLimitMaxAmount, "%s token is larger than max amount", coin.Denom)
 		}
 
-		if tokenInMaxAmount, ok := tokenInMaxMap[PoolAsset.Token.Denom]; ok && tokenInAmount.GT(tokenInMaxAmount) {
-			return sdkerrors.Wrapf(types.ErrLimitMaxAmount, "%s token is larger than max amount", PoolAsset.Token.Denom)
+		poolBalance, err := pool.GetTokenBalance(coin.Denom)
+		if err != nil {
+			return err
 		}
-
-		newPoolCoins = append(newPoolCoins,
-			sdk.NewCoin(PoolAsset.Token.Denom, PoolAsset.Token.Amount.Add(tokenInAmount)))
-		coins = append(coins, sdk.NewCoin(PoolAsset.Token.Denom, tokenInAmount))
+		newPoolCoins = append(newPoolCoins, sdk.NewCoin(coin.Denom, poolBalance.Add(coin.Amount)))
 	}
 
 	err = pool.UpdatePoolAssetBalances(newPoolCoins)
@@ -163,6 +234,8 @@ func (k Keeper) JoinPool(
 
 	k.createAddLiquidityEvent(ctx, sender, pool.GetId(), coins)
 	k.hooks.AfterJoinPool(ctx, sender, pool.GetId(), coins, shareOutAmount)
+	k.recordJoinStats(ctx, pool.GetId(), coins)
+	k.trackChangedPool(ctx, pool.GetId())
 	k.RecordTotalLiquidityIncrease(ctx, coins)
 
 	return nil
@@ -184,19 +257,30 @@ func (k Keeper) JoinSwapExternAmountIn(
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrPoolLocked, "join swap on inactive pool")
 	}
 
+	// Single token joins swap against the pool, which only happens in batches in batch mode.
+	if k.IsBatchModePool(ctx, poolId) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrPoolInBatchMode, "swaps on pool %d are queued", poolId)
+	}
+
+	err = k.checkPoolOpen(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	err = checkLBPJoin(pool, sender)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
 	PoolAsset, err := pool.GetPoolAsset(tokenIn.Denom)
 	if err != nil {
 		return sdk.Int{}, err
 	}
 
-	shareOutAmount = calcPoolOutGivenSingleIn(
-		PoolAsset.Token.Amount.ToDec(),
-		PoolAsset.Weight.ToDec(),
-		pool.GetTotalShares().Amount.ToDec(),
-		pool.GetTotalWeight().ToDec(),
-		tokenIn.Amount.ToDec(),
-		pool.GetPoolSwapFee(),
-	).TruncateInt()
+	shareOutAmount, err = pool.JoinPoolShares(tokenIn)
+	if err != nil {
+		return sdk.Int{}, err
+	}
 
 	if shareOutAmount.LTE(sdk.ZeroInt()) {
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "share amount is zero or negative")
@@ -230,6 +314,8 @@ func (k Keeper) JoinSwapExternAmountIn(
 	addedCoins := sdk.Coins{tokenIn}
 	k.createAddLiquidityEvent(ctx, sender, pool.GetId(), addedCoins)
 	k.hooks.AfterJoinPool(ctx, sender, pool.GetId(), addedCoins, shareOutAmount)
+	k.recordJoinStats(ctx, pool.GetId(), addedCoins)
+	k.trackChangedPool(ctx, pool.GetId())
 	k.RecordTotalLiquidityIncrease(ctx, addedCoins)
 
 	return shareOutAmount, nil
@@ -252,19 +338,31 @@ func (k Keeper) JoinSwapShareAmountOut(
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrPoolLocked, "join swap on inactive pool")
 	}
 
+	// Single token joins swap against the pool, which only happens in batches in batch mode.
+	if k.IsBatchModePool(ctx, poolId) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrPoolInBatchMode, "swaps on pool %d are queued", poolId)
+	}
+
+	err = k.checkPoolOpen(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	err = checkLBPJoin(pool, sender)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
 	PoolAsset, err := pool.GetPoolAsset(tokenInDenom)
 	if err != nil {
 		return sdk.Int{}, err
 	}
 
-	tokenInAmount = calcSingleInGivenPoolOut(
-		PoolAsset.Token.Amount.ToDec(),
-		PoolAsset.Weight.ToDec(),
-		pool.GetTotalShares().Amount.ToDec(),
-		pool.GetTotalWeight().ToDec(),
-		shareOutAmount.ToDec(),
-		pool.GetPoolSwapFee(),
-	).TruncateInt()
+	tokenIn, err := pool.JoinPoolTokenIn(tokenInDenom, shareOutAmount)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	tokenInAmount = tokenIn.Amount
 
 	if tokenInAmount.LTE(sdk.ZeroInt()) {
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
@@ -298,9 +396,11 @@ func (k Keeper) JoinSwapShareAmountOut(
 	coinsAdded := sdk.Coins{sdk.NewCoin(tokenInDenom, tokenInAmount)}
 	k.createAddLiquidityEvent(ctx, sender, pool.GetId(), coinsAdded)
 	k.hooks.AfterJoinPool(ctx, sender, pool.GetId(), coinsAdded, shareOutAmount)
+	k.recordJoinStats(ctx, pool.GetId(), coinsAdded)
+	k.trackChangedPool(ctx, pool.GetId())
 	k.RecordTotalLiquidityIncrease(ctx, coinsAdded)
 
-	return shareOutAmount, nil
+	return tokenInAmount, nil
 }
 
 func (k Keeper) ExitPool(
@@ -315,13 +415,17 @@ func (k Keeper) ExitPool(
 		return err
 	}
 
-	totalSharesAmount := pool.GetTotalShares().Amount
+	err = k.checkPoolExitable(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
 	exitFee := pool.GetPoolExitFee().MulInt(shareInAmount).TruncateInt()
 	shareInAmountAfterExitFee := shareInAmount.Sub(exitFee)
-	shareRatio := shareInAmountAfterExitFee.ToDec().QuoInt(totalSharesAmount)
 
-	if shareRatio.LTE(sdk.ZeroDec()) {
-		return sdkerrors.Wrapf(types.ErrInvalidMathApprox, "share ratio is zero or negative")
+	coins, err := pool.ExitPoolCoins(shareInAmount)
+	if err != nil {
+		return err
 	}
 
 	// Assume that the tokenInMaxAmounts is validated.
@@ -330,25 +434,20 @@ func (k Keeper) ExitPool(
 		tokenOutMinMap[min.Denom] = min.Amount
 	}
 
-	PoolAssets := pool.GetAllPoolAssets()
-	newPoolCoins := make([]sdk.Coin, 0, len(PoolAssets))
+	newPoolCoins := make([]sdk.Coin, 0, len(coins))
 	// Transfer the PoolAssets tokens to the user account from the pool's module account.
-	var coins sdk.Coins
-	for _, PoolAsset := range PoolAssets {
-		tokenOutAmount := shareRatio.MulInt(PoolAsset.Token.Amount).TruncateInt()
-		if tokenOutAmount.LTE(sdk.ZeroInt()) {
-			return sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
-		}
-
+	for _, coin := range coins {
 		// Check if a minimum token amount is specified for this token,
 		// and if so ensure that the minimum is less than the amount returned.
-		if tokenOutMinAmount, ok := tokenOutMinMap[PoolAsset.Token.Denom]; ok && tokenOutAmount.LT(tokenOutMinAmount) {
-			return sdkerrors.Wrapf(types.ErrLimitMinAmount, "%s token is lesser than min amount", PoolAsset.Token.Denom)
+		if tokenOutMinAmount, ok := tokenOutMinMap[coin.Denom]; ok && coin.Amount.LT(tokenOutMinAmount) {
+			return sdkerrors.Wrapf(types.ErrLimitMinAmount, "%s token is lesser than min amount", coin.Denom)
 		}
 
-		newPoolCoins = append(newPoolCoins,
-			sdk.NewCoin(PoolAsset.Token.Denom, PoolAsset.Token.Amount.Sub(tokenOutAmount)))
-		coins = append(coins, sdk.NewCoin(PoolAsset.Token.Denom, tokenOutAmount))
+		poolBalance, err := pool.GetTokenBalance(coin.Denom)
+		if err != nil {
+			return err
+		}
+		newPoolCoins = append(newPoolCoins, sdk.NewCoin(coin.Denom, poolBalance.Sub(coin.Amount)))
 	}
 
 	err = pool.UpdatePoolAssetBalances(newPoolCoins)
@@ -382,6 +481,8 @@ func (k Keeper) ExitPool(
 
 	k.createRemoveLiquidityEvent(ctx, sender, pool.GetId(), coins)
 	k.hooks.AfterExitPool(ctx, sender, pool.GetId(), shareInAmount, coins)
+	k.recordExitStats(ctx, pool.GetId(), coins)
+	k.trackChangedPool(ctx, pool.GetId())
 	k.RecordTotalLiquidityDecrease(ctx, coins)
 
 	return nil
@@ -404,20 +505,27 @@ func (k Keeper) ExitSwapShareAmountIn(
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrPoolLocked, "exit swap on inactive pool")
 	}
 
+	// Single token exits swap against the pool, which only happens in batches in batch mode.
+	if k.IsBatchModePool(ctx, poolId) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrPoolInBatchMode, "swaps on pool %d are queued", poolId)
+	}
+
+	// Exiting to a single token swaps against the pool.
+	err = k.checkPoolOpen(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
 	PoolAsset, err := pool.GetPoolAsset(tokenOutDenom)
 	if err != nil {
 		return sdk.Int{}, err
 	}
 
-	tokenOutAmount = calcSingleOutGivenPoolIn(
-		PoolAsset.Token.Amount.ToDec(),
-		PoolAsset.Weight.ToDec(),
-		pool.GetTotalShares().Amount.ToDec(),
-		pool.GetTotalWeight().ToDec(),
-		shareInAmount.ToDec(),
-		pool.GetPoolSwapFee(),
-		pool.GetPoolExitFee(),
-	).TruncateInt()
+	tokenOut, err := pool.ExitPoolTokenOut(tokenOutDenom, shareInAmount)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	tokenOutAmount = tokenOut.Amount
 
 	if tokenOutAmount.LTE(sdk.ZeroInt()) {
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
@@ -468,6 +576,8 @@ func (k Keeper) ExitSwapShareAmountIn(
 	removedCoins := sdk.Coins{sdk.NewCoin(tokenOutDenom, tokenOutAmount)}
 	k.createRemoveLiquidityEvent(ctx, sender, pool.GetId(), removedCoins)
 	k.hooks.AfterExitPool(ctx, sender, pool.GetId(), shareInAmount, removedCoins)
+	k.recordExitStats(ctx, pool.GetId(), removedCoins)
+	k.trackChangedPool(ctx, pool.GetId())
 	k.RecordTotalLiquidityDecrease(ctx, removedCoins)
 
 	return tokenOutAmount, nil
@@ -489,20 +599,26 @@ func (k Keeper) ExitSwapExternAmountOut(
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrPoolLocked, "exit swap on inactive pool")
 	}
 
+	// Single token exits swap against the pool, which only happens in batches in batch mode.
+	if k.IsBatchModePool(ctx, poolId) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrPoolInBatchMode, "swaps on pool %d are queued", poolId)
+	}
+
+	// Exiting to a single token swaps against the pool.
+	err = k.checkPoolOpen(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
 	PoolAsset, err := pool.GetPoolAsset(tokenOut.Denom)
 	if err != nil {
 		return sdk.Int{}, err
 	}
 
-	shareInAmount = calcPoolInGivenSingleOut(
-		PoolAsset.Token.Amount.ToDec(),
-		PoolAsset.Weight.ToDec(),
-		pool.GetTotalShares().Amount.ToDec(),
-		pool.GetTotalWeight().ToDec(),
-		tokenOut.Amount.ToDec(),
-		pool.GetPoolSwapFee(),
-		pool.GetPoolExitFee(),
-	).TruncateInt()
+	shareInAmount, err = pool.ExitPoolShares(tokenOut)
+	if err != nil {
+		return sdk.Int{}, err
+	}
 
 	if shareInAmount.LTE(sdk.ZeroInt()) {
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
@@ -553,6 +669,8 @@ func (k Keeper) ExitSwapExternAmountOut(
 	removedCoins := sdk.Coins{tokenOut}
 	k.createRemoveLiquidityEvent(ctx, sender, pool.GetId(), removedCoins)
 	k.hooks.AfterExitPool(ctx, sender, pool.GetId(), shareInAmount, removedCoins)
+	k.recordExitStats(ctx, pool.GetId(), removedCoins)
+	k.trackChangedPool(ctx, pool.GetId())
 	k.RecordTotalLiquidityDecrease(ctx, removedCoins)
 
 	return shareInAmount, nil
@@ -5,6 +5,9 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/x/gamm/types"
 )
 
@@ -210,7 +213,9 @@ func (suite *KeeperTestSuite) TestCreatePool() {
 		fn: func() {
 			keeper := suite.app.GAMMKeeper
 			keeper.SetParams(suite.ctx, types.Params{
-				PoolCreationFee: sdk.Coins{},
+				PoolCreationFee:      sdk.Coins{},
+				ProtocolFeeShare:     sdk.ZeroDec(),
+				StatsEpochIdentifier: "day",
 			})
 			_, err := keeper.CreateBalancerPool(suite.ctx, acc1, types.BalancerPoolParams{
 				SwapFee: sdk.NewDecWithPrec(1, 2),
@@ -231,7 +236,9 @@ func (suite *KeeperTestSuite) TestCreatePool() {
 		fn: func() {
 			keeper := suite.app.GAMMKeeper
 			keeper.SetParams(suite.ctx, types.Params{
-				PoolCreationFee: nil,
+				PoolCreationFee:      nil,
+				ProtocolFeeShare:     sdk.ZeroDec(),
+				StatsEpochIdentifier: "day",
 			})
 			_, err := keeper.CreateBalancerPool(suite.ctx, acc1, types.BalancerPoolParams{
 				SwapFee: sdk.NewDecWithPrec(1, 2),
@@ -274,6 +281,177 @@ func (suite *KeeperTestSuite) TestCreatePool() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestPoolShareMetadata() {
+	poolId := suite.prepareBalancerPoolWithFutureGovernor(acc1.String())
+	gammKeeper := suite.app.GAMMKeeper
+	msgServer := keeper.NewMsgServerImpl(gammKeeper)
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "foo"}, {Denom: "FOO", Exponent: 6}},
+		Base:       "foo",
+		Display:    "FOO",
+	})
+
+	createMsg := &types.MsgCreateBalancerPool{
+		Sender: acc1.String(),
+		PoolParams: types.BalancerPoolParams{
+			SwapFee: sdk.NewDecWithPrec(1, 2),
+			ExitFee: sdk.NewDecWithPrec(1, 2),
+		},
+		PoolAssets: []types.PoolAsset{{
+			Weight: sdk.NewInt(100),
+			Token:  sdk.NewCoin("foo", sdk.NewInt(10000)),
+		}, {
+			Weight: sdk.NewInt(100),
+			Token:  sdk.NewCoin("bar", sdk.NewInt(10000)),
+		}},
+		ShareSymbol: "FOOBAR",
+	}
+	_, err := msgServer.CreateBalancerPool(sdk.WrapSDKContext(suite.ctx), createMsg)
+	suite.Require().NoError(err)
+	symbolPoolId := poolId + 1
+
+	// The share symbol is the display denom, and the assets are named by their display denoms.
+	metadata := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, types.GetPoolShareDenom(symbolPoolId))
+	suite.Require().Equal("FOOBAR", metadata.Display)
+	suite.Require().Equal(fmt.Sprintf("The share token of the gamm pool %d of bar/FOO", symbolPoolId), metadata.Description)
+	suite.Require().Len(metadata.DenomUnits, 2)
+	suite.Require().Equal(types.GetPoolShareDenom(symbolPoolId), metadata.DenomUnits[0].Denom)
+	suite.Require().Equal(uint32(types.OneShareExponent), metadata.DenomUnits[1].Exponent)
+	suite.Require().Equal([]string{types.GetPoolShareDisplayDenom(symbolPoolId)}, metadata.DenomUnits[1].Aliases)
+
+	// Denoms can't be share symbols.
+	createMsg.ShareSymbol = "foo"
+	_, err = msgServer.CreateBalancerPool(sdk.WrapSDKContext(suite.ctx), createMsg)
+	suite.Require().ErrorIs(err, types.ErrInvalidPoolShareSymbol)
+
+	// Pools created before keep their share symbols, and are described by their assets.
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+		Description: fmt.Sprintf("The share token of the gamm pool %d", poolId),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: types.GetPoolShareDenom(poolId)},
+			{Denom: types.GetPoolShareDisplayDenom(poolId), Exponent: types.OneShareExponent},
+		},
+		Base:    types.GetPoolShareDenom(poolId),
+		Display: types.GetPoolShareDisplayDenom(poolId),
+	})
+	err = gammKeeper.BackfillPoolShareMetadata(suite.ctx)
+	suite.Require().NoError(err)
+
+	metadata = suite.app.BankKeeper.GetDenomMetaData(suite.ctx, types.GetPoolShareDenom(poolId))
+	suite.Require().Equal(types.GetPoolShareDisplayDenom(poolId), metadata.Display)
+	suite.Require().Equal(fmt.Sprintf("The share token of the gamm pool %d of bar/FOO", poolId), metadata.Description)
+	metadata = suite.app.BankKeeper.GetDenomMetaData(suite.ctx, types.GetPoolShareDenom(symbolPoolId))
+	suite.Require().Equal("FOOBAR", metadata.Display)
+}
+
+func (suite *KeeperTestSuite) TestCreateStableswapPool() {
+	params := suite.app.GAMMKeeper.GetParams(suite.ctx)
+	keeper := suite.app.GAMMKeeper
+
+	poolCreationFeeDecCoins := sdk.DecCoins{}
+	for _, coin := range params.PoolCreationFee {
+		poolCreationFeeDecCoins = poolCreationFeeDecCoins.Add(sdk.NewDecCoin(coin.Denom, coin.Amount))
+	}
+
+	initialLiquidity := sdk.NewCoins(
+		sdk.NewCoin("foo", sdk.NewInt(10000)),
+		sdk.NewCoin("bar", sdk.NewInt(10000)),
+	)
+
+	// Try to create pool without balances.
+	_, err := keeper.CreateStableswapPool(suite.ctx, acc1, types.StableswapPoolParams{
+		SwapFee: sdk.NewDecWithPrec(1, 2),
+		ExitFee: sdk.NewDecWithPrec(1, 2),
+	}, initialLiquidity, 100, defaultFutureGovernor)
+	suite.Require().Error(err)
+
+	err = suite.app.BankKeeper.AddCoins(
+		suite.ctx,
+		acc1,
+		sdk.NewCoins(
+			sdk.NewCoin("uosmo", sdk.NewInt(10000000000)),
+			sdk.NewCoin("foo", sdk.NewInt(10000000)),
+			sdk.NewCoin("bar", sdk.NewInt(10000000)),
+		),
+	)
+	suite.Require().NoError(err)
+
+	// Try to create pool with an invalid amplification parameter.
+	_, err = keeper.CreateStableswapPool(suite.ctx, acc1, types.StableswapPoolParams{
+		SwapFee: sdk.NewDecWithPrec(1, 2),
+		ExitFee: sdk.NewDecWithPrec(1, 2),
+	}, initialLiquidity, 0, defaultFutureGovernor)
+	suite.Require().Error(err)
+
+	prevFeePool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+	prevAcc1Bal := suite.app.BankKeeper.GetAllBalances(suite.ctx, acc1)
+	poolId, err := keeper.CreateStableswapPool(suite.ctx, acc1, types.StableswapPoolParams{
+		SwapFee: sdk.NewDecWithPrec(1, 2),
+		ExitFee: sdk.NewDecWithPrec(1, 2),
+	}, initialLiquidity, 100, defaultFutureGovernor)
+	suite.Require().NoError(err)
+
+	pool, err := keeper.GetPool(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	stableswapPool, ok := pool.(*types.StableswapPool)
+	suite.Require().True(ok)
+	suite.Require().Equal(uint64(100), stableswapPool.GetAmplificationParameter())
+	suite.Require().Equal(types.InitPoolSharesSupply.String(), pool.GetTotalShares().Amount.String(),
+		fmt.Sprintf("share token should be minted as %s initially", types.InitPoolSharesSupply.String()),
+	)
+
+	// check fee is correctly sent to community pool
+	feePool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+	suite.Require().Equal(feePool, prevFeePool.Add(poolCreationFeeDecCoins...))
+
+	// check account's balance is correctly reduced
+	acc1Bal := suite.app.BankKeeper.GetAllBalances(suite.ctx, acc1)
+	suite.Require().Equal(acc1Bal.String(),
+		prevAcc1Bal.Sub(params.PoolCreationFee).
+			Sub(initialLiquidity).
+			Add(sdk.NewCoin(types.GetPoolShareDenom(pool.GetId()), types.InitPoolSharesSupply)).
+			String(),
+	)
+
+	// check the pool can be queried
+	poolRes, err := suite.queryClient.Pool(suite.ctx.Context(), &types.QueryPoolRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	var queriedPool types.PoolI
+	err = suite.app.InterfaceRegistry().UnpackAny(poolRes.Pool, &queriedPool)
+	suite.Require().NoError(err)
+	suite.Require().Equal(poolId, queriedPool.GetId())
+
+	poolParamsRes, err := suite.queryClient.PoolParams(suite.ctx.Context(), &types.QueryPoolParamsRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(1, 2), poolParamsRes.GetStableswapPoolParams().SwapFee)
+}
+
+func (suite *KeeperTestSuite) TestStableswapPoolJoinExit() {
+	poolId := suite.prepareStableswapPool(100)
+	keeper := suite.app.GAMMKeeper
+
+	// Proportional join and exit are supported.
+	err := keeper.JoinPool(suite.ctx, acc2, poolId, types.OneShare.MulRaw(50), sdk.Coins{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.OneShare.MulRaw(50).String(),
+		suite.app.BankKeeper.GetBalance(suite.ctx, acc2, types.GetPoolShareDenom(poolId)).Amount.String())
+
+	err = keeper.ExitPool(suite.ctx, acc2, poolId, types.OneShare.MulRaw(50), sdk.Coins{})
+	suite.Require().NoError(err)
+	suite.Require().True(
+		suite.app.BankKeeper.GetBalance(suite.ctx, acc2, types.GetPoolShareDenom(poolId)).Amount.IsZero())
+
+	// Single asset join and exit are not.
+	_, err = keeper.JoinSwapExternAmountIn(suite.ctx, acc2, poolId, sdk.NewCoin("foo", sdk.NewInt(1000)), sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrUnsupportedPoolOperation)
+	_, err = keeper.JoinSwapShareAmountOut(suite.ctx, acc2, poolId, "foo", types.OneShare, sdk.NewInt(1000000))
+	suite.Require().ErrorIs(err, types.ErrUnsupportedPoolOperation)
+	_, err = keeper.ExitSwapShareAmountIn(suite.ctx, acc1, poolId, "foo", types.OneShare, sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrUnsupportedPoolOperation)
+	_, err = keeper.ExitSwapExternAmountOut(suite.ctx, acc1, poolId, sdk.NewCoin("foo", sdk.NewInt(1000)), types.OneShare)
+	suite.Require().ErrorIs(err, types.ErrUnsupportedPoolOperation)
+}
+
 func (suite *KeeperTestSuite) TestJoinPool() {
 	tests := []struct {
 		fn func(poolId uint64)
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+// GetCurrentStatsEpoch returns the current epoch of the stats epoch identifier.
+func (k Keeper) GetCurrentStatsEpoch(ctx sdk.Context) int64 {
+	return k.epochKeeper.GetEpochInfo(ctx, k.GetParams(ctx).StatsEpochIdentifier).CurrentEpoch
+}
+
+// GetPoolStats returns the stats of a pool during the epochs between fromEpoch and toEpoch, both included,
+// by increasing epoch. Epochs the pool didn't change in have no stats.
+func (k Keeper) GetPoolStats(ctx sdk.Context, poolId uint64, fromEpoch, toEpoch int64) []types.PoolEpochStats {
+	if fromEpoch < 0 {
+		fromEpoch = 0
+	}
+	if toEpoch < fromEpoch {
+		return []types.PoolEpochStats{}
+	}
+
+	end := sdk.PrefixEndBytes(types.GetKeyPrefixPoolStats(poolId))
+	if toEpoch < math.MaxInt64 {
+		end = types.GetKeyPoolStats(poolId, toEpoch+1)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.GetKeyPoolStats(poolId, fromEpoch), end)
+	defer iter.Close()
+
+	stats := []types.PoolEpochStats{}
+	for ; iter.Valid(); iter.Next() {
+		var epochStats types.PoolEpochStats
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &epochStats)
+		stats = append(stats, epochStats)
+	}
+	return stats
+}
+
+// GetAllPoolStats returns the stats of every pool, by pool and increasing epoch.
+func (k Keeper) GetAllPoolStats(ctx sdk.Context) []types.PoolEpochStats {
+	iter := k.iterator(ctx, types.KeyPrefixPoolStats)
+	defer iter.Close()
+
+	stats := []types.PoolEpochStats{}
+	for ; iter.Valid(); iter.Next() {
+		var epochStats types.PoolEpochStats
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &epochStats)
+		stats = append(stats, epochStats)
+	}
+	return stats
+}
+
+func (k Keeper) SetPoolStats(ctx sdk.Context, stats types.PoolEpochStats) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyPoolStats(stats.PoolId, stats.Epoch), k.cdc.MustMarshalBinaryBare(&stats))
+}
+
+// getCurrentPoolStats returns the stats of a pool during the current epoch, empty if there are none yet.
+func (k Keeper) getCurrentPoolStats(ctx sdk.Context, poolId uint64) types.PoolEpochStats {
+	epoch := k.GetCurrentStatsEpoch(ctx)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyPoolStats(poolId, epoch))
+	if bz == nil {
+		return types.PoolEpochStats{
+			PoolId:        poolId,
+			Epoch:         epoch,
+			SwapVolumeIn:  sdk.Coins{},
+			SwapVolumeOut: sdk.Coins{},
+			SwapFees:      sdk.Coins{},
+			Joined:        sdk.Coins{},
+			Exited:        sdk.Coins{},
+			Liquidity:     sdk.Coins{},
+			TotalShares:   sdk.NewCoin(types.GetPoolShareDenom(poolId), sdk.ZeroInt()),
+		}
+	}
+
+	var stats types.PoolEpochStats
+	k.cdc.MustUnmarshalBinaryBare(bz, &stats)
+	return stats
+}
+
+// recordSwapStats adds swaps on a pool to its stats of the current epoch.
+func (k Keeper) recordSwapStats(ctx sdk.Context, poolId uint64, tokensIn, tokensOut, swapFees sdk.Coins, numSwaps uint64) {
+	stats := k.getCurrentPoolStats(ctx, poolId)
+	stats.SwapVolumeIn = stats.SwapVolumeIn.Add(tokensIn...)
+	stats.SwapVolumeOut = stats.SwapVolumeOut.Add(tokensOut...)
+	stats.SwapFees = stats.SwapFees.Add(swapFees...)
+	stats.NumSwaps += numSwaps
+	k.SetPoolStats(ctx, stats)
+}
+
+// recordJoinStats adds a join of a pool to its stats of the current epoch.
+func (k Keeper) recordJoinStats(ctx sdk.Context, poolId uint64, tokensIn sdk.Coins) {
+	stats := k.getCurrentPoolStats(ctx, poolId)
+	stats.Joined = stats.Joined.Add(tokensIn...)
+	stats.NumJoins++
+	k.SetPoolStats(ctx, stats)
+}
+
+// recordExitStats adds an exit of a pool to its stats of the current epoch.
+func (k Keeper) recordExitStats(ctx sdk.Context, poolId uint64, tokensOut sdk.Coins) {
+	stats := k.getCurrentPoolStats(ctx, poolId)
+	stats.Exited = stats.Exited.Add(tokensOut...)
+	stats.NumExits++
+	k.SetPoolStats(ctx, stats)
+}
+
+// updatePoolStatsLiquidity records the liquidity of a pool changed in the block in its stats of the current epoch,
+// and prunes its stats older than the retention period.
+func (k Keeper) updatePoolStatsLiquidity(ctx sdk.Context, poolId uint64) error {
+	pool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	stats := k.getCurrentPoolStats(ctx, poolId)
+	stats.Liquidity = types.PoolAssetsCoins(pool.GetAllPoolAssets())
+	stats.TotalShares = pool.GetTotalShares()
+	k.SetPoolStats(ctx, stats)
+
+	retention := k.GetParams(ctx).StatsRetentionEpochs
+	if retention == 0 || retention > uint64(stats.Epoch) {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.GetKeyPrefixPoolStats(poolId), types.GetKeyPoolStats(poolId, stats.Epoch-int64(retention)))
+	defer iter.Close()
+
+	prunedKeys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		prunedKeys = append(prunedKeys, iter.Key())
+	}
+	for _, key := range prunedKeys {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// swapFeeOf returns the swap fee paid on tokenIn.
+func swapFeeOf(tokenIn sdk.Coin, swapFee sdk.Dec) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(tokenIn.Denom, tokenIn.Amount.ToDec().Mul(swapFee).TruncateInt()))
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) setStatsEpoch(epoch int64) {
+	epochInfo := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "day")
+	epochInfo.CurrentEpoch = epoch
+	suite.app.EpochsKeeper.SetEpochInfo(suite.ctx, epochInfo)
+}
+
+func (suite *KeeperTestSuite) TestPoolStats() {
+	poolId := suite.prepareBalancerPoolWithFutureGovernor(acc1.String())
+	gammKeeper := suite.app.GAMMKeeper
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	suite.setStatsEpoch(1)
+
+	// The pool was created in epoch 1, but nothing is recorded until the end of the block.
+	res, err := suite.queryClient.PoolStats(goCtx, &types.QueryPoolStatsRequest{PoolId: poolId, FromEpoch: 0, ToEpoch: 10})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Stats)
+	suite.Require().Equal(int64(1), res.CurrentEpoch)
+
+	tokenIn := sdk.NewCoin("foo", sdk.NewInt(100000))
+	tokenOutAmount, _, err := gammKeeper.SwapExactAmountIn(suite.ctx, acc2, poolId, tokenIn, "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	err = gammKeeper.JoinPool(suite.ctx, acc2, poolId, types.OneShare.MulRaw(10), nil)
+	suite.Require().NoError(err)
+	err = gammKeeper.ExitPool(suite.ctx, acc2, poolId, types.OneShare.MulRaw(5), sdk.Coins{})
+	suite.Require().NoError(err)
+	gammKeeper.EndBlock(suite.ctx)
+
+	res, err = suite.queryClient.PoolStats(goCtx, &types.QueryPoolStatsRequest{PoolId: poolId, FromEpoch: 0, ToEpoch: 10})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Stats, 1)
+	stats := res.Stats[0]
+	suite.Require().Equal(int64(1), stats.Epoch)
+	suite.Require().Equal(sdk.Coins{tokenIn}, stats.SwapVolumeIn)
+	suite.Require().Equal(sdk.Coins{sdk.NewCoin("bar", tokenOutAmount)}, stats.SwapVolumeOut)
+	// The swap fee is 1%.
+	suite.Require().Equal(sdk.Coins{sdk.NewCoin("foo", sdk.NewInt(1000))}, stats.SwapFees)
+	suite.Require().Equal(uint64(1), stats.NumSwaps)
+	suite.Require().Equal(uint64(1), stats.NumJoins)
+	suite.Require().Equal(uint64(1), stats.NumExits)
+	suite.Require().False(stats.Joined.Empty())
+	suite.Require().False(stats.Exited.Empty())
+
+	pool, err := gammKeeper.GetPool(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.PoolAssetsCoins(pool.GetAllPoolAssets()), stats.Liquidity)
+	suite.Require().Equal(pool.GetTotalShares(), stats.TotalShares)
+
+	// Stats of later epochs are kept apart, and old ones are pruned.
+	params := gammKeeper.GetParams(suite.ctx)
+	params.StatsRetentionEpochs = 2
+	gammKeeper.SetParams(suite.ctx, params)
+
+	for epoch := int64(2); epoch <= 4; epoch++ {
+		suite.setStatsEpoch(epoch)
+		_, _, err = gammKeeper.SwapExactAmountIn(suite.ctx, acc2, poolId, tokenIn, "bar", sdk.OneInt())
+		suite.Require().NoError(err)
+		gammKeeper.EndBlock(suite.ctx)
+	}
+
+	res, err = suite.queryClient.PoolStats(goCtx, &types.QueryPoolStatsRequest{PoolId: poolId, FromEpoch: 0, ToEpoch: 10})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Stats, 3)
+	suite.Require().Equal(int64(2), res.Stats[0].Epoch)
+	suite.Require().Equal(int64(4), res.Stats[2].Epoch)
+	suite.Require().Equal(uint64(0), res.Stats[2].NumJoins)
+
+	res, err = suite.queryClient.PoolStats(goCtx, &types.QueryPoolStatsRequest{PoolId: poolId, FromEpoch: 3, ToEpoch: 3})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Stats, 1)
+	suite.Require().Equal(int64(3), res.Stats[0].Epoch)
+
+	_, err = suite.queryClient.PoolStats(goCtx, &types.QueryPoolStatsRequest{PoolId: poolId, FromEpoch: 3, ToEpoch: 2})
+	suite.Require().Error(err)
+	_, err = suite.queryClient.PoolStats(goCtx, &types.QueryPoolStatsRequest{PoolId: poolId + 1, FromEpoch: 0, ToEpoch: 10})
+	suite.Require().Error(err)
+}
@@ -0,0 +1,255 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/x/lockup/types"
+)
+
+// GetPoolStatus returns the status of a pool, which is active unless governance changed it.
+func (k Keeper) GetPoolStatus(ctx sdk.Context, poolId uint64) types.PoolStatus {
+	return k.GetPoolStatusRecord(ctx, poolId).Status
+}
+
+// GetPoolStatusRecord returns the status record of a pool, which is active unless governance changed it.
+func (k Keeper) GetPoolStatusRecord(ctx sdk.Context, poolId uint64) types.PoolStatusRecord {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyPoolStatus(poolId))
+	if bz == nil {
+		return types.PoolStatusRecord{PoolId: poolId, Status: types.PoolActive}
+	}
+
+	var record types.PoolStatusRecord
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+	return record
+}
+
+// SetPoolStatus sets the status of a pool.
+func (k Keeper) SetPoolStatus(ctx sdk.Context, poolId uint64, status types.PoolStatus) {
+	k.SetPoolStatusRecord(ctx, types.PoolStatusRecord{PoolId: poolId, Status: status})
+}
+
+// SetPoolStatusRecord sets the status record of a pool. Only the records of the pools that aren't active are stored.
+func (k Keeper) SetPoolStatusRecord(ctx sdk.Context, record types.PoolStatusRecord) {
+	store := ctx.KVStore(k.storeKey)
+	if record.Status == types.PoolActive {
+		store.Delete(types.GetKeyPoolStatus(record.PoolId))
+		return
+	}
+
+	store.Set(types.GetKeyPoolStatus(record.PoolId), k.cdc.MustMarshalBinaryBare(&record))
+}
+
+// GetPoolStatusRecords returns the statuses of the pools that aren't active, in increasing pool id order.
+func (k Keeper) GetPoolStatusRecords(ctx sdk.Context) []types.PoolStatusRecord {
+	iter := k.iterator(ctx, types.KeyPrefixPoolStatuses)
+	defer iter.Close()
+
+	records := []types.PoolStatusRecord{}
+	for ; iter.Valid(); iter.Next() {
+		var record types.PoolStatusRecord
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// checkPoolOpen returns an error if the pool can't be swapped against or joined because of its status.
+func (k Keeper) checkPoolOpen(ctx sdk.Context, poolId uint64) error {
+	return k.GetPoolStatus(ctx, poolId).CheckOpen(poolId)
+}
+
+// checkPoolExitable returns an error if the pool can't be exited because of its status.
+func (k Keeper) checkPoolExitable(ctx sdk.Context, poolId uint64) error {
+	return k.GetPoolStatus(ctx, poolId).CheckExitable(poolId)
+}
+
+// UpdatePoolStatus pauses, reactivates or deprecates a pool on behalf of governance.
+func (k Keeper) UpdatePoolStatus(ctx sdk.Context, poolId uint64, status types.PoolStatus) error {
+	_, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	err = types.ValidatePoolStatusChange(k.GetPoolStatus(ctx, poolId), status)
+	if err != nil {
+		return err
+	}
+
+	k.SetPoolStatus(ctx, poolId, status)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPoolStatusSet,
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyStatus, status.String()),
+	))
+	return nil
+}
+
+// MigratePool moves all the tokens of a pool into a new balancer pool with poolParams and the weights of
+// poolWeights, on behalf of governance. The new pool has as many shares as the old one, and holds the same
+// tokens, so that the shares keep their value. Locked shares are replaced in the lockup module account,
+// and the locks are updated by the AfterPoolMigrated hook. The other holders of shares of the old pool
+// replace them by shares of the new pool with MigratePoolShares, which are minted then.
+// The old pool is left without tokens, and can't be used anymore.
+func (k Keeper) MigratePool(
+	ctx sdk.Context,
+	poolId uint64,
+	poolParams types.BalancerPoolParams,
+	poolWeights []types.PoolAsset,
+	futurePoolGovernor string,
+) (uint64, error) {
+	oldPool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.checkPoolExitable(ctx, poolId); err != nil {
+		return 0, err
+	}
+	if err := types.CheckMigratable(oldPool); err != nil {
+		return 0, err
+	}
+	if poolParams.LbpParams != nil {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidPoolMigration, "pools can't be migrated to liquidity bootstrapping pools")
+	}
+
+	if len(poolWeights) != oldPool.NumAssets() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidPoolMigration, "pool %d has %d assets", poolId, oldPool.NumAssets())
+	}
+	poolAssets := make([]types.PoolAsset, 0, len(poolWeights))
+	for _, asset := range poolWeights {
+		balance, err := oldPool.GetTokenBalance(asset.Token.Denom)
+		if err != nil {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidPoolMigration, "pool %d has no %s", poolId, asset.Token.Denom)
+		}
+		poolAssets = append(poolAssets, types.PoolAsset{
+			Token:  sdk.NewCoin(asset.Token.Denom, balance),
+			Weight: asset.Weight,
+		})
+	}
+
+	newPool, err := k.newBalancerPool(ctx, poolParams, poolAssets, futurePoolGovernor)
+	if err != nil {
+		return 0, err
+	}
+
+	coins := types.PoolAssetsCoins(oldPool.GetAllPoolAssets())
+	err = k.bankKeeper.SendCoins(ctx, oldPool.GetAddress(), newPool.GetAddress(), coins)
+	if err != nil {
+		return 0, err
+	}
+
+	migratedShares := oldPool.GetTotalShares().Amount
+	newPool.AddTotalShares(migratedShares)
+	err = k.migrateLockedPoolShares(ctx, oldPool, newPool)
+	if err != nil {
+		return 0, err
+	}
+
+	err = types.DrainPool(oldPool)
+	if err != nil {
+		return 0, err
+	}
+	err = k.SetPool(ctx, oldPool)
+	if err != nil {
+		return 0, err
+	}
+	k.SetPoolStatusRecord(ctx, types.PoolStatusRecord{
+		PoolId:           poolId,
+		Status:           types.PoolMigrated,
+		MigratedToPoolId: newPool.GetId(),
+	})
+
+	k.setPoolShareMetadata(ctx, newPool, "")
+	err = k.SetPool(ctx, newPool)
+	if err != nil {
+		return 0, err
+	}
+	err = k.createTwapRecords(ctx, newPool)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPoolMigrated,
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyNewPoolId, strconv.FormatUint(newPool.GetId(), 10)),
+		sdk.NewAttribute(types.AttributeKeyShares, migratedShares.String()),
+		sdk.NewAttribute(types.AttributeKeyTokensIn, coins.String()),
+	))
+
+	k.hooks.AfterPoolCreated(ctx, k.accountKeeper.GetModuleAddress(govtypes.ModuleName), newPool.GetId())
+	k.hooks.AfterPoolMigrated(ctx, poolId, newPool.GetId())
+	k.trackChangedPool(ctx, newPool.GetId())
+
+	return newPool.GetId(), nil
+}
+
+// migrateLockedPoolShares replaces the shares of oldPool held by the lockup module account by as many shares
+// of newPool, which are already counted in the total shares of newPool.
+func (k Keeper) migrateLockedPoolShares(ctx sdk.Context, oldPool, newPool types.PoolI) error {
+	lockupAddr := k.accountKeeper.GetModuleAddress(lockuptypes.ModuleName)
+	if lockupAddr == nil {
+		return nil
+	}
+	return k.replacePoolShares(ctx, oldPool, newPool, lockupAddr)
+}
+
+// MigratePoolShares replaces all the shares of a migrated pool held by sender by as many shares of the pool
+// its liquidity was moved to. It returns the shares of the new pool minted to sender.
+func (k Keeper) MigratePoolShares(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) (sdk.Coin, error) {
+	record := k.GetPoolStatusRecord(ctx, poolId)
+	if record.Status != types.PoolMigrated {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidPoolMigration, "pool %d is not migrated", poolId)
+	}
+	oldPool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	newPool, err := k.GetPool(ctx, record.MigratedToPoolId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shares := k.bankKeeper.GetBalance(ctx, sender, types.GetPoolShareDenom(poolId))
+	if !shares.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidPoolMigration, "no shares of pool %d to migrate", poolId)
+	}
+	err = k.replacePoolShares(ctx, oldPool, newPool, sender)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	err = k.SetPool(ctx, oldPool)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(types.GetPoolShareDenom(newPool.GetId()), shares.Amount), nil
+}
+
+// replacePoolShares burns the shares of oldPool held by holder, and mints as many shares of newPool to it.
+// The new shares are sent from the module account rather than from the module,
+// so that module accounts that can't receive tokens, like the lockup one, get them too.
+func (k Keeper) replacePoolShares(ctx sdk.Context, oldPool, newPool types.PoolI, holder sdk.AccAddress) error {
+	amount := k.bankKeeper.GetBalance(ctx, holder, types.GetPoolShareDenom(oldPool.GetId())).Amount
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	err := k.BurnPoolShareFromAccount(ctx, oldPool, holder, amount)
+	if err != nil {
+		return err
+	}
+
+	newShares := sdk.Coins{sdk.NewCoin(types.GetPoolShareDenom(newPool.GetId()), amount)}
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, newShares)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoins(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), holder, newShares)
+}
@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammkeeper "github.com/osmosis-labs/osmosis/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+	poolincentivestypes "github.com/osmosis-labs/osmosis/x/pool-incentives/types"
+)
+
+func (suite *KeeperTestSuite) TestPoolStatus() {
+	poolId := suite.preparePool()
+	keeper := suite.app.GAMMKeeper
+	suite.Require().Equal(types.PoolActive, keeper.GetPoolStatus(suite.ctx, poolId))
+
+	err := keeper.UpdatePoolStatus(suite.ctx, poolId+1, types.PoolPaused)
+	suite.Require().Error(err)
+	err = keeper.UpdatePoolStatus(suite.ctx, poolId, types.PoolActive)
+	suite.Require().ErrorIs(err, types.ErrInvalidPoolStatus)
+	err = keeper.UpdatePoolStatus(suite.ctx, poolId, types.PoolMigrated)
+	suite.Require().ErrorIs(err, types.ErrInvalidPoolStatus)
+
+	// Paused pools can only be exited.
+	err = keeper.UpdatePoolStatus(suite.ctx, poolId, types.PoolPaused)
+	suite.Require().NoError(err)
+	_, _, err = keeper.SwapExactAmountIn(suite.ctx, acc1, poolId, sdk.NewCoin("foo", sdk.NewInt(100000)), "bar", sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolPaused)
+	_, _, err = keeper.SwapExactAmountOut(suite.ctx, acc1, poolId, "bar", sdk.NewInt(1000000), sdk.NewCoin("foo", sdk.NewInt(100000)))
+	suite.Require().ErrorIs(err, types.ErrPoolPaused)
+	err = keeper.JoinPool(suite.ctx, acc2, poolId, types.OneShare.MulRaw(10), sdk.Coins{})
+	suite.Require().ErrorIs(err, types.ErrPoolPaused)
+	_, err = keeper.JoinSwapExternAmountIn(suite.ctx, acc2, poolId, sdk.NewCoin("foo", sdk.NewInt(100000)), sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolPaused)
+	_, err = keeper.ExitSwapShareAmountIn(suite.ctx, acc1, poolId, "foo", types.OneShare.MulRaw(10), sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolPaused)
+	_, err = keeper.PlaceLimitOrder(suite.ctx, acc1, poolId, sdk.NewCoin("foo", sdk.NewInt(100000)), "bar", sdk.OneDec())
+	suite.Require().ErrorIs(err, types.ErrPoolPaused)
+	err = keeper.ExitPool(suite.ctx, acc1, poolId, types.OneShare.MulRaw(10), sdk.Coins{})
+	suite.Require().NoError(err)
+
+	res, err := keeper.Pool(sdk.WrapSDKContext(suite.ctx), &types.QueryPoolRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.PoolPaused, res.Status)
+
+	// Paused pools can be reactivated.
+	err = keeper.UpdatePoolStatus(suite.ctx, poolId, types.PoolActive)
+	suite.Require().NoError(err)
+	suite.Require().Empty(keeper.GetPoolStatusRecords(suite.ctx))
+	_, _, err = keeper.SwapExactAmountIn(suite.ctx, acc1, poolId, sdk.NewCoin("foo", sdk.NewInt(100000)), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+
+	// Deprecated pools can't.
+	err = keeper.UpdatePoolStatus(suite.ctx, poolId, types.PoolDeprecated)
+	suite.Require().NoError(err)
+	err = keeper.UpdatePoolStatus(suite.ctx, poolId, types.PoolActive)
+	suite.Require().ErrorIs(err, types.ErrInvalidPoolStatus)
+	_, _, err = keeper.SwapExactAmountIn(suite.ctx, acc1, poolId, sdk.NewCoin("foo", sdk.NewInt(100000)), "bar", sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolDeprecated)
+	suite.Require().Equal([]types.PoolStatusRecord{{PoolId: poolId, Status: types.PoolDeprecated}}, keeper.GetPoolStatusRecords(suite.ctx))
+
+	pools, err := keeper.Pools(sdk.WrapSDKContext(suite.ctx), &types.QueryPoolsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PoolStatus{types.PoolDeprecated}, pools.Statuses)
+}
+
+func (suite *KeeperTestSuite) TestMigratePool() {
+	poolId := suite.preparePool()
+	keeper := suite.app.GAMMKeeper
+	oldShareDenom := types.GetPoolShareDenom(poolId)
+
+	err := keeper.JoinPool(suite.ctx, acc2, poolId, types.OneShare.MulRaw(50), sdk.Coins{})
+	suite.Require().NoError(err)
+	lock, err := suite.app.LockupKeeper.LockTokens(suite.ctx, acc2, sdk.NewCoins(sdk.NewCoin(oldShareDenom, types.OneShare.MulRaw(20))), time.Hour)
+	suite.Require().NoError(err)
+
+	lockableDuration := suite.app.PoolIncentivesKeeper.GetLockableDurations(suite.ctx)[0]
+	oldGaugeId, err := suite.app.PoolIncentivesKeeper.GetPoolGaugeId(suite.ctx, poolId, lockableDuration)
+	suite.Require().NoError(err)
+	err = suite.app.PoolIncentivesKeeper.ReplaceDistrRecords(suite.ctx, poolincentivestypes.DistrRecord{GaugeId: oldGaugeId, Weight: sdk.NewInt(100)})
+	suite.Require().NoError(err)
+
+	oldPool, err := keeper.GetPool(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	liquidity := types.PoolAssetsCoins(oldPool.GetAllPoolAssets())
+	totalShares := oldPool.GetTotalShares().Amount
+
+	poolParams := types.BalancerPoolParams{
+		SwapFee: sdk.NewDecWithPrec(1, 2),
+		ExitFee: sdk.ZeroDec(),
+	}
+	weights := []types.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("foo", sdk.ZeroInt())},
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("bar", sdk.ZeroInt())},
+		{Weight: sdk.NewInt(1), Token: sdk.NewCoin("baz", sdk.ZeroInt())},
+	}
+
+	// The new pool must have the same assets.
+	_, err = keeper.MigratePool(suite.ctx, poolId, poolParams, weights[:2], "")
+	suite.Require().ErrorIs(err, types.ErrInvalidPoolMigration)
+	_, err = keeper.MigratePool(suite.ctx, poolId, poolParams, []types.PoolAsset{
+		weights[0], weights[1], {Weight: sdk.NewInt(1), Token: sdk.NewCoin("uosmo", sdk.ZeroInt())},
+	}, "")
+	suite.Require().ErrorIs(err, types.ErrInvalidPoolMigration)
+
+	newPoolId, err := keeper.MigratePool(suite.ctx, poolId, poolParams, weights, "")
+	suite.Require().NoError(err)
+	newShareDenom := types.GetPoolShareDenom(newPoolId)
+
+	newPool, err := keeper.GetPool(suite.ctx, newPoolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(liquidity, types.PoolAssetsCoins(newPool.GetAllPoolAssets()))
+	suite.Require().Equal(totalShares, newPool.GetTotalShares().Amount)
+	suite.Require().Equal(poolParams.SwapFee, newPool.GetPoolSwapFee())
+	suite.Require().Equal(liquidity, suite.app.BankKeeper.GetAllBalances(suite.ctx, newPool.GetAddress()))
+
+	// The old pool is left without tokens, and points to the new pool.
+	oldPool, err = keeper.GetPool(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, oldPool.GetAddress()).Empty())
+	suite.Require().Equal(types.PoolStatusRecord{PoolId: poolId, Status: types.PoolMigrated, MigratedToPoolId: newPoolId},
+		keeper.GetPoolStatusRecord(suite.ctx, poolId))
+
+	// Locked shares are replaced right away, the other ones by their holders.
+	suite.Require().Equal(totalShares.Sub(types.OneShare.MulRaw(20)), oldPool.GetTotalShares().Amount)
+	suite.Require().Equal(types.OneShare.MulRaw(30), suite.app.BankKeeper.GetBalance(suite.ctx, acc2, oldShareDenom).Amount)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, acc2, newShareDenom).Amount.IsZero())
+
+	msgServer := gammkeeper.NewMsgServerImpl(keeper)
+	res, err := msgServer.MigratePoolShares(sdk.WrapSDKContext(suite.ctx), &types.MsgMigratePoolShares{Sender: acc2.String(), PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(newShareDenom, types.OneShare.MulRaw(30)), res.SharesOut)
+	_, err = keeper.MigratePoolShares(suite.ctx, acc2, poolId)
+	suite.Require().ErrorIs(err, types.ErrInvalidPoolMigration)
+	_, err = keeper.MigratePoolShares(suite.ctx, acc1, newPoolId)
+	suite.Require().ErrorIs(err, types.ErrInvalidPoolMigration)
+	_, err = keeper.MigratePoolShares(suite.ctx, acc1, poolId)
+	suite.Require().NoError(err)
+
+	oldPool, err = keeper.GetPool(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().True(oldPool.GetTotalShares().Amount.IsZero())
+	suite.Require().True(suite.app.BankKeeper.GetSupply(suite.ctx).GetTotal().AmountOf(oldShareDenom).IsZero())
+	suite.Require().Equal(totalShares, suite.app.BankKeeper.GetSupply(suite.ctx).GetTotal().AmountOf(newShareDenom))
+
+	// Shares are replaced one for one, locked ones included.
+	suite.Require().Equal(types.InitPoolSharesSupply, suite.app.BankKeeper.GetBalance(suite.ctx, acc1, newShareDenom).Amount)
+	suite.Require().Equal(types.OneShare.MulRaw(30), suite.app.BankKeeper.GetBalance(suite.ctx, acc2, newShareDenom).Amount)
+	migratedLock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(newShareDenom, types.OneShare.MulRaw(20))), migratedLock.Coins)
+	suite.Require().Equal(types.OneShare.MulRaw(20), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, newShareDenom, time.Hour))
+	suite.Require().True(suite.app.LockupKeeper.GetLockedDenom(suite.ctx, oldShareDenom, time.Hour).IsZero())
+
+	// Incentives go to the new pool.
+	newGaugeId, err := suite.app.PoolIncentivesKeeper.GetPoolGaugeId(suite.ctx, newPoolId, lockableDuration)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]poolincentivestypes.DistrRecord{{GaugeId: newGaugeId, Weight: sdk.NewInt(100)}},
+		suite.app.PoolIncentivesKeeper.GetDistrInfo(suite.ctx).Records)
+
+	// Migrated pools can't be used anymore.
+	_, _, err = keeper.SwapExactAmountIn(suite.ctx, acc1, poolId, sdk.NewCoin("foo", sdk.NewInt(100000)), "bar", sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolMigrated)
+	err = keeper.ExitPool(suite.ctx, acc1, poolId, types.OneShare, sdk.Coins{})
+	suite.Require().ErrorIs(err, types.ErrPoolMigrated)
+	err = keeper.UpdatePoolStatus(suite.ctx, poolId, types.PoolActive)
+	suite.Require().ErrorIs(err, types.ErrInvalidPoolStatus)
+	_, err = keeper.MigratePool(suite.ctx, poolId, poolParams, weights, "")
+	suite.Require().ErrorIs(err, types.ErrPoolMigrated)
+
+	// The new pool is used instead.
+	_, _, err = keeper.SwapExactAmountIn(suite.ctx, acc1, newPoolId, sdk.NewCoin("foo", sdk.NewInt(100000)), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	err = keeper.ExitPool(suite.ctx, acc2, newPoolId, types.OneShare.MulRaw(10), sdk.Coins{})
+	suite.Require().NoError(err)
+	suite.requireGammInvariants()
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+// GetProtocolFees returns the cumulative swap fees sent to the community pool.
+func (k Keeper) GetProtocolFees(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.KeyProtocolFees) {
+		return sdk.Coins{}
+	}
+
+	bz := store.Get(types.KeyProtocolFees)
+	coins, err := sdk.ParseCoinsNormalized(string(bz))
+	if err != nil {
+		panic("invalid protocol fees value set")
+	}
+
+	return coins
+}
+
+func (k Keeper) SetProtocolFees(ctx sdk.Context, coins sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyProtocolFees, []byte(coins.String()))
+}
+
+func (k Keeper) RecordProtocolFees(ctx sdk.Context, coins sdk.Coins) {
+	protocolFees := k.GetProtocolFees(ctx)
+	k.SetProtocolFees(ctx, protocolFees.Add(coins...))
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) setProtocolFeeShare(protocolFeeShare sdk.Dec) {
+	params := suite.app.GAMMKeeper.GetParams(suite.ctx)
+	params.ProtocolFeeShare = protocolFeeShare
+	suite.app.GAMMKeeper.SetParams(suite.ctx, params)
+}
+
+func (suite *KeeperTestSuite) requireGammInvariants() {
+	for _, invariant := range []func(k keeper.Keeper) (string, bool){
+		func(k keeper.Keeper) (string, bool) {
+			return keeper.PoolAccountInvariant(k, suite.app.BankKeeper)(suite.ctx)
+		},
+		func(k keeper.Keeper) (string, bool) {
+			return keeper.TotalLiquidityInvariant(k)(suite.ctx)
+		},
+	} {
+		msg, broken := invariant(suite.app.GAMMKeeper)
+		suite.Require().False(broken, msg)
+	}
+}
+
+func (suite *KeeperTestSuite) TestProtocolFees() {
+	poolId := suite.prepareBalancerPoolWithPoolParams(types.BalancerPoolParams{
+		SwapFee: sdk.NewDecWithPrec(1, 2),
+		ExitFee: sdk.NewDec(0),
+	})
+	keeper := suite.app.GAMMKeeper
+	suite.setProtocolFeeShare(sdk.NewDecWithPrec(5, 1))
+	communityPoolBefore := suite.app.DistrKeeper.GetFeePool(suite.ctx).CommunityPool
+
+	// Half of the 1% swap fee goes to the community pool.
+	_, _, err := keeper.SwapExactAmountIn(suite.ctx, acc1, poolId, sdk.NewCoin("foo", sdk.NewInt(100000)), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	_, _, err = keeper.SwapExactAmountOut(suite.ctx, acc1, poolId, "bar", sdk.NewInt(1000000), sdk.NewCoin("foo", sdk.NewInt(100000)))
+	suite.Require().NoError(err)
+
+	protocolFees := keeper.GetProtocolFees(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(500), protocolFees.AmountOf("foo"))
+	suite.Require().True(protocolFees.AmountOf("bar").IsPositive())
+
+	communityPool := suite.app.DistrKeeper.GetFeePool(suite.ctx).CommunityPool
+	suite.Require().Equal(sdk.NewDecCoinsFromCoins(protocolFees...), communityPool.Sub(communityPoolBefore))
+	suite.requireGammInvariants()
+
+	res, err := suite.queryClient.ProtocolFees(sdk.WrapSDKContext(suite.ctx), &types.QueryProtocolFeesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(protocolFees, res.ProtocolFees)
+
+	// Without a protocol fee share, the swap fees stay in the pool.
+	suite.setProtocolFeeShare(sdk.ZeroDec())
+	_, _, err = keeper.SwapExactAmountIn(suite.ctx, acc1, poolId, sdk.NewCoin("foo", sdk.NewInt(100000)), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().Equal(protocolFees, keeper.GetProtocolFees(suite.ctx))
+	suite.requireGammInvariants()
+}
+
+func (suite *KeeperTestSuite) TestConcentratedPoolProtocolFees() {
+	poolId := suite.prepareConcentratedPool()
+	keeper := suite.app.GAMMKeeper
+	suite.setProtocolFeeShare(sdk.NewDecWithPrec(5, 1))
+
+	positionId, liquidity, _, err := keeper.CreatePosition(suite.ctx, acc1, poolId, -1000, 1000,
+		sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.ZeroInt(), sdk.ZeroInt())
+	suite.Require().NoError(err)
+
+	_, _, err = keeper.SwapExactAmountIn(suite.ctx, acc2, poolId, sdk.NewCoin("foo", sdk.NewInt(100000)), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+
+	// The position only earns the swap fee not sent to the community pool.
+	// Both are rounded down from half of the 1000 foo swap fee.
+	protocolFees := keeper.GetProtocolFees(suite.ctx)
+	suite.Require().True(protocolFees.AmountOf("foo").GTE(sdk.NewInt(499)))
+	suite.Require().True(protocolFees.AmountOf("foo").LTE(sdk.NewInt(500)))
+	fees, err := keeper.CollectFees(suite.ctx, acc1, positionId)
+	suite.Require().NoError(err)
+	suite.Require().True(fees.AmountOf("foo").LTE(sdk.NewInt(500)))
+	suite.Require().True(fees.AmountOf("foo").GTE(sdk.NewInt(499)))
+	suite.requireGammInvariants()
+
+	// The pool still holds all the tokens owed to the position.
+	_, err = keeper.WithdrawPosition(suite.ctx, acc1, positionId, liquidity)
+	suite.Require().NoError(err)
+	suite.requireGammInvariants()
+}
@@ -0,0 +1,319 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+const (
+	// maxSearchedRoutes bounds the number of routes found by the route search,
+	// to keep the search cheap for well connected denoms.
+	maxSearchedRoutes = 1000
+	// maxSearchedPoolVisits bounds the number of times the route search goes through a pool,
+	// so that the search stays cheap when few or no routes are found among many connected pools.
+	maxSearchedPoolVisits = 10000
+	// maxCandidateRoutes is the number of routes, ranked by their output for the whole input,
+	// that the input can be split across.
+	maxCandidateRoutes = 16
+	// routeSplitSteps is the number of parts the input is divided into when splitting it across routes.
+	routeSplitSteps = 20
+)
+
+// poolSimulation tracks the pools swapped on while estimating routes,
+// so that routes sharing pools account for each other's price impact.
+// Nothing is written to the store.
+type poolSimulation struct {
+	ctx              sdk.Context
+	k                Keeper
+	pools            map[uint64]types.PoolI
+	protocolFeeShare sdk.Dec
+}
+
+func (k Keeper) newPoolSimulation(ctx sdk.Context) *poolSimulation {
+	return &poolSimulation{
+		ctx:              ctx,
+		k:                k,
+		pools:            make(map[uint64]types.PoolI),
+		protocolFeeShare: k.GetParams(ctx).ProtocolFeeShare,
+	}
+}
+
+func (sim *poolSimulation) getPool(poolId uint64) (types.PoolI, error) {
+	if pool, ok := sim.pools[poolId]; ok {
+		return pool, nil
+	}
+
+	pool, err := sim.k.GetPool(sim.ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !pool.IsActive(sim.ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrPoolLocked, "swap on inactive pool")
+	}
+	if err := sim.k.checkPoolOpen(sim.ctx, poolId); err != nil {
+		return nil, err
+	}
+
+	sim.pools[poolId] = pool
+	return pool, nil
+}
+
+// estimateRoute returns the amount out of swapping tokenIn along the route, without applying the swaps.
+// The route must not swap on the same pool twice.
+func (sim *poolSimulation) estimateRoute(route []types.SwapAmountInRoute, tokenIn sdk.Coin) (sdk.Int, error) {
+	for _, hop := range route {
+		pool, err := sim.getPool(hop.PoolId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		tokenOut, err := pool.SwapOutGivenIn(tokenIn, hop.TokenOutDenom, pool.GetPoolSwapFee())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		if !tokenOut.Amount.IsPositive() {
+			return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
+		}
+
+		tokenIn = tokenOut
+	}
+
+	return tokenIn.Amount, nil
+}
+
+// applyRoute swaps tokenIn along the route, updating the balances of the simulated pools
+// the same way SwapExactAmountIn does.
+func (sim *poolSimulation) applyRoute(route []types.SwapAmountInRoute, tokenIn sdk.Coin) (sdk.Int, error) {
+	for _, hop := range route {
+		pool, err := sim.getPool(hop.PoolId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		tokenOut, err := pool.SwapOutGivenIn(tokenIn, hop.TokenOutDenom, pool.GetPoolSwapFee())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		if !tokenOut.Amount.IsPositive() {
+			return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
+		}
+
+		_, err = pool.ApplySwap(tokenIn, tokenOut, pool.GetPoolSwapFee(), sim.protocolFeeShare)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		tokenIn = tokenOut
+	}
+
+	return tokenIn.Amount, nil
+}
+
+// findRoutes returns the routes from tokenInDenom to tokenOutDenom of at most maxHops pools.
+// A route never visits the same pool or denom twice.
+// The search stops after maxSearchedRoutes routes, or maxSearchedPoolVisits pools gone through.
+func findRoutes(pools []types.PoolI, tokenInDenom, tokenOutDenom string, maxHops int) [][]types.SwapAmountInRoute {
+	denomPools := make(map[string][]types.PoolI)
+	for _, pool := range pools {
+		for _, asset := range pool.GetAllPoolAssets() {
+			denomPools[asset.Token.Denom] = append(denomPools[asset.Token.Denom], pool)
+		}
+	}
+
+	routes := [][]types.SwapAmountInRoute{}
+	route := []types.SwapAmountInRoute{}
+	visitedDenoms := map[string]bool{tokenInDenom: true}
+	visitedPools := make(map[uint64]bool)
+	poolVisits := 0
+
+	var search func(denom string)
+	search = func(denom string) {
+		for _, pool := range denomPools[denom] {
+			if visitedPools[pool.GetId()] {
+				continue
+			}
+			if poolVisits >= maxSearchedPoolVisits {
+				return
+			}
+			poolVisits++
+			visitedPools[pool.GetId()] = true
+
+			for _, asset := range pool.GetAllPoolAssets() {
+				nextDenom := asset.Token.Denom
+				if visitedDenoms[nextDenom] || len(routes) >= maxSearchedRoutes {
+					continue
+				}
+
+				route = append(route, types.SwapAmountInRoute{
+					PoolId:        pool.GetId(),
+					TokenOutDenom: nextDenom,
+				})
+
+				if nextDenom == tokenOutDenom {
+					routes = append(routes, append([]types.SwapAmountInRoute{}, route...))
+				} else if len(route) < maxHops {
+					visitedDenoms[nextDenom] = true
+					search(nextDenom)
+					delete(visitedDenoms, nextDenom)
+				}
+
+				route = route[:len(route)-1]
+			}
+
+			delete(visitedPools, pool.GetId())
+		}
+	}
+	search(tokenInDenom)
+
+	return routes
+}
+
+// FindBestSplitRoutes searches the active pools not in batch mode for the routes of at most maxHops pools
+// that return the most tokenOutDenom for tokenIn, splitting tokenIn across up to maxSplits routes.
+// It returns the routes along with the expected amount out of swapping them in order.
+func (k Keeper) FindBestSplitRoutes(
+	ctx sdk.Context,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops int,
+	maxSplits int,
+) (routes []types.SwapAmountInSplitRoute, tokenOutAmount sdk.Int, err error) {
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidRouteOptions, "cannot trade same denomination in and out")
+	}
+	if !tokenIn.IsValid() || !tokenIn.IsPositive() {
+		return nil, sdk.Int{}, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, tokenIn.String())
+	}
+	if maxHops < 1 || maxHops > types.MaxRouteHops {
+		return nil, sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidRouteOptions, "max hops should be between 1 and %d", types.MaxRouteHops)
+	}
+	if maxSplits < 1 || maxSplits > types.MaxRouteSplits {
+		return nil, sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidRouteOptions, "max splits should be between 1 and %d", types.MaxRouteSplits)
+	}
+
+	pools, err := k.GetPools(ctx)
+	if err != nil {
+		return nil, sdk.Int{}, err
+	}
+	// Swaps on pools in batch mode can't be routed, as they are only executed at the end of the block.
+	activePools := []types.PoolI{}
+	for _, pool := range pools {
+		if pool.IsActive(ctx.BlockTime()) && !k.IsBatchModePool(ctx, pool.GetId()) && k.checkPoolOpen(ctx, pool.GetId()) == nil {
+			activePools = append(activePools, pool)
+		}
+	}
+
+	type candidate struct {
+		pools     []types.SwapAmountInRoute
+		amountOut sdk.Int
+		amountIn  sdk.Int
+	}
+
+	// Rank the routes by their output when swapping the whole input on their own.
+	candidates := []candidate{}
+	sim := k.newPoolSimulation(ctx)
+	for _, route := range findRoutes(activePools, tokenIn.Denom, tokenOutDenom, maxHops) {
+		amountOut, err := sim.estimateRoute(route, tokenIn)
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, candidate{pools: route, amountOut: amountOut, amountIn: sdk.ZeroInt()})
+	}
+	if len(candidates) == 0 {
+		return nil, sdk.Int{}, sdkerrors.Wrapf(types.ErrNoRouteFound, "from %s to %s", tokenIn.Denom, tokenOutDenom)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].amountOut.GT(candidates[j].amountOut)
+	})
+	if len(candidates) > maxCandidateRoutes {
+		candidates = candidates[:maxCandidateRoutes]
+	}
+
+	// Allocate the input step by step to the route with the best output for the step,
+	// given the price impact of the previous steps.
+	steps := int64(routeSplitSteps)
+	if maxSplits == 1 {
+		steps = 1
+	}
+	if tokenIn.Amount.LT(sdk.NewInt(steps)) {
+		steps = tokenIn.Amount.Int64()
+	}
+	stepAmount := tokenIn.Amount.QuoRaw(steps)
+
+	numSplits := 0
+	for step := int64(0); step < steps; step++ {
+		amount := stepAmount
+		if step == steps-1 {
+			amount = tokenIn.Amount.Sub(stepAmount.MulRaw(steps - 1))
+		}
+		stepTokenIn := sdk.NewCoin(tokenIn.Denom, amount)
+
+		best := -1
+		bestAmountOut := sdk.ZeroInt()
+		for i, c := range candidates {
+			if c.amountIn.IsZero() && numSplits >= maxSplits {
+				continue
+			}
+
+			amountOut, err := sim.estimateRoute(c.pools, stepTokenIn)
+			if err != nil {
+				continue
+			}
+			if amountOut.GT(bestAmountOut) {
+				best = i
+				bestAmountOut = amountOut
+			}
+		}
+		if best < 0 {
+			return nil, sdk.Int{}, sdkerrors.Wrapf(types.ErrNoRouteFound, "no route can swap %s to %s", stepTokenIn, tokenOutDenom)
+		}
+
+		_, err = sim.applyRoute(candidates[best].pools, stepTokenIn)
+		if err != nil {
+			return nil, sdk.Int{}, err
+		}
+
+		if candidates[best].amountIn.IsZero() {
+			numSplits++
+		}
+		candidates[best].amountIn = candidates[best].amountIn.Add(amount)
+	}
+
+	for _, c := range candidates {
+		if c.amountIn.IsPositive() {
+			routes = append(routes, types.SwapAmountInSplitRoute{
+				Pools:         c.pools,
+				TokenInAmount: c.amountIn,
+			})
+		}
+	}
+
+	// The amount out of the steps can differ slightly from swapping each route at once,
+	// so estimate the routes again in the order they are swapped by SplitRouteSwapExactAmountIn.
+	tokenOutAmount, err = k.estimateSplitRoutes(ctx, routes, tokenIn.Denom)
+	if err != nil {
+		return nil, sdk.Int{}, err
+	}
+
+	return routes, tokenOutAmount, nil
+}
+
+// estimateSplitRoutes returns the amount out of SplitRouteSwapExactAmountIn, without applying the swaps.
+func (k Keeper) estimateSplitRoutes(ctx sdk.Context, routes []types.SwapAmountInSplitRoute, tokenInDenom string) (sdk.Int, error) {
+	sim := k.newPoolSimulation(ctx)
+	tokenOutAmount := sdk.ZeroInt()
+	for _, route := range routes {
+		amountOut, err := sim.applyRoute(route.Pools, sdk.NewCoin(tokenInDenom, route.TokenInAmount))
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		tokenOutAmount = tokenOutAmount.Add(amountOut)
+	}
+	return tokenOutAmount, nil
+}
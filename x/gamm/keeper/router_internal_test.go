@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+func TestFindRoutesBoundsPoolVisits(t *testing.T) {
+	// Many pools sharing their denoms, none of which holds the token out, so that no route is ever found.
+	pools := []types.PoolI{}
+	for id := uint64(1); id <= 200; id++ {
+		pool := &types.BalancerPool{Id: id}
+		for i := uint64(0); i < types.MaxPoolAssets; i++ {
+			pool.PoolAssets = append(pool.PoolAssets, types.PoolAsset{
+				Token:  sdk.NewInt64Coin(fmt.Sprintf("denom%d", (id+i*7)%20), 1000),
+				Weight: sdk.OneInt(),
+			})
+		}
+		pools = append(pools, pool)
+	}
+
+	// Without the bound, the search would go through the billions of routes of up to 4 of these pools.
+	routes := findRoutes(pools, "denom0", "bar", types.MaxRouteHops)
+	require.Empty(t, routes)
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestFindBestSplitRoutes() {
+	suite.preparePool()
+	suite.preparePool()
+	keeper := suite.app.GAMMKeeper
+	tokenIn := sdk.NewCoin("foo", sdk.NewInt(1000000))
+
+	// A single direct route swaps the whole input on one of the pools.
+	routes, singleRouteAmountOut, err := keeper.FindBestSplitRoutes(suite.ctx, tokenIn, "bar", 1, 1)
+	suite.Require().NoError(err)
+	suite.Require().Len(routes, 1)
+	suite.Require().Len(routes[0].Pools, 1)
+	suite.Require().Equal(tokenIn.Amount, routes[0].TokenInAmount)
+
+	cacheCtx, _ := suite.ctx.CacheContext()
+	amountOut, err := keeper.MultihopSwapExactAmountIn(cacheCtx, acc1, routes[0].Pools, tokenIn, sdk.NewInt(1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(amountOut, singleRouteAmountOut)
+
+	// Splitting across the two identical pools halves the price impact.
+	routes, splitAmountOut, err := keeper.FindBestSplitRoutes(suite.ctx, tokenIn, "bar", 1, 2)
+	suite.Require().NoError(err)
+	suite.Require().Len(routes, 2)
+	suite.Require().Equal(tokenIn.Amount.QuoRaw(2), routes[0].TokenInAmount)
+	suite.Require().Equal(tokenIn.Amount.QuoRaw(2), routes[1].TokenInAmount)
+	suite.Require().True(splitAmountOut.GT(singleRouteAmountOut))
+
+	// Longer routes never do worse than the direct ones, and end in the requested denom.
+	routes, multihopAmountOut, err := keeper.FindBestSplitRoutes(suite.ctx, tokenIn, "bar", 2, 4)
+	suite.Require().NoError(err)
+	suite.Require().True(multihopAmountOut.GTE(splitAmountOut))
+	suite.Require().Equal(tokenIn.Amount, types.SwapAmountInSplitRoutes(routes).TokenInAmount())
+	for _, route := range routes {
+		suite.Require().LessOrEqual(len(route.Pools), 2)
+		suite.Require().Equal("bar", route.TokenOutDenom())
+	}
+
+	// The query returns the same routes.
+	res, err := suite.queryClient.EstimateBestRoute(sdk.WrapSDKContext(suite.ctx), &types.QueryEstimateBestRouteRequest{
+		TokenIn:       tokenIn.String(),
+		TokenOutDenom: "bar",
+		MaxHops:       2,
+		MaxSplits:     4,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(routes, res.Routes)
+	suite.Require().Equal(multihopAmountOut, res.TokenOutAmount)
+}
+
+func (suite *KeeperTestSuite) TestFindBestSplitRoutesErrors() {
+	suite.preparePool()
+	keeper := suite.app.GAMMKeeper
+
+	tests := []struct {
+		name          string
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+		maxHops       int
+		maxSplits     int
+	}{
+		{"same denom", sdk.NewCoin("foo", sdk.NewInt(1000)), "foo", 1, 1},
+		{"zero amount", sdk.NewCoin("foo", sdk.ZeroInt()), "bar", 1, 1},
+		{"zero max hops", sdk.NewCoin("foo", sdk.NewInt(1000)), "bar", 0, 1},
+		{"too many hops", sdk.NewCoin("foo", sdk.NewInt(1000)), "bar", types.MaxRouteHops + 1, 1},
+		{"zero max splits", sdk.NewCoin("foo", sdk.NewInt(1000)), "bar", 1, 0},
+		{"too many splits", sdk.NewCoin("foo", sdk.NewInt(1000)), "bar", 1, types.MaxRouteSplits + 1},
+		{"no route", sdk.NewCoin("foo", sdk.NewInt(1000)), "qux", types.MaxRouteHops, 1},
+	}
+
+	for _, test := range tests {
+		_, _, err := keeper.FindBestSplitRoutes(suite.ctx, test.tokenIn, test.tokenOutDenom, test.maxHops, test.maxSplits)
+		suite.Require().Error(err, "test: %v", test.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestSplitRouteSwapExactAmountIn() {
+	suite.preparePool()
+	suite.preparePool()
+	keeper := suite.app.GAMMKeeper
+	tokenIn := sdk.NewCoin("foo", sdk.NewInt(1000000))
+
+	routes, expectedAmountOut, err := keeper.FindBestSplitRoutes(suite.ctx, tokenIn, "baz", 2, 3)
+	suite.Require().NoError(err)
+
+	// Asking for more than the routes return reverts every swap.
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, acc1)
+	_, err = keeper.SplitRouteSwapExactAmountIn(suite.ctx, acc1, routes, "foo", expectedAmountOut.AddRaw(1))
+	suite.Require().ErrorIs(err, types.ErrLimitMinAmount)
+	suite.Require().Equal(balancesBefore, suite.app.BankKeeper.GetAllBalances(suite.ctx, acc1))
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	amountOut, err := keeper.SplitRouteSwapExactAmountIn(suite.ctx, acc1, routes, "foo", expectedAmountOut)
+	suite.Require().NoError(err)
+
+	// Every swap of every route emits its event.
+	swaps := 0
+	for _, route := range routes {
+		swaps += len(route.Pools)
+	}
+	swapEvents := 0
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.TypeEvtTokenSwapped {
+			swapEvents++
+		}
+	}
+	suite.Require().Equal(swaps, swapEvents)
+	suite.Require().Equal(expectedAmountOut, amountOut)
+	suite.Require().Equal(
+		balancesBefore.Sub(sdk.NewCoins(tokenIn)).Add(sdk.NewCoin("baz", amountOut)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, acc1),
+	)
+
+	// Routes ending in different denoms are rejected.
+	_, err = keeper.SplitRouteSwapExactAmountIn(suite.ctx, acc1, []types.SwapAmountInSplitRoute{
+		{Pools: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}}, TokenInAmount: sdk.NewInt(1000)},
+		{Pools: []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "baz"}}, TokenInAmount: sdk.NewInt(1000)},
+	}, "foo", sdk.NewInt(1))
+	suite.Require().ErrorIs(err, types.ErrInvalidSplitRoutes)
+}
@@ -1,7 +1,11 @@
 package keeper
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/osmosis-labs/osmosis/x/gamm/types"
 )
@@ -46,3 +50,82 @@ func (k Keeper) BurnPoolShareFromAccount(ctx sdk.Context, pool types.PoolI, addr
 
 	return nil
 }
+
+// setPoolShareMetadata registers the bank metadata of the share denom of a pool, displayed with
+// OneShareExponent decimals as shareSymbol, or GAMM-<pool id> if shareSymbol is empty.
+// The description names the pool by the display denoms of its assets.
+func (k Keeper) setPoolShareMetadata(ctx sdk.Context, pool types.PoolI, shareSymbol string) {
+	poolShareBaseDenom := types.GetPoolShareDenom(pool.GetId())
+	poolShareDisplayDenom := types.GetPoolShareDisplayDenom(pool.GetId())
+	var displayAliases []string
+	if shareSymbol != "" {
+		// The default display denom still refers to the shares.
+		displayAliases = []string{poolShareDisplayDenom}
+		poolShareDisplayDenom = shareSymbol
+	}
+
+	assetDenoms := []string{}
+	for _, asset := range pool.GetAllPoolAssets() {
+		assetDenoms = append(assetDenoms, k.displayDenom(ctx, asset.Token.Denom))
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: fmt.Sprintf("The share token of the gamm pool %d of %s", pool.GetId(), strings.Join(assetDenoms, "/")),
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    poolShareBaseDenom,
+				Exponent: 0,
+				Aliases: []string{
+					"attopoolshare",
+				},
+			},
+			{
+				Denom:    poolShareDisplayDenom,
+				Exponent: types.OneShareExponent,
+				Aliases:  displayAliases,
+			},
+		},
+		Base:    poolShareBaseDenom,
+		Display: poolShareDisplayDenom,
+	})
+}
+
+// displayDenom returns the display denom of the bank metadata of denom, or denom if it has none.
+func (k Keeper) displayDenom(ctx sdk.Context, denom string) string {
+	metadata := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if metadata.Base != denom || metadata.Display == "" {
+		return denom
+	}
+	return metadata.Display
+}
+
+// isDenom returns whether denom has a supply or bank metadata, so that it can't be used as a share symbol.
+func (k Keeper) isDenom(ctx sdk.Context, denom string) bool {
+	return k.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(denom).IsPositive() ||
+		k.bankKeeper.GetDenomMetaData(ctx, denom).Base == denom
+}
+
+// BackfillPoolShareMetadata registers the share denom metadata of the pools created before it was
+// derived from the pool assets, keeping the share symbols already registered.
+func (k Keeper) BackfillPoolShareMetadata(ctx sdk.Context) error {
+	pools, err := k.GetPools(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		// Concentrated pools have no shares.
+		if _, ok := pool.(*types.ConcentratedPool); ok {
+			continue
+		}
+
+		shareSymbol := ""
+		metadata := k.bankKeeper.GetDenomMetaData(ctx, types.GetPoolShareDenom(pool.GetId()))
+		if metadata.Display != types.GetPoolShareDisplayDenom(pool.GetId()) &&
+			types.ValidatePoolShareSymbol(metadata.Display) == nil {
+			shareSymbol = metadata.Display
+		}
+		k.setPoolShareMetadata(ctx, pool, shareSymbol)
+	}
+	return nil
+}
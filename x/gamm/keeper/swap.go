@@ -21,7 +21,14 @@ func (k Keeper) SwapExactAmountIn(
 		return sdk.Int{}, sdk.Dec{}, errors.New("cannot trade same denomination in and out")
 	}
 
-	pool, inPoolAsset, outPoolAsset, err :=
+	if k.IsBatchModePool(ctx, poolId) {
+		return sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrPoolInBatchMode, "swaps on pool %d are queued", poolId)
+	}
+	if err := k.checkPoolOpen(ctx, poolId); err != nil {
+		return sdk.Int{}, sdk.Dec{}, err
+	}
+
+	pool, _, outPoolAsset, err :=
 		k.getPoolAndInOutAssets(ctx, poolId, tokenIn.Denom, tokenOutDenom)
 	if err != nil {
 		return sdk.Int{}, sdk.Dec{}, err
@@ -34,14 +41,11 @@ func (k Keeper) SwapExactAmountIn(
 	// TODO: Understand if we are handling swap fee consistently,
 	// with the global swap fee and the pool swap fee
 
-	tokenOutAmount = calcOutGivenIn(
-		inPoolAsset.Token.Amount.ToDec(),
-		inPoolAsset.Weight.ToDec(),
-		outPoolAsset.Token.Amount.ToDec(),
-		outPoolAsset.Weight.ToDec(),
-		tokenIn.Amount.ToDec(),
-		pool.GetPoolSwapFee(),
-	).TruncateInt()
+	tokenOut, err := pool.SwapOutGivenIn(tokenIn, tokenOutDenom, pool.GetPoolSwapFee())
+	if err != nil {
+		return sdk.Int{}, sdk.Dec{}, err
+	}
+	tokenOutAmount = tokenOut.Amount
 	if tokenOutAmount.LTE(sdk.ZeroInt()) {
 		return sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
 	}
@@ -50,12 +54,7 @@ func (k Keeper) SwapExactAmountIn(
 		return sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrLimitMinAmount, "%s token is lesser than min amount", outPoolAsset.Token.Denom)
 	}
 
-	inPoolAsset.Token.Amount = inPoolAsset.Token.Amount.Add(tokenIn.Amount)
-	outPoolAsset.Token.Amount = outPoolAsset.Token.Amount.Sub(tokenOutAmount)
-
-	tokenOut := sdk.Coin{Denom: tokenOutDenom, Amount: tokenOutAmount}
-
-	err = k.updatePoolForSwap(ctx, pool, sender, inPoolAsset, outPoolAsset, tokenIn, tokenOut)
+	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut)
 	if err != nil {
 		return sdk.Int{}, sdk.Dec{}, err
 	}
@@ -75,7 +74,14 @@ func (k Keeper) SwapExactAmountOut(
 		return sdk.Int{}, sdk.Dec{}, errors.New("cannot trade same denomination in and out")
 	}
 
-	pool, inPoolAsset, outPoolAsset, err :=
+	if k.IsBatchModePool(ctx, poolId) {
+		return sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrPoolInBatchMode, "swaps on pool %d are queued", poolId)
+	}
+	if err := k.checkPoolOpen(ctx, poolId); err != nil {
+		return sdk.Int{}, sdk.Dec{}, err
+	}
+
+	pool, _, outPoolAsset, err :=
 		k.getPoolAndInOutAssets(ctx, poolId, tokenInDenom, tokenOut.Denom)
 	if err != nil {
 		return sdk.Int{}, sdk.Dec{}, err
@@ -85,20 +91,11 @@ func (k Keeper) SwapExactAmountOut(
 		return sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrPoolLocked, "swap on inactive pool")
 	}
 
-	poolOutBal, _ := pool.GetTokenBalance(tokenOut.Denom)
-	if tokenOut.Amount.GTE(poolOutBal) {
-		return sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrTooManyTokensOut,
-			"can't get more tokens out than there are tokens in the pool")
+	tokenIn, err := pool.SwapInGivenOut(tokenOut, tokenInDenom, pool.GetPoolSwapFee())
+	if err != nil {
+		return sdk.Int{}, sdk.Dec{}, err
 	}
-
-	tokenInAmount = calcInGivenOut(
-		inPoolAsset.Token.Amount.ToDec(),
-		inPoolAsset.Weight.ToDec(),
-		outPoolAsset.Token.Amount.ToDec(),
-		outPoolAsset.Weight.ToDec(),
-		tokenOut.Amount.ToDec(),
-		pool.GetPoolSwapFee(),
-	).TruncateInt()
+	tokenInAmount = tokenIn.Amount
 	if tokenInAmount.LTE(sdk.ZeroInt()) {
 		return sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
 	}
@@ -107,34 +104,24 @@ func (k Keeper) SwapExactAmountOut(
 		return sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "%s token is larger than max amount", outPoolAsset.Token.Denom)
 	}
 
-	inPoolAsset.Token.Amount = inPoolAsset.Token.Amount.Add(tokenInAmount)
-	outPoolAsset.Token.Amount = outPoolAsset.Token.Amount.Sub(tokenOut.Amount)
-
-	tokenIn := sdk.Coin{Denom: tokenInDenom, Amount: tokenInAmount}
-
-	err = k.updatePoolForSwap(ctx, pool, sender, inPoolAsset, outPoolAsset, tokenIn, tokenOut)
+	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut)
 	if err != nil {
 		return sdk.Int{}, sdk.Dec{}, err
 	}
 	return tokenInAmount, spotPriceAfter, nil
 }
 
-// updatePoolForSwap takes a pool, sender, post-swap pool reserves, and tokenIn, tokenOut amounts
-// It then updates the pool's balances to the new reserve amounts, and
+// updatePoolForSwap takes a pool, sender, and tokenIn, tokenOut amounts
+// It then applies the swap to the pool's state, and
 // sends the in tokens from the sender to the pool, and the out tokens from the pool to the sender.
 func (k Keeper) updatePoolForSwap(
 	ctx sdk.Context,
 	pool types.PoolI,
 	sender sdk.AccAddress,
-	updatedPoolAssetIn types.PoolAsset,
-	updatedPoolAssetOut types.PoolAsset,
 	tokenIn sdk.Coin,
 	tokenOut sdk.Coin,
 ) error {
-	err := pool.UpdatePoolAssetBalances(sdk.NewCoins(
-		updatedPoolAssetIn.Token,
-		updatedPoolAssetOut.Token,
-	))
+	protocolFee, err := pool.ApplySwap(tokenIn, tokenOut, pool.GetPoolSwapFee(), k.GetParams(ctx).ProtocolFeeShare)
 	if err != nil {
 		return err
 	}
@@ -157,45 +144,60 @@ func (k Keeper) updatePoolForSwap(
 		return err
 	}
 
+	// The protocol's share of the swap fee goes from the pool to the community pool.
+	protocolFees := sdk.NewCoins(protocolFee)
+	if !protocolFees.Empty() {
+		err = k.distrKeeper.FundCommunityPool(ctx, protocolFees, pool.GetAddress())
+		if err != nil {
+			return err
+		}
+		k.RecordProtocolFees(ctx, protocolFees)
+	}
+
 	tokensIn := sdk.Coins{tokenIn}
 	tokensOut := sdk.Coins{tokenOut}
 	k.createSwapEvent(ctx, sender, pool.GetId(), tokensIn, tokensOut)
 	k.hooks.AfterSwap(ctx, sender, pool.GetId(), tokensIn, tokensOut)
+	k.recordSwapStats(ctx, pool.GetId(), tokensIn, tokensOut, swapFeeOf(tokenIn, pool.GetPoolSwapFee()), 1)
+	k.trackChangedPool(ctx, pool.GetId())
 	k.RecordTotalLiquidityIncrease(ctx, tokensIn)
-	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
+	k.RecordTotalLiquidityDecrease(ctx, tokensOut.Add(protocolFees...))
 
 	return err
 }
 
+// checkPriceImpact returns an error if the effective price of a swap of tokenInAmount for tokenOutAmount,
+// in tokens in per token out, is more than maxPriceImpact above spotPrice, or if spotPrice is above
+// maxSpotPrice, unless maxSpotPrice is zero.
+func checkPriceImpact(spotPrice sdk.Dec, tokenInAmount, tokenOutAmount sdk.Int, maxPriceImpact, maxSpotPrice sdk.Dec) error {
+	if !maxSpotPrice.IsNil() && maxSpotPrice.IsPositive() && spotPrice.GT(maxSpotPrice) {
+		return sdkerrors.Wrapf(types.ErrMaxSpotPriceExceeded, "spot price %s is above %s", spotPrice, maxSpotPrice)
+	}
+
+	effectivePrice := tokenInAmount.ToDec().Quo(tokenOutAmount.ToDec())
+	priceImpact := effectivePrice.Quo(spotPrice).Sub(sdk.OneDec())
+	if priceImpact.GT(maxPriceImpact) {
+		return sdkerrors.Wrapf(types.ErrMaxPriceImpactExceeded, "effective price %s is %s above the spot price %s",
+			effectivePrice, priceImpact, spotPrice)
+	}
+	return nil
+}
+
 func (k Keeper) CalculateSpotPriceWithSwapFee(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string) (sdk.Dec, error) {
-	pool, inPoolAsset, outPoolAsset, err :=
-		k.getPoolAndInOutAssets(ctx, poolId, tokenInDenom, tokenOutDenom)
+	pool, err := k.GetPool(ctx, poolId)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
 
-	return calcSpotPriceWithSwapFee(
-		inPoolAsset.Token.Amount.ToDec(),
-		inPoolAsset.Weight.ToDec(),
-		outPoolAsset.Token.Amount.ToDec(),
-		outPoolAsset.Weight.ToDec(),
-		pool.GetPoolSwapFee(),
-	), nil
+	return pool.SpotPrice(tokenInDenom, tokenOutDenom, pool.GetPoolSwapFee())
 }
 
 func (k Keeper) CalculateSpotPrice(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string) (sdk.Dec, error) {
-	_, inPoolAsset, outPoolAsset, err :=
-		k.getPoolAndInOutAssets(ctx, poolId, tokenInDenom, tokenOutDenom)
+	pool, err := k.GetPool(ctx, poolId)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
 
-	// calcSpotPriceWithSwapFee, but with fee = 0
-	return calcSpotPriceWithSwapFee(
-		inPoolAsset.Token.Amount.ToDec(),
-		inPoolAsset.Weight.ToDec(),
-		outPoolAsset.Token.Amount.ToDec(),
-		outPoolAsset.Weight.ToDec(),
-		sdk.ZeroDec(),
-	), nil
+	// SpotPrice, but with fee = 0
+	return pool.SpotPrice(tokenInDenom, tokenOutDenom, sdk.ZeroDec())
 }
@@ -251,3 +251,38 @@ func (suite *KeeperTestSuite) TestActivePoolSwap() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestStableswapSwap() {
+	poolId := suite.prepareStableswapPool(100)
+	keeper := suite.app.GAMMKeeper
+
+	// The pool is balanced, so the spot price is 1.
+	spotPrice, err := keeper.CalculateSpotPrice(suite.ctx, poolId, "foo", "bar")
+	suite.Require().NoError(err)
+	suite.Require().True(sdk.OneDec().Sub(spotPrice).Abs().LTE(sdk.NewDecWithPrec(1, 8)),
+		"expected spot price 1, got %s", spotPrice)
+
+	// A small swap on a balanced pool trades close to 1:1, but never above.
+	tokenOutAmount, _, err := keeper.SwapExactAmountIn(suite.ctx, acc1, poolId, sdk.NewCoin("foo", sdk.NewInt(100000)), "bar", sdk.NewInt(99900))
+	suite.Require().NoError(err)
+	suite.Require().True(tokenOutAmount.LT(sdk.NewInt(100000)))
+
+	_, _, err = keeper.SwapExactAmountIn(suite.ctx, acc1, poolId, sdk.NewCoin("foo", sdk.NewInt(100000)), "bar", sdk.NewInt(100000))
+	suite.Require().Error(err)
+
+	// After the swap, bar is scarcer than foo.
+	spotPrice, err = keeper.CalculateSpotPrice(suite.ctx, poolId, "foo", "bar")
+	suite.Require().NoError(err)
+	suite.Require().True(spotPrice.GT(sdk.OneDec()))
+
+	tokenInAmount, _, err := keeper.SwapExactAmountOut(suite.ctx, acc1, poolId, "bar", sdk.NewInt(100000), sdk.NewCoin("foo", sdk.NewInt(99000)))
+	suite.Require().NoError(err)
+	suite.Require().True(tokenInAmount.LT(sdk.NewInt(99000)))
+
+	pool, err := keeper.GetPool(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(
+		types.PoolAssetsCoins(pool.GetAllPoolAssets()).String(),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, pool.GetAddress()).String(),
+	)
+}
@@ -0,0 +1,294 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+// trackChangedPool marks the pool as changed in the current block, so that its
+// TWAP records get updated in the end blocker.
+func (k Keeper) trackChangedPool(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyTwapChangedPool(poolId), sdk.Uint64ToBigEndian(poolId))
+}
+
+func (k Keeper) getChangedPools(ctx sdk.Context) []uint64 {
+	iter := k.iterator(ctx, types.KeyPrefixTwapChangedPools)
+	defer iter.Close()
+
+	poolIds := []uint64{}
+	for ; iter.Valid(); iter.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iter.Value()))
+	}
+	return poolIds
+}
+
+// getDenomPairs returns every pair of the pool's assets, with the denoms of
+// each pair in lexicographic order.
+func getDenomPairs(pool types.PoolI) [][2]string {
+	denoms := []string{}
+	for _, asset := range pool.GetAllPoolAssets() {
+		denoms = append(denoms, asset.Token.Denom)
+	}
+	sort.Strings(denoms)
+
+	pairs := [][2]string{}
+	for i := 0; i < len(denoms); i++ {
+		for j := i + 1; j < len(denoms); j++ {
+			pairs = append(pairs, [2]string{denoms[i], denoms[j]})
+		}
+	}
+	return pairs
+}
+
+// getSpotPrices returns the price of asset0 in units of asset1, and the price
+// of asset1 in units of asset0.
+func getSpotPrices(pool types.PoolI, asset0Denom, asset1Denom string) (p0 sdk.Dec, p1 sdk.Dec, err error) {
+	p0, err = pool.SpotPrice(asset1Denom, asset0Denom, sdk.ZeroDec())
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	p1, err = pool.SpotPrice(asset0Denom, asset1Denom, sdk.ZeroDec())
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	return p0, p1, nil
+}
+
+func newTwapRecord(ctx sdk.Context, pool types.PoolI, asset0Denom, asset1Denom string) (types.TwapRecord, error) {
+	p0, p1, err := getSpotPrices(pool, asset0Denom, asset1Denom)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+
+	return types.TwapRecord{
+		PoolId:                      pool.GetId(),
+		Asset0Denom:                 asset0Denom,
+		Asset1Denom:                 asset1Denom,
+		Height:                      ctx.BlockHeight(),
+		Time:                        ctx.BlockTime(),
+		P0LastSpotPrice:             p0,
+		P1LastSpotPrice:             p1,
+		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
+	}, nil
+}
+
+// createTwapRecords creates the initial TWAP records for every asset pair of a new pool.
+func (k Keeper) createTwapRecords(ctx sdk.Context, pool types.PoolI) error {
+	for _, pair := range getDenomPairs(pool) {
+		record, err := newTwapRecord(ctx, pool, pair[0], pair[1])
+		if err != nil {
+			return err
+		}
+		k.SetTwapRecord(ctx, record)
+	}
+	return nil
+}
+
+// updateTwapRecords accumulates the last spot prices of every asset pair of the pool
+// up to the current block time, and records the pool's new spot prices.
+func (k Keeper) updateTwapRecords(ctx sdk.Context, poolId uint64) error {
+	pool, err := k.GetPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	for _, pair := range getDenomPairs(pool) {
+		record, err := k.GetMostRecentTwapRecord(ctx, poolId, pair[0], pair[1])
+		if err != nil {
+			// The pool predates TWAP tracking, so start accumulating from now on.
+			record, err = newTwapRecord(ctx, pool, pair[0], pair[1])
+			if err != nil {
+				return err
+			}
+			k.SetTwapRecord(ctx, record)
+			continue
+		}
+
+		record = interpolateTwapRecord(record, ctx.BlockTime())
+		record.Height = ctx.BlockHeight()
+
+		p0, p1, err := getSpotPrices(pool, pair[0], pair[1])
+		if err != nil {
+			// Keep accumulating the previous spot prices until they can be computed again.
+			k.Logger(ctx).Error("failed to compute spot price for twap", "pool_id", poolId,
+				"asset0", pair[0], "asset1", pair[1], "error", err.Error())
+		} else {
+			record.P0LastSpotPrice = p0
+			record.P1LastSpotPrice = p1
+		}
+
+		k.SetTwapRecord(ctx, record)
+	}
+	return nil
+}
+
+// interpolateTwapRecord returns the record with its accumulators advanced to time t,
+// assuming the last spot prices held since the record was written.
+func interpolateTwapRecord(record types.TwapRecord, t time.Time) types.TwapRecord {
+	elapsedMs := sdk.NewDec(t.Sub(record.Time).Milliseconds())
+	record.P0ArithmeticTwapAccumulator = record.P0ArithmeticTwapAccumulator.Add(record.P0LastSpotPrice.Mul(elapsedMs))
+	record.P1ArithmeticTwapAccumulator = record.P1ArithmeticTwapAccumulator.Add(record.P1LastSpotPrice.Mul(elapsedMs))
+	record.Time = t
+	return record
+}
+
+// EndBlock executes the swaps queued on pools in batch mode, fills the limit orders whose trigger
+// price was reached, updates the TWAP records of the pools changed in this block, and prunes the records
+// that fell out of the pruning horizon.
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	k.executeBatches(ctx)
+	k.fillLimitOrders(ctx)
+
+	store := ctx.KVStore(k.storeKey)
+	for _, poolId := range k.getChangedPools(ctx) {
+		err := k.updateTwapRecords(ctx, poolId)
+		if err != nil {
+			k.Logger(ctx).Error("failed to update twap records", "pool_id", poolId, "error", err.Error())
+		}
+		err = k.updatePoolStatsLiquidity(ctx, poolId)
+		if err != nil {
+			k.Logger(ctx).Error("failed to update pool stats", "pool_id", poolId, "error", err.Error())
+		}
+		store.Delete(types.GetKeyTwapChangedPool(poolId))
+	}
+
+	k.pruneTwapRecords(ctx, ctx.BlockTime().Add(-k.GetParams(ctx).TwapPruningHorizon))
+}
+
+// pruneTwapRecords deletes the historical records superseded by a newer record of their asset pair
+// before the given time. The newest record before that time is never superseded before it, so it is kept
+// for each asset pair, and TWAPs starting within the horizon can still be computed.
+// Only the records to delete are iterated, since kept records are not in the pruning index.
+func (k Keeper) pruneTwapRecords(ctx sdk.Context, pruneBefore time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.KeyPrefixTwapPruning, types.GetKeyPrefixTwapPruning(pruneBefore))
+	defer iter.Close()
+
+	prunedKeys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		var record types.TwapRecord
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &record)
+
+		prunedKeys = append(prunedKeys, iter.Key(),
+			types.GetKeyTwapHistorical(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time))
+	}
+
+	for _, key := range prunedKeys {
+		store.Delete(key)
+	}
+}
+
+// SetTwapRecord stores the record as the most recent record of its asset pair,
+// and in the historical records. The previous most recent record of the pair
+// becomes prunable once the new record falls out of the pruning horizon.
+func (k Keeper) SetTwapRecord(ctx sdk.Context, record types.TwapRecord) {
+	previous, err := k.GetMostRecentTwapRecord(ctx, record.PoolId, record.Asset0Denom, record.Asset1Denom)
+
+	store := ctx.KVStore(k.storeKey)
+	if err == nil && previous.Time.Before(record.Time) {
+		store.Set(types.GetKeyTwapPruning(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time),
+			k.cdc.MustMarshalBinaryBare(&previous))
+	}
+
+	bz := k.cdc.MustMarshalBinaryBare(&record)
+	store.Set(types.GetKeyTwapMostRecent(record.PoolId, record.Asset0Denom, record.Asset1Denom), bz)
+	store.Set(types.GetKeyTwapHistorical(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time), bz)
+}
+
+func (k Keeper) GetMostRecentTwapRecord(ctx sdk.Context, poolId uint64, asset0Denom, asset1Denom string) (types.TwapRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyTwapMostRecent(poolId, asset0Denom, asset1Denom))
+	if bz == nil {
+		return types.TwapRecord{}, sdkerrors.Wrapf(types.ErrTwapHistoryUnavailable,
+			"no twap record for pool %d, assets %s and %s", poolId, asset0Denom, asset1Denom)
+	}
+
+	var record types.TwapRecord
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+	return record, nil
+}
+
+// GetAllHistoricalTwapRecords returns every historical record, which include
+// the most recent record of each asset pair.
+func (k Keeper) GetAllHistoricalTwapRecords(ctx sdk.Context) []types.TwapRecord {
+	iter := k.iterator(ctx, types.KeyPrefixTwapHistorical)
+	defer iter.Close()
+
+	records := []types.TwapRecord{}
+	for ; iter.Valid(); iter.Next() {
+		var record types.TwapRecord
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// getTwapRecordAtOrBefore returns the newest record of the asset pair written at or before time t.
+func (k Keeper) getTwapRecordAtOrBefore(ctx sdk.Context, poolId uint64, asset0Denom, asset1Denom string, t time.Time) (types.TwapRecord, error) {
+	record, err := k.GetMostRecentTwapRecord(ctx, poolId, asset0Denom, asset1Denom)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+	if !record.Time.After(t) {
+		return record, nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	end := append(types.GetKeyTwapHistorical(poolId, asset0Denom, asset1Denom, t), 0x00)
+	iter := store.ReverseIterator(types.GetKeyPrefixTwapHistorical(poolId, asset0Denom, asset1Denom), end)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.TwapRecord{}, sdkerrors.Wrapf(types.ErrTwapHistoryUnavailable,
+			"no twap record for pool %d at or before %s", poolId, t)
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(iter.Value(), &record)
+	return record, nil
+}
+
+// ArithmeticTwap returns the time weighted average price of the base asset,
+// in units of the quote asset, over the [startTime, endTime] window.
+func (k Keeper) ArithmeticTwap(ctx sdk.Context, poolId uint64, baseAsset, quoteAsset string, startTime, endTime time.Time) (sdk.Dec, error) {
+	if baseAsset == quoteAsset {
+		return sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "base and quote asset are both %s", baseAsset)
+	}
+	if endTime.Sub(startTime) < time.Millisecond {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTwapTimeRange, "end time %s is not at least 1ms after start time %s", endTime, startTime)
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTwapTimeRange, "end time %s is after block time %s", endTime, ctx.BlockTime())
+	}
+
+	asset0Denom, asset1Denom := baseAsset, quoteAsset
+	if asset1Denom < asset0Denom {
+		asset0Denom, asset1Denom = asset1Denom, asset0Denom
+	}
+
+	startRecord, err := k.getTwapRecordAtOrBefore(ctx, poolId, asset0Denom, asset1Denom, startTime)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	endRecord, err := k.getTwapRecordAtOrBefore(ctx, poolId, asset0Denom, asset1Denom, endTime)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	startRecord = interpolateTwapRecord(startRecord, startTime)
+	endRecord = interpolateTwapRecord(endRecord, endTime)
+
+	var accumulatorDiff sdk.Dec
+	if baseAsset == asset0Denom {
+		accumulatorDiff = endRecord.P0ArithmeticTwapAccumulator.Sub(startRecord.P0ArithmeticTwapAccumulator)
+	} else {
+		accumulatorDiff = endRecord.P1ArithmeticTwapAccumulator.Sub(startRecord.P1ArithmeticTwapAccumulator)
+	}
+
+	return accumulatorDiff.QuoInt64(endTime.Sub(startTime).Milliseconds()), nil
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+var twapStartTime = time.Unix(1_000_000, 0).UTC()
+
+func (suite *KeeperTestSuite) requireDecApproxEqual(expected, actual sdk.Dec) {
+	suite.Require().True(expected.Sub(actual).Abs().LTE(sdk.NewDecWithPrec(1, 15)), "expected %s, got %s", expected, actual)
+}
+
+func (suite *KeeperTestSuite) advanceBlock(d time.Duration) {
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(suite.ctx.BlockTime().Add(d))
+}
+
+func (suite *KeeperTestSuite) TestArithmeticTwap() {
+	suite.ctx = suite.ctx.WithBlockTime(twapStartTime)
+	poolId := suite.preparePool()
+	keeper := suite.app.GAMMKeeper
+	keeper.EndBlock(suite.ctx)
+
+	// price of foo in units of bar
+	spotPriceBefore, err := keeper.CalculateSpotPrice(suite.ctx, poolId, "bar", "foo")
+	suite.Require().NoError(err)
+
+	// Without any pool change, the twap is the spot price.
+	suite.advanceBlock(10 * time.Second)
+	keeper.EndBlock(suite.ctx)
+	twap, err := keeper.ArithmeticTwap(suite.ctx, poolId, "foo", "bar", twapStartTime, suite.ctx.BlockTime())
+	suite.Require().NoError(err)
+	suite.requireDecApproxEqual(spotPriceBefore, twap)
+
+	_, _, err = keeper.SwapExactAmountIn(suite.ctx, acc1, poolId, sdk.NewCoin("bar", sdk.NewInt(100000)), "foo", sdk.OneInt())
+	suite.Require().NoError(err)
+	keeper.EndBlock(suite.ctx)
+
+	spotPriceAfter, err := keeper.CalculateSpotPrice(suite.ctx, poolId, "bar", "foo")
+	suite.Require().NoError(err)
+	suite.Require().True(spotPriceAfter.GT(spotPriceBefore))
+
+	suite.advanceBlock(10 * time.Second)
+
+	// The swap happened halfway through the window.
+	twap, err = keeper.ArithmeticTwap(suite.ctx, poolId, "foo", "bar", twapStartTime, suite.ctx.BlockTime())
+	suite.Require().NoError(err)
+	expected := spotPriceBefore.Add(spotPriceAfter).QuoInt64(2)
+	suite.requireDecApproxEqual(expected, twap)
+
+	// The quote side of the pair is tracked as well.
+	twap, err = keeper.ArithmeticTwap(suite.ctx, poolId, "bar", "foo", twapStartTime.Add(10*time.Second), suite.ctx.BlockTime())
+	suite.Require().NoError(err)
+	inverseSpotPrice, err := keeper.CalculateSpotPrice(suite.ctx, poolId, "foo", "bar")
+	suite.Require().NoError(err)
+	suite.requireDecApproxEqual(inverseSpotPrice, twap)
+
+	// Windows within a single record interpolate the last spot price.
+	twap, err = keeper.ArithmeticTwap(suite.ctx, poolId, "foo", "bar", twapStartTime.Add(2*time.Second), twapStartTime.Add(5*time.Second))
+	suite.Require().NoError(err)
+	suite.requireDecApproxEqual(spotPriceBefore, twap)
+
+	// The query defaults the end time to the block time.
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper)
+	res, err := types.NewQueryClient(queryHelper).Twap(sdk.WrapSDKContext(suite.ctx), &types.QueryTwapRequest{
+		PoolId:     poolId,
+		BaseAsset:  "foo",
+		QuoteAsset: "bar",
+		StartTime:  twapStartTime,
+	})
+	suite.Require().NoError(err)
+	suite.requireDecApproxEqual(expected, res.ArithmeticTwap)
+}
+
+func (suite *KeeperTestSuite) TestArithmeticTwapErrors() {
+	suite.ctx = suite.ctx.WithBlockTime(twapStartTime)
+	poolId := suite.preparePool()
+	keeper := suite.app.GAMMKeeper
+	suite.advanceBlock(10 * time.Second)
+
+	tests := []struct {
+		name       string
+		poolId     uint64
+		baseAsset  string
+		quoteAsset string
+		startTime  time.Time
+		endTime    time.Time
+	}{
+		{"same asset", poolId, "foo", "foo", twapStartTime, suite.ctx.BlockTime()},
+		{"start time after end time", poolId, "foo", "bar", suite.ctx.BlockTime(), twapStartTime},
+		{"end time after block time", poolId, "foo", "bar", twapStartTime, suite.ctx.BlockTime().Add(time.Second)},
+		{"start time before pool creation", poolId, "foo", "bar", twapStartTime.Add(-time.Second), suite.ctx.BlockTime()},
+		{"unknown asset", poolId, "foo", "qux", twapStartTime, suite.ctx.BlockTime()},
+		{"unknown pool", poolId + 1, "foo", "bar", twapStartTime, suite.ctx.BlockTime()},
+	}
+
+	for _, test := range tests {
+		_, err := keeper.ArithmeticTwap(suite.ctx, test.poolId, test.baseAsset, test.quoteAsset, test.startTime, test.endTime)
+		suite.Require().Error(err, "test: %v", test.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestTwapPruning() {
+	suite.ctx = suite.ctx.WithBlockTime(twapStartTime)
+	poolId := suite.preparePool()
+	keeper := suite.app.GAMMKeeper
+
+	params := keeper.GetParams(suite.ctx)
+	params.TwapPruningHorizon = time.Hour
+	keeper.SetParams(suite.ctx, params)
+
+	swap := func() {
+		_, _, err := keeper.SwapExactAmountIn(suite.ctx, acc1, poolId, sdk.NewCoin("bar", sdk.NewInt(100000)), "foo", sdk.OneInt())
+		suite.Require().NoError(err)
+		keeper.EndBlock(suite.ctx)
+	}
+
+	suite.advanceBlock(10 * time.Second)
+	swap()
+	suite.advanceBlock(2 * time.Hour)
+	swap()
+
+	// The pool has 3 asset pairs, each with records at the pool creation,
+	// and after each swap. The creation records are pruned, but the records of the first swap
+	// are kept since they are the newest ones before the horizon.
+	records := keeper.GetAllHistoricalTwapRecords(suite.ctx)
+	suite.Require().Len(records, 6)
+	for _, record := range records {
+		suite.Require().True(record.Time.After(twapStartTime))
+	}
+
+	// Only the records of the first swap, superseded by the second swap within the horizon, are left to prune.
+	iter := sdk.KVStorePrefixIterator(suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)), types.KeyPrefixTwapPruning)
+	pruningEntries := 0
+	for ; iter.Valid(); iter.Next() {
+		pruningEntries++
+	}
+	iter.Close()
+	suite.Require().Equal(3, pruningEntries)
+
+	_, err := keeper.ArithmeticTwap(suite.ctx, poolId, "foo", "bar", twapStartTime.Add(5*time.Second), suite.ctx.BlockTime())
+	suite.Require().Error(err)
+	_, err = keeper.ArithmeticTwap(suite.ctx, poolId, "foo", "bar", twapStartTime.Add(10*time.Second), suite.ctx.BlockTime())
+	suite.Require().NoError(err)
+}
@@ -28,6 +28,7 @@ const (
 	OpWeightMsgJoinSwapShareAmountOut  = "op_weight_join_swap_share_amount_out"
 	OpWeightMsgExitSwapExternAmountOut = "op_weight_exit_swap_extern_amount_out"
 	OpWeightMsgExitSwapShareAmountIn   = "op_weight_exit_swap_share_amount_in"
+	OpWeightMsgBatchSwapExactAmountIn  = "op_weight_batch_swap_exact_amount_in"
 
 	DefaultWeightMsgCreatePool              int = 10
 	DefaultWeightMsgSwapExactAmountIn       int = 25
@@ -38,6 +39,7 @@ const (
 	DefaultWeightMsgJoinSwapShareAmountOut  int = 10
 	DefaultWeightMsgExitSwapExternAmountOut int = 10
 	DefaultWeightMsgExitSwapShareAmountIn   int = 10
+	DefaultWeightMsgBatchSwapExactAmountIn  int = 10
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -46,8 +48,9 @@ func WeightedOperations(
 	bk stakingTypes.BankKeeper, k keeper.Keeper,
 ) simulation.WeightedOperations {
 	var (
-		weightMsgCreatePool        int
-		weightMsgSwapExactAmountIn int
+		weightMsgCreatePool             int
+		weightMsgSwapExactAmountIn      int
+		weightMsgBatchSwapExactAmountIn int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreatePool, &weightMsgCreatePool, nil,
@@ -56,6 +59,11 @@ func WeightedOperations(
 			weightMsgSwapExactAmountIn = simappparams.DefaultWeightMsgCreateValidator
 		},
 	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgBatchSwapExactAmountIn, &weightMsgBatchSwapExactAmountIn, nil,
+		func(_ *rand.Rand) {
+			weightMsgBatchSwapExactAmountIn = DefaultWeightMsgBatchSwapExactAmountIn
+		},
+	)
 
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
@@ -66,6 +74,10 @@ func WeightedOperations(
 			weightMsgSwapExactAmountIn,
 			SimulateMsgSwapExactAmountIn(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgBatchSwapExactAmountIn,
+			SimulateMsgBatchSwapExactAmountIn(ak, bk, k),
+		),
 	}
 }
 
@@ -155,9 +167,9 @@ func SimulateMsgCreatePool(ak stakingTypes.AccountKeeper, bk stakingTypes.BankKe
 		}
 
 		// set the pool params to set the pool creation fee to dust amount of denom
-		k.SetParams(ctx, types.Params{
-			PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(denoms[0], 1)},
-		})
+		params := k.GetParams(ctx)
+		params.PoolCreationFee = sdk.Coins{sdk.NewInt64Coin(denoms[0], 1)}
+		k.SetParams(ctx, params)
 
 		// futurePoolGovernor := genFuturePoolGovernor(r, simAccount.Address, denoms)
 		msg := types.MsgCreateBalancerPool{
@@ -217,6 +229,49 @@ func SimulateMsgSwapExactAmountIn(ak stakingTypes.AccountKeeper, bk stakingTypes
 	}
 }
 
+// SimulateMsgBatchSwapExactAmountIn puts a random pool in batch mode, and generates a MsgSwapExactAmountIn
+// with random values on it, to be executed with the other swaps queued on the pool at the end of the block.
+func SimulateMsgBatchSwapExactAmountIn(ak stakingTypes.AccountKeeper, bk stakingTypes.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		simCoins := bk.SpendableCoins(ctx, simAccount.Address)
+		if simCoins.Len() <= 0 {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSwapExactAmountIn, "Account have no coin"), nil, nil
+		}
+
+		coin := simCoins[r.Intn(len(simCoins))]
+		amt, _ := simtypes.RandPositiveInt(r, coin.Amount.QuoRaw(200))
+
+		tokenIn := sdk.Coin{
+			Denom:  coin.Denom,
+			Amount: amt,
+		}
+
+		routes, _ := RandomExactAmountInRoute(ctx, r, k, tokenIn)
+		if len(routes) != 1 {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSwapExactAmountIn, "No pool exist"), nil, nil
+		}
+
+		// The pools created by the simulation have no governor, so batch mode is turned on directly.
+		k.SetBatchModePool(ctx, routes[0].PoolId, true)
+
+		msg := types.MsgSwapExactAmountIn{
+			Sender:            simAccount.Address.String(),
+			Routes:            routes,
+			TokenIn:           tokenIn,
+			TokenOutMinAmount: sdk.OneInt(),
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		return osmo_simulation.GenAndDeliverTxWithRandFees(
+			r, app, txGen, &msg, sdk.Coins{tokenIn}, ctx, simAccount, ak, bk, types.ModuleName)
+	}
+}
+
 func RandomExactAmountInRoute(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, tokenIn sdk.Coin) (res []types.SwapAmountInRoute, tokenOut sdk.Coin) {
 	routeLen := r.Intn(1) + 1
 
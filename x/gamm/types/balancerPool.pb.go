@@ -32,6 +32,37 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type LBPStatus int32
+
+const (
+	LBPSalePending LBPStatus = 0
+	LBPSaleOngoing LBPStatus = 1
+	LBPSaleEnded   LBPStatus = 2
+	LBPFinalized   LBPStatus = 3
+)
+
+var LBPStatus_name = map[int32]string{
+	0: "LBPSalePending",
+	1: "LBPSaleOngoing",
+	2: "LBPSaleEnded",
+	3: "LBPFinalized",
+}
+
+var LBPStatus_value = map[string]int32{
+	"LBPSalePending": 0,
+	"LBPSaleOngoing": 1,
+	"LBPSaleEnded":   2,
+	"LBPFinalized":   3,
+}
+
+func (x LBPStatus) String() string {
+	return proto.EnumName(LBPStatus_name, int32(x))
+}
+
+func (LBPStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8bed8b78c08e572f, []int{0}
+}
+
 type PoolAsset struct {
 	// Coins we are talking about,
 	// the denomination must be unique amongst all PoolAssets for this pool.
@@ -86,11 +117,12 @@ func (m *PoolAsset) GetToken() types.Coin {
 // the two weights, but more types may be added in the future.
 // When these parameters are set, the weight w(t) for pool time `t` is the
 // following:
-//   t <= start_time: w(t) = initial_pool_weights
-//   start_time < t <= start_time + duration:
-//     w(t) = initial_pool_weights + (t - start_time) *
-//       (target_pool_weights - initial_pool_weights) / (duration)
-//   t > start_time + duration: w(t) = target_pool_weights
+//
+//	t <= start_time: w(t) = initial_pool_weights
+//	start_time < t <= start_time + duration:
+//	  w(t) = initial_pool_weights + (t - start_time) *
+//	    (target_pool_weights - initial_pool_weights) / (duration)
+//	t > start_time + duration: w(t) = target_pool_weights
 type SmoothWeightChangeParams struct {
 	// The start time for beginning the weight change.
 	// If a parameter change / pool instantiation leaves this blank,
@@ -172,21 +204,100 @@ func (m *SmoothWeightChangeParams) GetTargetPoolWeights() []PoolAsset {
 	return nil
 }
 
+// LBPParams make a balancer pool a liquidity bootstrapping pool. It can only be
+// swapped against during its sale, and only its creator can join it until the
+// creator finalizes it after the sale.
+type LBPParams struct {
+	// The start time of the sale.
+	// If a pool instantiation leaves this blank, it is set by the state machine
+	// as the current time.
+	SaleStartTime time.Time `protobuf:"bytes,1,opt,name=sale_start_time,json=saleStartTime,proto3,stdtime" json:"sale_start_time" yaml:"sale_start_time"`
+	// The end time of the sale, after which the creator can finalize the pool.
+	SaleEndTime time.Time `protobuf:"bytes,2,opt,name=sale_end_time,json=saleEndTime,proto3,stdtime" json:"sale_end_time" yaml:"sale_end_time"`
+	// The creator of the pool. This is set by the state machine.
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	// Whether the creator finalized the pool without reopening it, which closes
+	// the pool for good. This is set by the state machine.
+	Finalized bool `protobuf:"varint,4,opt,name=finalized,proto3" json:"finalized,omitempty" yaml:"finalized"`
+}
+
+func (m *LBPParams) Reset()         { *m = LBPParams{} }
+func (m *LBPParams) String() string { return proto.CompactTextString(m) }
+func (*LBPParams) ProtoMessage()    {}
+func (*LBPParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bed8b78c08e572f, []int{2}
+}
+func (m *LBPParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LBPParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LBPParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LBPParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LBPParams.Merge(m, src)
+}
+func (m *LBPParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *LBPParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_LBPParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LBPParams proto.InternalMessageInfo
+
+func (m *LBPParams) GetSaleStartTime() time.Time {
+	if m != nil {
+		return m.SaleStartTime
+	}
+	return time.Time{}
+}
+
+func (m *LBPParams) GetSaleEndTime() time.Time {
+	if m != nil {
+		return m.SaleEndTime
+	}
+	return time.Time{}
+}
+
+func (m *LBPParams) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *LBPParams) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
+	}
+	return false
+}
+
 // BalancerPoolParams defined the parameters that will be managed by the pool
 // governance in the future. This params are not managed by the chain
-// governanace. Instead they will be managed by the token holders of the pool.
+// governance. Instead they will be managed by the token holders of the pool.
 // The pool's token holders are specified in future_pool_governor.
 type BalancerPoolParams struct {
 	SwapFee                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swapFee" yaml:"swap_fee"`
 	ExitFee                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exitFee" yaml:"exit_fee"`
 	SmoothWeightChangeParams *SmoothWeightChangeParams              `protobuf:"bytes,3,opt,name=smoothWeightChangeParams,proto3" json:"smoothWeightChangeParams,omitempty" yaml:"smooth_weight_change_params"`
+	LbpParams                *LBPParams                             `protobuf:"bytes,4,opt,name=lbpParams,proto3" json:"lbpParams,omitempty" yaml:"lbp_params"`
 }
 
 func (m *BalancerPoolParams) Reset()         { *m = BalancerPoolParams{} }
 func (m *BalancerPoolParams) String() string { return proto.CompactTextString(m) }
 func (*BalancerPoolParams) ProtoMessage()    {}
 func (*BalancerPoolParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bed8b78c08e572f, []int{2}
+	return fileDescriptor_8bed8b78c08e572f, []int{3}
 }
 func (m *BalancerPoolParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -222,6 +333,13 @@ func (m *BalancerPoolParams) GetSmoothWeightChangeParams() *SmoothWeightChangePa
 	return nil
 }
 
+func (m *BalancerPoolParams) GetLbpParams() *LBPParams {
+	if m != nil {
+		return m.LbpParams
+	}
+	return nil
+}
+
 type BalancerPool struct {
 	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
 	Id         uint64             `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -248,7 +366,7 @@ type BalancerPool struct {
 func (m *BalancerPool) Reset()      { *m = BalancerPool{} }
 func (*BalancerPool) ProtoMessage() {}
 func (*BalancerPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bed8b78c08e572f, []int{3}
+	return fileDescriptor_8bed8b78c08e572f, []int{4}
 }
 func (m *BalancerPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,8 +396,10 @@ func (m *BalancerPool) XXX_DiscardUnknown() {
 var xxx_messageInfo_BalancerPool proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("osmosis.gamm.v1beta1.LBPStatus", LBPStatus_name, LBPStatus_value)
 	proto.RegisterType((*PoolAsset)(nil), "osmosis.gamm.v1beta1.PoolAsset")
 	proto.RegisterType((*SmoothWeightChangeParams)(nil), "osmosis.gamm.v1beta1.SmoothWeightChangeParams")
+	proto.RegisterType((*LBPParams)(nil), "osmosis.gamm.v1beta1.LBPParams")
 	proto.RegisterType((*BalancerPoolParams)(nil), "osmosis.gamm.v1beta1.BalancerPoolParams")
 	proto.RegisterType((*BalancerPool)(nil), "osmosis.gamm.v1beta1.BalancerPool")
 }
@@ -289,58 +409,71 @@ func init() {
 }
 
 var fileDescriptor_8bed8b78c08e572f = []byte{
-	// 816 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x4f, 0xeb, 0x46,
-	0x14, 0x8d, 0x93, 0x00, 0xcd, 0x40, 0xa9, 0x98, 0x66, 0x61, 0x82, 0x1a, 0xa3, 0xa9, 0xd4, 0x46,
-	0x15, 0xd8, 0x82, 0xee, 0xd8, 0x54, 0x98, 0x8f, 0x8a, 0x1d, 0x35, 0x95, 0x40, 0x65, 0x61, 0x4d,
-	0x92, 0xc1, 0xb1, 0xb0, 0x3d, 0xae, 0x67, 0xc2, 0xc7, 0x3f, 0xe8, 0x92, 0x55, 0xc5, 0x92, 0x7d,
-	0xb7, 0xfd, 0x03, 0x5d, 0x95, 0x25, 0xea, 0xaa, 0xea, 0xc2, 0x7d, 0x82, 0xc5, 0x93, 0xde, 0x32,
-	0xbf, 0xe0, 0x69, 0x3e, 0x1c, 0xf2, 0x42, 0xd0, 0x03, 0xbd, 0x15, 0xcc, 0x9d, 0x7b, 0xcf, 0xb9,
-	0xe7, 0xdc, 0xeb, 0x09, 0xf8, 0x96, 0xb2, 0x98, 0xb2, 0x90, 0x39, 0x01, 0x8e, 0x63, 0xe7, 0x6c,
-	0xad, 0x4d, 0x38, 0x5e, 0x73, 0xda, 0x38, 0xc2, 0x49, 0x87, 0x64, 0xfb, 0x94, 0x46, 0x76, 0x9a,
-	0x51, 0x4e, 0x61, 0x5d, 0x27, 0xda, 0x22, 0xd1, 0xd6, 0x89, 0x8d, 0xc5, 0x8e, 0x0c, 0xfb, 0x32,
-	0xc7, 0x51, 0x07, 0x55, 0xd0, 0xa8, 0x07, 0x34, 0xa0, 0x2a, 0x2e, 0xfe, 0xd3, 0xd1, 0x66, 0x40,
-	0x69, 0x10, 0x11, 0x47, 0x9e, 0xda, 0xfd, 0x13, 0xa7, 0xdb, 0xcf, 0x30, 0x0f, 0x69, 0xa2, 0xef,
-	0xad, 0xf1, 0x7b, 0x1e, 0xc6, 0x84, 0x71, 0x1c, 0xa7, 0x05, 0x80, 0x22, 0x71, 0x70, 0x9f, 0xf7,
-	0x86, 0xfd, 0x8a, 0xc3, 0xd8, 0x7d, 0x1b, 0x33, 0x32, 0xbc, 0xef, 0xd0, 0x50, 0x13, 0xa0, 0x3f,
-	0x0c, 0x50, 0x13, 0xb2, 0x36, 0x19, 0x23, 0x1c, 0xee, 0x80, 0x29, 0x4e, 0x4f, 0x49, 0x62, 0x1a,
-	0xcb, 0x46, 0x6b, 0x76, 0x7d, 0xd1, 0xd6, 0x12, 0x44, 0x75, 0x21, 0xd2, 0xde, 0xa2, 0x61, 0xe2,
-	0xd6, 0x6f, 0x73, 0xab, 0x34, 0xc8, 0xad, 0xb9, 0x4b, 0x1c, 0x47, 0x1b, 0x48, 0x56, 0x21, 0x4f,
-	0x55, 0xc3, 0x43, 0x30, 0x7d, 0x4e, 0xc2, 0xa0, 0xc7, 0xcd, 0xf2, 0xb2, 0xd1, 0xaa, 0xb9, 0x3f,
-	0x88, 0xe4, 0xff, 0x72, 0xeb, 0x9b, 0x20, 0xe4, 0xbd, 0x7e, 0xdb, 0xee, 0xd0, 0x58, 0x9b, 0xa3,
-	0xff, 0xac, 0xb2, 0xee, 0xa9, 0xc3, 0x2f, 0x53, 0xc2, 0xec, 0xbd, 0x84, 0x0f, 0x72, 0xeb, 0x73,
-	0x05, 0xab, 0x50, 0x90, 0xa7, 0xe1, 0xd0, 0xdf, 0x15, 0x60, 0x1e, 0xc4, 0x94, 0xf2, 0xde, 0xa1,
-	0x0c, 0x6c, 0xf5, 0x70, 0x12, 0x90, 0x7d, 0x9c, 0xe1, 0x98, 0xc1, 0x23, 0x00, 0x18, 0xc7, 0x19,
-	0xf7, 0x85, 0x47, 0x5a, 0x41, 0xc3, 0x56, 0x06, 0xda, 0x85, 0x81, 0xf6, 0xcf, 0x85, 0x81, 0xee,
-	0x57, 0x5a, 0xc2, 0x82, 0xe2, 0x7a, 0xac, 0x45, 0x57, 0xff, 0x5b, 0x86, 0x57, 0x93, 0x01, 0x91,
-	0x0e, 0x7b, 0xe0, 0xb3, 0x62, 0x2e, 0x52, 0x91, 0x70, 0x66, 0x1c, 0x77, 0x5b, 0x27, 0xb8, 0x6b,
-	0x02, 0xf6, 0x5d, 0x6e, 0xc1, 0xa2, 0x64, 0x85, 0xc6, 0x21, 0x27, 0x71, 0xca, 0x2f, 0x07, 0xb9,
-	0xf5, 0x85, 0x22, 0x2b, 0xee, 0xd0, 0xb5, 0xa0, 0x1a, 0xa2, 0x43, 0x0e, 0x60, 0x98, 0x84, 0x3c,
-	0xc4, 0x91, 0x18, 0x8a, 0x12, 0xc9, 0xcc, 0xca, 0x72, 0xa5, 0x35, 0xbb, 0x6e, 0xd9, 0x93, 0x76,
-	0xce, 0x1e, 0x4e, 0xcf, 0xfd, 0x5a, 0x0b, 0x5a, 0x52, 0x1c, 0x1a, 0xc8, 0x4f, 0x29, 0x8d, 0x7c,
-	0x65, 0x20, 0x43, 0xde, 0x04, 0x7c, 0xf8, 0x2b, 0x58, 0xe0, 0x38, 0x0b, 0x08, 0x1f, 0x25, 0xad,
-	0xbe, 0x8c, 0x14, 0x69, 0xd2, 0x86, 0x5e, 0x04, 0x89, 0x33, 0xc6, 0xf9, 0x14, 0x1d, 0xbd, 0x2d,
-	0x03, 0xe8, 0x8e, 0x7c, 0x56, 0x7a, 0x86, 0xc7, 0x60, 0x86, 0x9d, 0xe3, 0x74, 0x97, 0xa8, 0x01,
-	0xd6, 0xdc, 0xcd, 0x57, 0xac, 0xce, 0x36, 0xe9, 0x3c, 0x3a, 0x2c, 0x60, 0xfc, 0x13, 0x42, 0x90,
-	0x57, 0x20, 0x0a, 0x70, 0x72, 0x11, 0x72, 0x01, 0x5e, 0xfe, 0x34, 0x70, 0x01, 0xa3, 0xc1, 0x35,
-	0x22, 0xfc, 0xdd, 0x00, 0x26, 0x7b, 0x66, 0x35, 0xcd, 0x8a, 0x5c, 0x1a, 0x7b, 0xb2, 0x97, 0xcf,
-	0x2d, 0xb4, 0xfb, 0xdd, 0x6d, 0x6e, 0x19, 0x83, 0xdc, 0x42, 0x5a, 0x91, 0xcc, 0xd3, 0xae, 0xfa,
-	0x1d, 0x99, 0xe9, 0xa7, 0x32, 0x15, 0x79, 0xcf, 0x72, 0xa3, 0xbf, 0xaa, 0x60, 0x6e, 0xd4, 0x69,
-	0xb8, 0x02, 0x66, 0x70, 0xb7, 0x9b, 0x11, 0xc6, 0xb4, 0xc7, 0x70, 0x90, 0x5b, 0xf3, 0x8a, 0x43,
-	0x5f, 0x20, 0xaf, 0x48, 0x81, 0xf3, 0xa0, 0x1c, 0x76, 0xa5, 0x5f, 0x55, 0xaf, 0x1c, 0x76, 0x61,
-	0x0c, 0x40, 0x3a, 0x9c, 0x97, 0x16, 0xd6, 0x9a, 0x2c, 0xec, 0xe9, 0x7c, 0xc7, 0x57, 0xb4, 0x78,
-	0x58, 0xd5, 0xbe, 0x14, 0x5a, 0x46, 0x08, 0xe0, 0x4f, 0xa0, 0x7e, 0xd2, 0xe7, 0xfd, 0x8c, 0xa8,
-	0x94, 0x80, 0x9e, 0x91, 0x2c, 0xa1, 0x99, 0x59, 0x95, 0x9d, 0x5b, 0x8f, 0x50, 0x93, 0xb2, 0x90,
-	0x07, 0x55, 0x58, 0x74, 0xf0, 0xa3, 0x0e, 0xc2, 0x23, 0x30, 0xcb, 0x29, 0xc7, 0xd1, 0x41, 0x0f,
-	0x67, 0x84, 0x99, 0x53, 0x1f, 0x7b, 0xea, 0x96, 0x74, 0xcf, 0x5f, 0x16, 0x4f, 0x1d, 0xc7, 0x91,
-	0xcf, 0x64, 0x31, 0xf2, 0x46, 0xa1, 0xe0, 0xb1, 0xf2, 0x46, 0x7e, 0x18, 0xcc, 0x9c, 0x7e, 0xd9,
-	0x07, 0xd4, 0xd0, 0xf0, 0x50, 0xc1, 0x4b, 0x01, 0x58, 0x22, 0x68, 0x27, 0x14, 0x1c, 0x0c, 0x74,
-	0xdb, 0x6a, 0xc4, 0xe6, 0x8c, 0x34, 0x60, 0xe7, 0xd5, 0x2f, 0xeb, 0x07, 0x2a, 0x8a, 0xf7, 0x75,
-	0x14, 0x79, 0x63, 0xe1, 0xb7, 0x1b, 0xab, 0x74, 0x7d, 0x63, 0x95, 0xfe, 0xf9, 0x73, 0x75, 0x4a,
-	0xf4, 0xb9, 0xe7, 0xee, 0xde, 0xde, 0x37, 0x8d, 0xbb, 0xfb, 0xa6, 0xf1, 0xe6, 0xbe, 0x69, 0x5c,
-	0x3d, 0x34, 0x4b, 0x77, 0x0f, 0xcd, 0xd2, 0xbf, 0x0f, 0xcd, 0xd2, 0x2f, 0x2b, 0x23, 0xc4, 0x5a,
-	0xe8, 0x6a, 0x84, 0xdb, 0xac, 0x38, 0x38, 0x17, 0xea, 0xa7, 0x54, 0xb6, 0xd0, 0x9e, 0x96, 0xcf,
-	0xe5, 0xf7, 0xef, 0x03, 0x00, 0x00, 0xff, 0xff, 0x5a, 0xdb, 0xd9, 0x1e, 0x67, 0x07, 0x00, 0x00,
+	// 1009 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x4e, 0x52, 0x4f, 0xd2, 0x34, 0x19, 0x2c, 0xb4, 0x71, 0x85, 0xd7, 0x1a, 0x24,
+	0x88, 0xaa, 0x64, 0xad, 0x84, 0x5b, 0x2f, 0xa8, 0xdb, 0x26, 0xa8, 0x12, 0x12, 0x61, 0x83, 0xd4,
+	0x88, 0x0a, 0x99, 0xb1, 0x77, 0xb2, 0x5e, 0x75, 0x77, 0x67, 0xd9, 0x19, 0xb7, 0x0d, 0xbf, 0xa0,
+	0xc7, 0x9e, 0x50, 0x8f, 0x95, 0x38, 0x72, 0x45, 0xe2, 0xdc, 0x13, 0x39, 0x56, 0x9c, 0x10, 0x87,
+	0x05, 0x25, 0x37, 0x8e, 0xfe, 0x05, 0x68, 0x66, 0xde, 0x3a, 0xae, 0xeb, 0xa8, 0x8d, 0x38, 0x25,
+	0xf3, 0xe6, 0xbd, 0xef, 0x7b, 0xef, 0x7b, 0x6f, 0xdf, 0x18, 0x7d, 0xca, 0x45, 0xc2, 0x45, 0x24,
+	0xba, 0x21, 0x4d, 0x92, 0xee, 0xe3, 0x9d, 0x3e, 0x93, 0x74, 0xa7, 0xdb, 0xa7, 0x31, 0x4d, 0x07,
+	0x2c, 0x3f, 0xe0, 0x3c, 0x76, 0xb3, 0x9c, 0x4b, 0x8e, 0x9b, 0xe0, 0xe8, 0x2a, 0x47, 0x17, 0x1c,
+	0x5b, 0x1b, 0x03, 0x6d, 0xee, 0x69, 0x9f, 0xae, 0x39, 0x98, 0x80, 0x56, 0x33, 0xe4, 0x21, 0x37,
+	0x76, 0xf5, 0x1f, 0x58, 0xdb, 0x21, 0xe7, 0x61, 0xcc, 0xba, 0xfa, 0xd4, 0x1f, 0x1d, 0x77, 0x83,
+	0x51, 0x4e, 0x65, 0xc4, 0x53, 0xb8, 0x77, 0x66, 0xef, 0x65, 0x94, 0x30, 0x21, 0x69, 0x92, 0x95,
+	0x00, 0x86, 0xa4, 0x4b, 0x47, 0x72, 0x38, 0xc9, 0x57, 0x1d, 0x66, 0xee, 0xfb, 0x54, 0xb0, 0xc9,
+	0xfd, 0x80, 0x47, 0x40, 0x40, 0x7e, 0xb1, 0x50, 0x43, 0x95, 0x75, 0x47, 0x08, 0x26, 0xf1, 0x1e,
+	0x5a, 0x90, 0xfc, 0x11, 0x4b, 0x6d, 0xab, 0x63, 0x6d, 0x2e, 0xef, 0x6e, 0xb8, 0x50, 0x82, 0x8a,
+	0x2e, 0x8b, 0x74, 0xef, 0xf2, 0x28, 0xf5, 0x9a, 0xa7, 0x85, 0x53, 0x19, 0x17, 0xce, 0xca, 0x09,
+	0x4d, 0xe2, 0xdb, 0x44, 0x47, 0x11, 0xdf, 0x44, 0xe3, 0x07, 0x68, 0xf1, 0x09, 0x8b, 0xc2, 0xa1,
+	0xb4, 0xab, 0x1d, 0x6b, 0xb3, 0xe1, 0x7d, 0xae, 0x9c, 0xff, 0x2a, 0x9c, 0x4f, 0xc2, 0x48, 0x0e,
+	0x47, 0x7d, 0x77, 0xc0, 0x13, 0x10, 0x07, 0xfe, 0x6c, 0x8b, 0xe0, 0x51, 0x57, 0x9e, 0x64, 0x4c,
+	0xb8, 0xf7, 0x53, 0x39, 0x2e, 0x9c, 0xeb, 0x06, 0xd6, 0xa0, 0x10, 0x1f, 0xe0, 0xc8, 0xef, 0x35,
+	0x64, 0x1f, 0x26, 0x9c, 0xcb, 0xe1, 0x03, 0x6d, 0xb8, 0x3b, 0xa4, 0x69, 0xc8, 0x0e, 0x68, 0x4e,
+	0x13, 0x81, 0x8f, 0x10, 0x12, 0x92, 0xe6, 0xb2, 0xa7, 0x34, 0x82, 0x0a, 0x5a, 0xae, 0x11, 0xd0,
+	0x2d, 0x05, 0x74, 0xbf, 0x29, 0x05, 0xf4, 0x3e, 0x82, 0x12, 0xd6, 0x0d, 0xd7, 0x45, 0x2c, 0x79,
+	0xfe, 0xb7, 0x63, 0xf9, 0x0d, 0x6d, 0x50, 0xee, 0x78, 0x88, 0xae, 0x95, 0x7d, 0xb1, 0xab, 0xa0,
+	0xcc, 0x2c, 0xee, 0x3d, 0x70, 0xf0, 0x76, 0x14, 0xec, 0xbf, 0x85, 0x83, 0xcb, 0x90, 0x2d, 0x9e,
+	0x44, 0x92, 0x25, 0x99, 0x3c, 0x19, 0x17, 0xce, 0x0d, 0x43, 0x56, 0xde, 0x91, 0x17, 0x8a, 0x6a,
+	0x82, 0x8e, 0x25, 0xc2, 0x51, 0x1a, 0xc9, 0x88, 0xc6, 0xaa, 0x29, 0xa6, 0x48, 0x61, 0xd7, 0x3a,
+	0xb5, 0xcd, 0xe5, 0x5d, 0xc7, 0x9d, 0x37, 0x73, 0xee, 0xa4, 0x7b, 0xde, 0xc7, 0x50, 0xd0, 0x4d,
+	0xc3, 0x01, 0x40, 0xbd, 0x8c, 0xf3, 0xb8, 0x67, 0x04, 0x14, 0xc4, 0x9f, 0x83, 0x8f, 0x7f, 0x40,
+	0xeb, 0x92, 0xe6, 0x21, 0x93, 0xd3, 0xa4, 0xf5, 0xf7, 0x23, 0x25, 0x40, 0xda, 0x82, 0x41, 0xd0,
+	0x38, 0x33, 0x9c, 0x6f, 0xa3, 0x93, 0xdf, 0xaa, 0xa8, 0xf1, 0xa5, 0x77, 0x00, 0xad, 0x3b, 0x46,
+	0x37, 0x04, 0x8d, 0x59, 0xef, 0x4a, 0xfd, 0x2b, 0x99, 0x3f, 0x84, 0xfe, 0xbd, 0x09, 0x60, 0x9a,
+	0x78, 0x5d, 0x59, 0x0f, 0x27, 0x8d, 0xfc, 0x1e, 0x69, 0x43, 0x8f, 0xa5, 0x81, 0x61, 0xa9, 0xbe,
+	0x93, 0xa5, 0x03, 0x2c, 0xcd, 0x29, 0x96, 0x32, 0xdc, 0x70, 0x2c, 0x2b, 0xdb, 0x5e, 0x1a, 0x68,
+	0x86, 0x2d, 0xb4, 0x34, 0xc8, 0x19, 0x95, 0x3c, 0xb7, 0x6b, 0x7a, 0xf6, 0xf1, 0xb8, 0x70, 0x56,
+	0x4d, 0x2c, 0x5c, 0x10, 0xbf, 0x74, 0xc1, 0xbb, 0xa8, 0x71, 0x1c, 0xa5, 0x34, 0x8e, 0x7e, 0x64,
+	0x81, 0x5d, 0xef, 0x58, 0x9b, 0xd7, 0xbc, 0xe6, 0xb8, 0x70, 0xd6, 0x8c, 0xff, 0xe4, 0x8a, 0xf8,
+	0x17, 0x6e, 0xe4, 0x55, 0x0d, 0x61, 0x6f, 0x6a, 0x21, 0x81, 0x84, 0x0f, 0xd1, 0x92, 0x78, 0x42,
+	0xb3, 0x7d, 0x66, 0xa4, 0x6b, 0x78, 0x77, 0xae, 0xf0, 0xd1, 0xdd, 0x63, 0x83, 0x8b, 0xd9, 0x54,
+	0x30, 0xbd, 0x63, 0xc6, 0x88, 0x5f, 0x22, 0x2a, 0x70, 0xf6, 0x34, 0x92, 0xfb, 0xcc, 0x28, 0xf6,
+	0x3f, 0xc0, 0x15, 0x0c, 0x80, 0x03, 0x22, 0xfe, 0xc9, 0x42, 0xb6, 0xb8, 0xe4, 0xa3, 0xd6, 0x22,
+	0x2e, 0xef, 0xba, 0xf3, 0xa7, 0xf0, 0xb2, 0x55, 0xe0, 0xdd, 0x3a, 0x2d, 0x1c, 0x6b, 0x5c, 0x38,
+	0x04, 0x2a, 0xd2, 0x7e, 0x30, 0x8f, 0xbd, 0x81, 0xf6, 0xec, 0x65, 0xda, 0x95, 0xf8, 0x97, 0x72,
+	0xe3, 0x23, 0xd4, 0x88, 0xfb, 0x19, 0x24, 0x52, 0xef, 0x58, 0x97, 0x7f, 0x0e, 0x93, 0x49, 0xf6,
+	0x36, 0x80, 0x19, 0x96, 0x4a, 0xdc, 0xcf, 0x26, 0x44, 0x17, 0x60, 0xe4, 0x55, 0x1d, 0xad, 0x4c,
+	0xf7, 0x50, 0x8d, 0x0d, 0x0d, 0x82, 0x9c, 0x09, 0x61, 0x5b, 0xb3, 0x63, 0x03, 0x17, 0xc4, 0x2f,
+	0x5d, 0xf0, 0x2a, 0xaa, 0x46, 0x81, 0xee, 0x44, 0xdd, 0xaf, 0x46, 0x01, 0x4e, 0x10, 0xca, 0x26,
+	0x93, 0x00, 0x92, 0x6d, 0xce, 0xcf, 0xf4, 0xed, 0xc9, 0x99, 0x5d, 0x1b, 0xe5, 0x63, 0x67, 0xbe,
+	0xe1, 0x32, 0xf9, 0x29, 0x02, 0xfc, 0x35, 0x6a, 0x1e, 0x8f, 0xe4, 0x28, 0x67, 0xc6, 0x25, 0xe4,
+	0x8f, 0x59, 0x9e, 0xf2, 0x5c, 0x4b, 0xd4, 0xf0, 0x9c, 0x0b, 0xa8, 0x79, 0x5e, 0xc4, 0xc7, 0xc6,
+	0xac, 0x32, 0xf8, 0x02, 0x8c, 0xf8, 0x08, 0x2d, 0x4b, 0x2e, 0x69, 0x7c, 0x38, 0xa4, 0x39, 0x13,
+	0xf6, 0xc2, 0xbb, 0x9e, 0x9f, 0x9b, 0x90, 0xf3, 0x07, 0xe5, 0xf3, 0x23, 0x69, 0xdc, 0x13, 0x3a,
+	0x98, 0xf8, 0xd3, 0x50, 0xf8, 0xa1, 0xd1, 0x46, 0x2f, 0x2b, 0x61, 0x2f, 0xbe, 0xdf, 0x52, 0x6b,
+	0x01, 0x3c, 0x36, 0xf0, 0xba, 0x00, 0xaa, 0x11, 0x40, 0x09, 0x03, 0x87, 0x43, 0x48, 0xdb, 0x0c,
+	0x8f, 0xbd, 0xa4, 0x05, 0xd8, 0xbb, 0xf2, 0x6b, 0xf7, 0x46, 0x15, 0xe5, 0x9b, 0x37, 0x8d, 0x7c,
+	0x7b, 0xfd, 0xd9, 0x4b, 0xa7, 0xf2, 0xe2, 0xa5, 0x53, 0xf9, 0xe3, 0xd7, 0xed, 0x05, 0x95, 0xe7,
+	0xfd, 0x5b, 0xdf, 0xe9, 0x05, 0x7a, 0x28, 0xa9, 0x1c, 0x09, 0x8c, 0xd1, 0xaa, 0x3a, 0xd0, 0x98,
+	0x1d, 0xb0, 0x34, 0x88, 0xd2, 0x70, 0xad, 0x32, 0x65, 0xfb, 0x2a, 0x0d, 0xb9, 0xb2, 0x59, 0x78,
+	0x0d, 0xad, 0x80, 0x6d, 0x2f, 0x0d, 0x58, 0xb0, 0x56, 0x05, 0xcb, 0x7e, 0xb9, 0x5e, 0xd6, 0x6a,
+	0xad, 0xfa, 0xb3, 0x9f, 0xdb, 0x15, 0x6f, 0xff, 0xf4, 0xac, 0x6d, 0xbd, 0x3e, 0x6b, 0x5b, 0xff,
+	0x9c, 0xb5, 0xad, 0xe7, 0xe7, 0xed, 0xca, 0xeb, 0xf3, 0x76, 0xe5, 0xcf, 0xf3, 0x76, 0xe5, 0xdb,
+	0xad, 0xa9, 0xba, 0x40, 0xc7, 0xed, 0x98, 0xf6, 0x45, 0x79, 0xe8, 0x3e, 0x35, 0xbf, 0x9e, 0x74,
+	0x85, 0xfd, 0x45, 0xbd, 0x53, 0x3f, 0xfb, 0x6f, 0x00, 0xfd, 0x5f, 0xd4, 0xe4, 0x5a, 0x09, 0x00,
+	0x00,
 }
 
 func (m *PoolAsset) Marshal() (dAtA []byte, err error) {
@@ -453,6 +586,62 @@ func (m *SmoothWeightChangeParams) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *LBPParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LBPParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LBPParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintBalancerPool(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SaleEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SaleEndTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintBalancerPool(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SaleStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SaleStartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintBalancerPool(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *BalancerPoolParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -473,6 +662,18 @@ func (m *BalancerPoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LbpParams != nil {
+		{
+			size, err := m.LbpParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBalancerPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.SmoothWeightChangeParams != nil {
 		{
 			size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
@@ -643,6 +844,26 @@ func (m *SmoothWeightChangeParams) Size() (n int) {
 	return n
 }
 
+func (m *LBPParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SaleStartTime)
+	n += 1 + l + sovBalancerPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.SaleEndTime)
+	n += 1 + l + sovBalancerPool(uint64(l))
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	return n
+}
+
 func (m *BalancerPoolParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -657,6 +878,10 @@ func (m *BalancerPoolParams) Size() (n int) {
 		l = m.SmoothWeightChangeParams.Size()
 		n += 1 + l + sovBalancerPool(uint64(l))
 	}
+	if m.LbpParams != nil {
+		l = m.LbpParams.Size()
+		n += 1 + l + sovBalancerPool(uint64(l))
+	}
 	return n
 }
 
@@ -999,6 +1224,174 @@ func (m *SmoothWeightChangeParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LBPParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBalancerPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LBPParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LBPParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaleStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SaleStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaleEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SaleEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBalancerPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BalancerPoolParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1132,6 +1525,42 @@ func (m *BalancerPoolParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LbpParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBalancerPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LbpParams == nil {
+				m.LbpParams = &LBPParams{}
+			}
+			if err := m.LbpParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBalancerPool(dAtA[iNdEx:])
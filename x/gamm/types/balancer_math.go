@@ -1,4 +1,4 @@
-package keeper
+package types
 
 import (
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -113,7 +113,7 @@ func calcPoolOutGivenSingleIn(
 	return newPoolSupply.Sub(poolSupply)
 }
 
-//tAi
+// tAi
 func calcSingleInGivenPoolOut(
 	tokenBalanceIn,
 	tokenWeightIn,
@@ -0,0 +1,290 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxBatchPricingRounds bounds the rounds spent pricing the exact out swaps of a batch.
+// Exact out swaps that still aren't priced after that are not filled.
+const maxBatchPricingRounds = 10
+
+// BatchExecution is the outcome of executing the swaps queued on a pool.
+type BatchExecution struct {
+	ClearingPrices []BatchClearingPrice
+	// The results of the queued swaps, in the order they were queued.
+	Swaps []BatchSwapResult
+	// The tokens to send to the pool account and out of it, and the swap fees
+	// to send out of it to the community pool.
+	PoolTokensIn  sdk.Coins
+	PoolTokensOut sdk.Coins
+	ProtocolFees  sdk.Coins
+	// The swap fees paid on the trades with the pool, including the protocol's share.
+	SwapFees sdk.Coins
+}
+
+type batchOrder struct {
+	swap     QueuedSwap
+	filled   bool
+	amountIn sdk.Int
+	// The amount received at the clearing price, rounded down.
+	amountOut sdk.Int
+}
+
+// ExecuteBatch executes the swaps queued on the pool, and applies the resulting trades to the pool.
+//
+// Swaps are cleared pair by pair, every swap in the same direction getting the same price.
+// Opposing swaps of a pair are first matched with each other at the spot price of the pool,
+// without swap fee. The remainder of the larger side is then swapped through the pool,
+// and its output is shared by all the swaps of that side, in proportion to their amount in.
+// Swaps whose limit isn't met at the clearing price are not filled, and the batch is cleared
+// again without them. The rounding errors are left in the pool.
+func ExecuteBatch(pool PoolI, swaps []QueuedSwap, protocolFeeShare sdk.Dec) (BatchExecution, error) {
+	execution := BatchExecution{
+		ClearingPrices: []BatchClearingPrice{},
+		PoolTokensIn:   sdk.Coins{},
+		PoolTokensOut:  sdk.Coins{},
+		ProtocolFees:   sdk.Coins{},
+		SwapFees:       sdk.Coins{},
+	}
+
+	orders := make([]*batchOrder, len(swaps))
+	pairs := make(map[[2]string][]*batchOrder)
+	for i, swap := range swaps {
+		orders[i] = &batchOrder{swap: swap}
+		if _, err := pool.GetPoolAssets(swap.TokenIn.Denom, swap.TokenOut.Denom); err != nil || swap.TokenIn.Denom == swap.TokenOut.Denom {
+			continue
+		}
+
+		pair := [2]string{swap.TokenIn.Denom, swap.TokenOut.Denom}
+		if pair[0] > pair[1] {
+			pair[0], pair[1] = pair[1], pair[0]
+		}
+		pairs[pair] = append(pairs[pair], orders[i])
+	}
+
+	sortedPairs := make([][2]string, 0, len(pairs))
+	for pair := range pairs {
+		sortedPairs = append(sortedPairs, pair)
+	}
+	sort.Slice(sortedPairs, func(i, j int) bool {
+		if sortedPairs[i][0] != sortedPairs[j][0] {
+			return sortedPairs[i][0] < sortedPairs[j][0]
+		}
+		return sortedPairs[i][1] < sortedPairs[j][1]
+	})
+
+	// Every pair is cleared against the pool as left by the previous pairs.
+	for _, pair := range sortedPairs {
+		err := execution.executePair(pool, pair[0], pair[1], pairs[pair], protocolFeeShare)
+		if err != nil {
+			return BatchExecution{}, err
+		}
+	}
+
+	for _, order := range orders {
+		result := BatchSwapResult{
+			QueuedSwapId: order.swap.Id,
+			Sender:       order.swap.Sender,
+			Filled:       order.filled,
+			TokenIn:      sdk.NewCoin(order.swap.TokenIn.Denom, sdk.ZeroInt()),
+			TokenOut:     sdk.NewCoin(order.swap.TokenOut.Denom, sdk.ZeroInt()),
+			Refund:       order.swap.TokenIn,
+		}
+		if order.filled {
+			result.TokenIn.Amount = order.amountIn
+			result.TokenOut.Amount = order.amountOut
+			result.Refund.Amount = order.swap.TokenIn.Amount.Sub(order.amountIn)
+		}
+		execution.Swaps = append(execution.Swaps, result)
+	}
+
+	return execution, nil
+}
+
+// batchSettlement is the outcome of clearing the swaps of a pair, for given amounts in.
+type batchSettlement struct {
+	// The total amounts swapped in, and received by the swaps, by denom in.
+	totalIn  map[string]sdk.Int
+	totalOut map[string]sdk.Int
+	// The trade with the pool, if any.
+	poolTokenIn  sdk.Coin
+	poolTokenOut sdk.Coin
+}
+
+// settlePair matches the swaps from denomA to denomB with the swaps from denomB to denomA at the
+// spot price of the pool, and swaps the remainder through the pool.
+func settlePair(pool PoolI, denomA, denomB string, totalA, totalB sdk.Int) (batchSettlement, error) {
+	settlement := batchSettlement{
+		totalIn:  map[string]sdk.Int{denomA: totalA, denomB: totalB},
+		totalOut: map[string]sdk.Int{denomA: sdk.ZeroInt(), denomB: sdk.ZeroInt()},
+	}
+
+	// The price of denomA in units of denomB.
+	spotPrice, err := pool.SpotPrice(denomB, denomA, sdk.ZeroDec())
+	if err != nil {
+		return batchSettlement{}, err
+	}
+
+	// The larger side, in value, swaps its remainder through the pool.
+	in, out := denomA, denomB
+	matchedIn := totalB.ToDec().Quo(spotPrice).TruncateInt()
+	if totalA.ToDec().Mul(spotPrice).LT(totalB.ToDec()) {
+		in, out = denomB, denomA
+		matchedIn = totalA.ToDec().Mul(spotPrice).TruncateInt()
+	}
+	if matchedIn.GT(settlement.totalIn[in]) {
+		matchedIn = settlement.totalIn[in]
+	}
+
+	poolTokenIn := sdk.NewCoin(in, settlement.totalIn[in].Sub(matchedIn))
+	poolTokenOut := sdk.NewCoin(out, sdk.ZeroInt())
+	if poolTokenIn.IsPositive() {
+		poolTokenOut, err = pool.SwapOutGivenIn(poolTokenIn, out, pool.GetPoolSwapFee())
+		if err != nil {
+			return batchSettlement{}, err
+		}
+	}
+
+	settlement.totalOut[out] = matchedIn
+	settlement.totalOut[in] = settlement.totalIn[out].Add(poolTokenOut.Amount)
+	settlement.poolTokenIn = poolTokenIn
+	settlement.poolTokenOut = poolTokenOut
+	return settlement, nil
+}
+
+// executePair clears the swaps of a pair, and applies the trade with the pool and the rounding errors to the pool.
+func (execution *BatchExecution) executePair(pool PoolI, denomA, denomB string, orders []*batchOrder, protocolFeeShare sdk.Dec) error {
+	for _, order := range orders {
+		order.filled = true
+		order.amountIn = order.swap.TokenIn.Amount
+		if order.swap.ExactOut {
+			// The amount in of exact out swaps is first estimated from swapping them alone.
+			estimate, err := pool.SwapInGivenOut(order.swap.TokenOut, order.swap.TokenIn.Denom, pool.GetPoolSwapFee())
+			if err == nil && estimate.Amount.LT(order.amountIn) {
+				order.amountIn = estimate.Amount
+			}
+		}
+	}
+
+	var settlement batchSettlement
+	for round := 0; ; {
+		totals := map[string]sdk.Int{denomA: sdk.ZeroInt(), denomB: sdk.ZeroInt()}
+		for _, order := range orders {
+			if order.filled {
+				totals[order.swap.TokenIn.Denom] = totals[order.swap.TokenIn.Denom].Add(order.amountIn)
+			}
+		}
+		if totals[denomA].IsZero() && totals[denomB].IsZero() {
+			return nil
+		}
+
+		var err error
+		settlement, err = settlePair(pool, denomA, denomB, totals[denomA], totals[denomB])
+		if err != nil {
+			return err
+		}
+
+		dropped, unpriced := false, false
+		for _, order := range orders {
+			if !order.filled {
+				continue
+			}
+			totalIn := settlement.totalIn[order.swap.TokenIn.Denom]
+			totalOut := settlement.totalOut[order.swap.TokenIn.Denom]
+
+			if !order.swap.ExactOut {
+				order.amountOut = order.amountIn.Mul(totalOut).Quo(totalIn)
+				if !order.amountOut.IsPositive() || order.amountOut.LT(order.swap.TokenOut.Amount) {
+					order.filled, dropped = false, true
+				}
+				continue
+			}
+
+			// The amount in an exact out swap needs at the clearing price, rounded up.
+			if !totalOut.IsPositive() {
+				order.filled, dropped = false, true
+				continue
+			}
+			required := order.swap.TokenOut.Amount.ToDec().MulInt(totalIn).QuoInt(totalOut).Ceil().TruncateInt()
+			switch {
+			case required.GT(order.swap.TokenIn.Amount):
+				order.filled, dropped = false, true
+			case required.GT(order.amountIn) && round >= maxBatchPricingRounds:
+				order.filled, dropped = false, true
+			case required.GT(order.amountIn) || round == 0:
+				order.amountIn = required
+				unpriced = true
+			default:
+				order.amountOut = order.swap.TokenOut.Amount
+			}
+		}
+
+		if dropped {
+			round = 0
+			continue
+		}
+		if !unpriced {
+			break
+		}
+		round++
+	}
+
+	// Every swap gets at most its share of the total out of its side, and the rest stays in the pool.
+	remainders := map[string]sdk.Int{
+		denomA: settlement.totalOut[denomB],
+		denomB: settlement.totalOut[denomA],
+	}
+	for _, order := range orders {
+		if order.filled {
+			remainders[order.swap.TokenOut.Denom] = remainders[order.swap.TokenOut.Denom].Sub(order.amountOut)
+		}
+	}
+
+	if settlement.poolTokenIn.IsPositive() {
+		protocolFee, err := pool.ApplySwap(settlement.poolTokenIn, settlement.poolTokenOut, pool.GetPoolSwapFee(), protocolFeeShare)
+		if err != nil {
+			return err
+		}
+		execution.PoolTokensIn = execution.PoolTokensIn.Add(settlement.poolTokenIn)
+		execution.PoolTokensOut = execution.PoolTokensOut.Add(settlement.poolTokenOut)
+		execution.ProtocolFees = execution.ProtocolFees.Add(protocolFee)
+		swapFee := settlement.poolTokenIn.Amount.ToDec().Mul(pool.GetPoolSwapFee()).TruncateInt()
+		execution.SwapFees = execution.SwapFees.Add(sdk.NewCoins(sdk.NewCoin(settlement.poolTokenIn.Denom, swapFee))...)
+	}
+
+	for _, denom := range []string{denomA, denomB} {
+		remainder := sdk.NewCoin(denom, remainders[denom])
+		if !remainder.IsPositive() {
+			continue
+		}
+		balance, err := pool.GetTokenBalance(denom)
+		if err != nil {
+			return err
+		}
+		err = pool.UpdatePoolAssetBalance(sdk.NewCoin(denom, balance.Add(remainder.Amount)))
+		if err != nil {
+			return err
+		}
+		execution.PoolTokensIn = execution.PoolTokensIn.Add(remainder)
+	}
+
+	for _, denomIn := range []string{denomA, denomB} {
+		totalIn := settlement.totalIn[denomIn]
+		if !totalIn.IsPositive() {
+			continue
+		}
+		denomOut := denomA
+		if denomIn == denomA {
+			denomOut = denomB
+		}
+		execution.ClearingPrices = append(execution.ClearingPrices, BatchClearingPrice{
+			TokenInDenom:  denomIn,
+			TokenOutDenom: denomOut,
+			Price:         settlement.totalOut[denomIn].ToDec().QuoInt(totalIn),
+		})
+	}
+
+	return nil
+}
@@ -5,21 +5,43 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/gamm interfaces and concrete types
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&BalancerPool{}, "osmosis/gamm/Pool", nil)
+	cdc.RegisterConcrete(&StableswapPool{}, "osmosis/gamm/StableswapPool", nil)
+	cdc.RegisterConcrete(&ConcentratedPool{}, "osmosis/gamm/ConcentratedPool", nil)
 	cdc.RegisterConcrete(&MsgCreateBalancerPool{}, "osmosis/gamm/create-pool", nil)
+	cdc.RegisterConcrete(&MsgCreateStableswapPool{}, "osmosis/gamm/create-stableswap-pool", nil)
+	cdc.RegisterConcrete(&MsgCreateConcentratedPool{}, "osmosis/gamm/create-concentrated-pool", nil)
+	cdc.RegisterConcrete(&MsgCreatePosition{}, "osmosis/gamm/create-position", nil)
+	cdc.RegisterConcrete(&MsgWithdrawPosition{}, "osmosis/gamm/withdraw-position", nil)
+	cdc.RegisterConcrete(&MsgCollectFees{}, "osmosis/gamm/collect-fees", nil)
 	cdc.RegisterConcrete(&MsgJoinPool{}, "osmosis/gamm/join-pool", nil)
 	cdc.RegisterConcrete(&MsgExitPool{}, "osmosis/gamm/exit-pool", nil)
 	cdc.RegisterConcrete(&MsgSwapExactAmountIn{}, "osmosis/gamm/swap-exact-amount-in", nil)
+	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountIn{}, "osmosis/gamm/split-route-swap-exact-amount-in", nil)
 	cdc.RegisterConcrete(&MsgSwapExactAmountOut{}, "osmosis/gamm/swap-exact-amount-out", nil)
 	cdc.RegisterConcrete(&MsgJoinSwapExternAmountIn{}, "osmosis/gamm/join-swap-extern-amount-in", nil)
 	cdc.RegisterConcrete(&MsgJoinSwapShareAmountOut{}, "osmosis/gamm/join-swap-share-amount-out", nil)
 	cdc.RegisterConcrete(&MsgExitSwapExternAmountOut{}, "osmosis/gamm/exit-swap-extern-amount-out", nil)
 	cdc.RegisterConcrete(&MsgExitSwapShareAmountIn{}, "osmosis/gamm/exit-swap-share-amount-in", nil)
+	cdc.RegisterConcrete(&MsgSetPoolSwapFee{}, "osmosis/gamm/set-pool-swap-fee", nil)
+	cdc.RegisterConcrete(&MsgSetPoolExitFee{}, "osmosis/gamm/set-pool-exit-fee", nil)
+	cdc.RegisterConcrete(&MsgScheduleWeightChange{}, "osmosis/gamm/schedule-weight-change", nil)
+	cdc.RegisterConcrete(&MsgSetPoolBatchMode{}, "osmosis/gamm/set-pool-batch-mode", nil)
+	cdc.RegisterConcrete(&MsgPlaceLimitOrder{}, "osmosis/gamm/place-limit-order", nil)
+	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "osmosis/gamm/cancel-limit-order", nil)
+	cdc.RegisterConcrete(&MsgFlashSwap{}, "osmosis/gamm/flash-swap", nil)
+	cdc.RegisterConcrete(&MsgFinalizeLBP{}, "osmosis/gamm/finalize-lbp", nil)
+	cdc.RegisterConcrete(&MsgMigratePoolShares{}, "osmosis/gamm/migrate-pool-shares", nil)
+	cdc.RegisterConcrete(&MsgSwapExactAmountInWithPriceImpact{}, "osmosis/gamm/swap-exact-amount-in-with-price-impact", nil)
+	cdc.RegisterConcrete(&MsgSwapExactAmountOutWithPriceImpact{}, "osmosis/gamm/swap-exact-amount-out-with-price-impact", nil)
+	cdc.RegisterConcrete(&SetPoolStatusProposal{}, "osmosis/SetPoolStatusProposal", nil)
+	cdc.RegisterConcrete(&MigratePoolProposal{}, "osmosis/MigratePoolProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -28,20 +50,46 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		"osmosis.gamm.v1beta1.Pool",
 		(*PoolI)(nil),
 		&BalancerPool{},
+		&StableswapPool{},
+		&ConcentratedPool{},
 	)
 
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgCreateBalancerPool{},
+		&MsgCreateStableswapPool{},
+		&MsgCreateConcentratedPool{},
+		&MsgCreatePosition{},
+		&MsgWithdrawPosition{},
+		&MsgCollectFees{},
 		&MsgJoinPool{},
 		&MsgExitPool{},
 		&MsgSwapExactAmountIn{},
+		&MsgSplitRouteSwapExactAmountIn{},
 		&MsgSwapExactAmountOut{},
 		&MsgJoinSwapExternAmountIn{},
 		&MsgJoinSwapShareAmountOut{},
 		&MsgExitSwapExternAmountOut{},
 		&MsgExitSwapShareAmountIn{},
+		&MsgSetPoolSwapFee{},
+		&MsgSetPoolExitFee{},
+		&MsgScheduleWeightChange{},
+		&MsgSetPoolBatchMode{},
+		&MsgPlaceLimitOrder{},
+		&MsgCancelLimitOrder{},
+		&MsgFlashSwap{},
+		&MsgFinalizeLBP{},
+		&MsgMigratePoolShares{},
+		&MsgSwapExactAmountInWithPriceImpact{},
+		&MsgSwapExactAmountOutWithPriceImpact{},
 	)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&SetPoolStatusProposal{},
+		&MigratePoolProposal{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
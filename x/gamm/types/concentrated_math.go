@@ -0,0 +1,185 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MinTick and MaxTick bound the ticks of concentrated pools,
+	// which cover prices between 1.0001^-200000 (~2e-9) and 1.0001^200000 (~4.8e8).
+	MinTick int64 = -200_000
+	MaxTick int64 = 200_000
+)
+
+// sqrtTickBase is the ratio between the square roots of the prices of two consecutive ticks.
+var sqrtTickBase = mustApproxSqrt(sdk.NewDecWithPrec(10001, 4))
+
+func mustApproxSqrt(d sdk.Dec) sdk.Dec {
+	sqrt, err := d.ApproxSqrt()
+	if err != nil {
+		panic(err)
+	}
+	return sqrt
+}
+
+// TickToSqrtPrice returns the square root of the price at tick, sqrt(1.0001^tick).
+func TickToSqrtPrice(tick int64) sdk.Dec {
+	if tick < 0 {
+		return sdk.OneDec().Quo(sqrtTickBase.Power(uint64(-tick)))
+	}
+	return sqrtTickBase.Power(uint64(tick))
+}
+
+// SqrtPriceToTick returns the largest tick whose square root price is lower than or equal to sqrtPrice,
+// clamped to [MinTick, MaxTick].
+func SqrtPriceToTick(sqrtPrice sdk.Dec) int64 {
+	low, high := MinTick, MaxTick
+	for low < high {
+		mid := low + (high-low+1)/2
+		if TickToSqrtPrice(mid).LTE(sqrtPrice) {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+	return low
+}
+
+// calcAmount0Delta returns the amount of token0 between the square root prices sqrtPriceA and sqrtPriceB
+// for the liquidity: liquidity * (sqrtPriceB - sqrtPriceA) / (sqrtPriceA * sqrtPriceB).
+func calcAmount0Delta(sqrtPriceA, sqrtPriceB, liquidity sdk.Dec) sdk.Dec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	return liquidity.Mul(sqrtPriceB.Sub(sqrtPriceA)).Quo(sqrtPriceB).Quo(sqrtPriceA)
+}
+
+// calcAmount1Delta returns the amount of token1 between the square root prices sqrtPriceA and sqrtPriceB
+// for the liquidity: liquidity * (sqrtPriceB - sqrtPriceA).
+func calcAmount1Delta(sqrtPriceA, sqrtPriceB, liquidity sdk.Dec) sdk.Dec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	return liquidity.Mul(sqrtPriceB.Sub(sqrtPriceA))
+}
+
+// calcNextSqrtPriceFromInput returns the square root price after swapping amountIn into a range of
+// the liquidity, starting at sqrtPrice. The liquidity must be positive.
+func calcNextSqrtPriceFromInput(sqrtPrice, liquidity, amountIn sdk.Dec, zeroForOne bool) sdk.Dec {
+	if zeroForOne {
+		// L * sqrtP / (L + amountIn * sqrtP)
+		return liquidity.Mul(sqrtPrice).Quo(liquidity.Add(amountIn.Mul(sqrtPrice)))
+	}
+	// sqrtP + amountIn / L
+	return sqrtPrice.Add(amountIn.Quo(liquidity))
+}
+
+// calcNextSqrtPriceFromOutput returns the square root price after swapping amountOut out of a range of
+// the liquidity, starting at sqrtPrice. The liquidity must be positive and hold more than amountOut.
+func calcNextSqrtPriceFromOutput(sqrtPrice, liquidity, amountOut sdk.Dec, zeroForOne bool) sdk.Dec {
+	if zeroForOne {
+		// sqrtP - amountOut / L
+		return sqrtPrice.Sub(amountOut.Quo(liquidity))
+	}
+	// L * sqrtP / (L - amountOut * sqrtP)
+	return liquidity.Mul(sqrtPrice).Quo(liquidity.Sub(amountOut.Mul(sqrtPrice)))
+}
+
+// computeSwapStep swaps within a range of constant liquidity, from sqrtPriceCurrent towards sqrtPriceTarget.
+// amountRemaining is the amount left to swap in, including fees, if exactIn, or the amount left to receive otherwise.
+// It returns the square root price reached, the amounts swapped in and out, and the fee paid on top of amountIn.
+func computeSwapStep(
+	sqrtPriceCurrent, sqrtPriceTarget, liquidity, amountRemaining, swapFee sdk.Dec,
+	exactIn bool,
+) (sqrtPriceNext, amountIn, amountOut, feeAmount sdk.Dec) {
+	zeroForOne := sqrtPriceCurrent.GTE(sqrtPriceTarget)
+
+	var reachesTarget bool
+	if exactIn {
+		amountRemainingLessFee := amountRemaining.Mul(sdk.OneDec().Sub(swapFee))
+		if zeroForOne {
+			amountIn = calcAmount0Delta(sqrtPriceTarget, sqrtPriceCurrent, liquidity)
+		} else {
+			amountIn = calcAmount1Delta(sqrtPriceCurrent, sqrtPriceTarget, liquidity)
+		}
+
+		reachesTarget = amountRemainingLessFee.GTE(amountIn)
+		if !reachesTarget {
+			sqrtPriceNext = calcNextSqrtPriceFromInput(sqrtPriceCurrent, liquidity, amountRemainingLessFee, zeroForOne)
+		}
+	} else {
+		if zeroForOne {
+			amountOut = calcAmount1Delta(sqrtPriceTarget, sqrtPriceCurrent, liquidity)
+		} else {
+			amountOut = calcAmount0Delta(sqrtPriceCurrent, sqrtPriceTarget, liquidity)
+		}
+
+		reachesTarget = amountRemaining.GTE(amountOut)
+		if !reachesTarget {
+			sqrtPriceNext = calcNextSqrtPriceFromOutput(sqrtPriceCurrent, liquidity, amountRemaining, zeroForOne)
+		}
+	}
+
+	// The price never moves past the target, even with rounding errors.
+	if reachesTarget ||
+		(zeroForOne && sqrtPriceNext.LT(sqrtPriceTarget)) ||
+		(!zeroForOne && sqrtPriceNext.GT(sqrtPriceTarget)) {
+		sqrtPriceNext = sqrtPriceTarget
+	}
+
+	if zeroForOne {
+		amountIn = calcAmount0Delta(sqrtPriceNext, sqrtPriceCurrent, liquidity)
+		amountOut = calcAmount1Delta(sqrtPriceNext, sqrtPriceCurrent, liquidity)
+	} else {
+		amountIn = calcAmount1Delta(sqrtPriceCurrent, sqrtPriceNext, liquidity)
+		amountOut = calcAmount0Delta(sqrtPriceCurrent, sqrtPriceNext, liquidity)
+	}
+
+	if !reachesTarget {
+		// The step consumes the whole remaining amount.
+		if exactIn {
+			return sqrtPriceNext, amountIn, amountOut, amountRemaining.Sub(amountIn)
+		}
+		amountOut = amountRemaining
+	}
+
+	feeAmount = amountIn.Mul(swapFee).Quo(sdk.OneDec().Sub(swapFee))
+	return sqrtPriceNext, amountIn, amountOut, feeAmount
+}
+
+// calcLiquidityForAmounts returns the largest liquidity between the square root prices sqrtPriceLower and
+// sqrtPriceUpper that can be provided with amount0 and amount1, at the current square root price sqrtPrice.
+func calcLiquidityForAmounts(sqrtPrice, sqrtPriceLower, sqrtPriceUpper, amount0, amount1 sdk.Dec) sdk.Dec {
+	liquidity0 := func(sqrtPriceA sdk.Dec) sdk.Dec {
+		// amount0 * sqrtPriceA * sqrtPriceUpper / (sqrtPriceUpper - sqrtPriceA)
+		return amount0.Mul(sqrtPriceA).Mul(sqrtPriceUpper).Quo(sqrtPriceUpper.Sub(sqrtPriceA))
+	}
+	liquidity1 := func(sqrtPriceB sdk.Dec) sdk.Dec {
+		// amount1 / (sqrtPriceB - sqrtPriceLower)
+		return amount1.Quo(sqrtPriceB.Sub(sqrtPriceLower))
+	}
+
+	switch {
+	case sqrtPrice.LTE(sqrtPriceLower):
+		// The range is above the price, only token0 is provided.
+		return liquidity0(sqrtPriceLower)
+	case sqrtPrice.GTE(sqrtPriceUpper):
+		// The range is below the price, only token1 is provided.
+		return liquidity1(sqrtPriceUpper)
+	default:
+		return sdk.MinDec(liquidity0(sqrtPrice), liquidity1(sqrtPrice))
+	}
+}
+
+// calcAmountsForLiquidity returns the amounts of token0 and token1 held by the liquidity between the
+// square root prices sqrtPriceLower and sqrtPriceUpper, at the current square root price sqrtPrice.
+func calcAmountsForLiquidity(sqrtPrice, sqrtPriceLower, sqrtPriceUpper, liquidity sdk.Dec) (amount0, amount1 sdk.Dec) {
+	switch {
+	case sqrtPrice.LTE(sqrtPriceLower):
+		return calcAmount0Delta(sqrtPriceLower, sqrtPriceUpper, liquidity), sdk.ZeroDec()
+	case sqrtPrice.GTE(sqrtPriceUpper):
+		return sdk.ZeroDec(), calcAmount1Delta(sqrtPriceLower, sqrtPriceUpper, liquidity)
+	default:
+		return calcAmount0Delta(sqrtPrice, sqrtPriceUpper, liquidity), calcAmount1Delta(sqrtPriceLower, sqrtPrice, liquidity)
+	}
+}
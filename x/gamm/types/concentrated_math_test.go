@@ -0,0 +1,97 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+var concentratedTestPrecision = sdk.NewDecWithPrec(1, 9)
+
+func TestTickToSqrtPrice(t *testing.T) {
+	require.Equal(t, sdk.OneDec(), TickToSqrtPrice(0))
+
+	// Squaring the square root price at a tick gives back 1.0001^tick.
+	price := TickToSqrtPrice(10000)
+	price = price.Mul(price)
+	require.True(
+		t,
+		sdk.NewDecWithPrec(10001, 4).Power(10000).Sub(price).Abs().LTE(concentratedTestPrecision),
+		"expected 1.0001^10000, got %s", price,
+	)
+
+	// Negative ticks are the inverse of positive ticks.
+	product := TickToSqrtPrice(-500).Mul(TickToSqrtPrice(500))
+	require.True(t, sdk.OneDec().Sub(product).Abs().LTE(concentratedTestPrecision))
+}
+
+func TestSqrtPriceToTick(t *testing.T) {
+	for _, tick := range []int64{MinTick, -23028, -1, 0, 1, 6931, MaxTick} {
+		require.Equal(t, tick, SqrtPriceToTick(TickToSqrtPrice(tick)))
+	}
+
+	// Prices between two ticks round down to the lower tick.
+	between := TickToSqrtPrice(100).Add(TickToSqrtPrice(101)).QuoInt64(2)
+	require.Equal(t, int64(100), SqrtPriceToTick(between))
+
+	// Out of range prices are clamped.
+	require.Equal(t, MaxTick, SqrtPriceToTick(sdk.NewDec(1000000)))
+	require.Equal(t, MinTick, SqrtPriceToTick(sdk.SmallestDec()))
+}
+
+func TestComputeSwapStep(t *testing.T) {
+	liquidity := sdk.NewDec(1000000)
+	sqrtPriceCurrent := sdk.OneDec()
+	swapFee := sdk.NewDecWithPrec(3, 3)
+
+	// A small exact in swap of token1 stays within the range and consumes the whole amount.
+	target := TickToSqrtPrice(1000)
+	next, amountIn, amountOut, feeAmount := computeSwapStep(sqrtPriceCurrent, target, liquidity, sdk.NewDec(1000), swapFee, true)
+	require.True(t, next.GT(sqrtPriceCurrent) && next.LT(target))
+	require.Equal(t, sdk.NewDec(1000), amountIn.Add(feeAmount))
+	require.True(t, amountOut.LT(amountIn))
+
+	// A large exact in swap stops at the target.
+	next, amountIn, _, feeAmount = computeSwapStep(sqrtPriceCurrent, target, liquidity, sdk.NewDec(1000000), swapFee, true)
+	require.Equal(t, target, next)
+	require.True(t, amountIn.Add(feeAmount).LT(sdk.NewDec(1000000)))
+
+	// An exact out swap of token1 gives out exactly the requested amount.
+	target = TickToSqrtPrice(-1000)
+	next, amountIn, amountOut, feeAmount = computeSwapStep(sqrtPriceCurrent, target, liquidity, sdk.NewDec(1000), swapFee, false)
+	require.True(t, next.LT(sqrtPriceCurrent) && next.GT(target))
+	require.Equal(t, sdk.NewDec(1000), amountOut)
+	require.True(t, amountIn.GT(amountOut))
+	require.True(t, feeAmount.IsPositive())
+}
+
+func TestCalcLiquidityForAmounts(t *testing.T) {
+	sqrtPriceLower, sqrtPriceUpper := TickToSqrtPrice(-1000), TickToSqrtPrice(1000)
+	amount0, amount1 := sdk.NewDec(1000000), sdk.NewDec(1000000)
+
+	// In range, the amounts for the liquidity are bounded by the amounts provided.
+	liquidity := calcLiquidityForAmounts(sdk.OneDec(), sqrtPriceLower, sqrtPriceUpper, amount0, amount1)
+	require.True(t, liquidity.IsPositive())
+	used0, used1 := calcAmountsForLiquidity(sdk.OneDec(), sqrtPriceLower, sqrtPriceUpper, liquidity)
+	require.True(t, used0.LTE(amount0.Add(concentratedTestPrecision)))
+	require.True(t, used1.LTE(amount1.Add(concentratedTestPrecision)))
+	require.True(t, amount0.Sub(used0).Abs().LTE(concentratedTestPrecision) || amount1.Sub(used1).Abs().LTE(concentratedTestPrecision))
+
+	// Below the range, only token0 is provided.
+	below := TickToSqrtPrice(-2000)
+	liquidity = calcLiquidityForAmounts(below, sqrtPriceLower, sqrtPriceUpper, amount0, sdk.ZeroDec())
+	require.True(t, liquidity.IsPositive())
+	used0, used1 = calcAmountsForLiquidity(below, sqrtPriceLower, sqrtPriceUpper, liquidity)
+	require.True(t, amount0.Sub(used0).Abs().LTE(concentratedTestPrecision))
+	require.True(t, used1.IsZero())
+
+	// Above the range, only token1 is provided.
+	above := TickToSqrtPrice(2000)
+	liquidity = calcLiquidityForAmounts(above, sqrtPriceLower, sqrtPriceUpper, sdk.ZeroDec(), amount1)
+	require.True(t, liquidity.IsPositive())
+	used0, used1 = calcAmountsForLiquidity(above, sqrtPriceLower, sqrtPriceUpper, liquidity)
+	require.True(t, used0.IsZero())
+	require.True(t, amount1.Sub(used1).Abs().LTE(concentratedTestPrecision))
+}
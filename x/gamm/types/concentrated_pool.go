@@ -0,0 +1,787 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ConcentratedPool is a two asset pool where liquidity is provided in price
+// ranges. The price of token0, in units of token1, at tick i is 1.0001^i.
+// Within the range [P_a, P_b] between two initialized ticks, the pool
+// follows the constant product curve of its active liquidity L:
+//
+//	(x + L / sqrt(P_b)) * (y + L * sqrt(P_a)) = L^2
+//
+// Liquidity is owned by positions, rather than by fungible pool shares.
+// The initialized ticks are stored apart from the pool, and read through the tick store
+// set by the keeper when loading the pool. The ticks changed since are kept with the pool
+// until the keeper stores it.
+type ConcentratedPool struct {
+	Address    string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Id         uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	PoolParams ConcentratedPoolParams `protobuf:"bytes,3,opt,name=poolParams,proto3" json:"poolParams" yaml:"concentrated_pool_params"`
+	// This string specifies who will govern the pool in the future.
+	// It has the same format as BalancerPool.future_pool_governor.
+	FuturePoolGovernor string `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
+	// balance of the pool in the first asset, by denomination
+	Token0 sdk.Coin `protobuf:"bytes,5,opt,name=token0,proto3" json:"token0" yaml:"token0"`
+	// balance of the pool in the second asset, by denomination
+	Token1 sdk.Coin `protobuf:"bytes,6,opt,name=token1,proto3" json:"token1" yaml:"token1"`
+	// the bounds of positions must be multiples of the tick spacing
+	TickSpacing      uint64  `protobuf:"varint,7,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty" yaml:"tick_spacing"`
+	CurrentSqrtPrice sdk.Dec `protobuf:"bytes,8,opt,name=current_sqrt_price,json=currentSqrtPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_sqrt_price" yaml:"current_sqrt_price"`
+	CurrentTick      int64   `protobuf:"varint,9,opt,name=current_tick,json=currentTick,proto3" json:"current_tick,omitempty" yaml:"current_tick"`
+	// liquidity of the positions in range of the current tick
+	Liquidity sdk.Dec `protobuf:"bytes,10,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
+	// swap fees collected per unit of liquidity, in each asset
+	FeeGrowthGlobal0 sdk.Dec `protobuf:"bytes,11,opt,name=fee_growth_global0,json=feeGrowthGlobal0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_global0" yaml:"fee_growth_global0"`
+	FeeGrowthGlobal1 sdk.Dec `protobuf:"bytes,12,opt,name=fee_growth_global1,json=feeGrowthGlobal1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_global1" yaml:"fee_growth_global1"`
+
+	// ticks reads the ticks of the pool as of when it was loaded.
+	ticks TickStore
+	// tickChanges holds the ticks changed since, by tick index, with nil for the removed ticks.
+	tickChanges map[int64]*TickInfo
+}
+
+// TickStore reads the initialized ticks of a concentrated pool.
+type TickStore interface {
+	// GetTick returns the initialized tick, if tick is one.
+	GetTick(tick int64) (TickInfo, bool)
+	// NextInitializedTick returns the highest initialized tick lower than or equal to tick if lte,
+	// or else the lowest initialized tick greater than tick, if there is one.
+	NextInitializedTick(tick int64, lte bool) (TickInfo, bool)
+}
+
+// ConcentratedAssetWeight is the internal weight given to both assets of a concentrated pool.
+// Like for stableswap pools, it only exists to satisfy the PoolI weight accessors.
+var ConcentratedAssetWeight = sdk.NewInt(GuaranteedWeightPrecision)
+
+// NewConcentratedPool returns a concentrated pool without liquidity, with the provided parameters,
+// at the price initialPrice of denom0 in units of denom1.
+// Invariants that are assumed to be satisfied and not checked:
+// * FutureGovernor is valid
+// * poolID doesn't already exist
+func NewConcentratedPool(poolId uint64, concentratedPoolParams ConcentratedPoolParams, denom0, denom1 string, tickSpacing uint64, initialPrice sdk.Dec, futureGovernor string) (PoolI, error) {
+	err := concentratedPoolParams.Validate()
+	if err != nil {
+		return &ConcentratedPool{}, err
+	}
+
+	err = ValidateConcentratedPoolDenoms(denom0, denom1)
+	if err != nil {
+		return &ConcentratedPool{}, err
+	}
+
+	err = ValidateTickSpacing(tickSpacing)
+	if err != nil {
+		return &ConcentratedPool{}, err
+	}
+
+	sqrtPrice, err := initialSqrtPrice(initialPrice)
+	if err != nil {
+		return &ConcentratedPool{}, err
+	}
+
+	return &ConcentratedPool{
+		Address:            NewPoolAddress(poolId).String(),
+		Id:                 poolId,
+		PoolParams:         concentratedPoolParams,
+		FuturePoolGovernor: futureGovernor,
+		Token0:             sdk.NewCoin(denom0, sdk.ZeroInt()),
+		Token1:             sdk.NewCoin(denom1, sdk.ZeroInt()),
+		TickSpacing:        tickSpacing,
+		CurrentSqrtPrice:   sqrtPrice,
+		CurrentTick:        SqrtPriceToTick(sqrtPrice),
+		Liquidity:          sdk.ZeroDec(),
+		FeeGrowthGlobal0:   sdk.ZeroDec(),
+		FeeGrowthGlobal1:   sdk.ZeroDec(),
+	}, nil
+}
+
+func (params ConcentratedPoolParams) Validate() error {
+	return ValidateSwapFee(params.SwapFee)
+}
+
+// ValidateConcentratedPoolDenoms checks that the denoms are valid, and that denom0 sorts before denom1.
+func ValidateConcentratedPoolDenoms(denom0, denom1 string) error {
+	if err := sdk.ValidateDenom(denom0); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(denom1); err != nil {
+		return err
+	}
+	if denom0 >= denom1 {
+		return fmt.Errorf("denom0 (%s) should sort before denom1 (%s)", denom0, denom1)
+	}
+	return nil
+}
+
+// ValidateTickSpacing checks that the tick spacing is within [1, MaxTick].
+func ValidateTickSpacing(tickSpacing uint64) error {
+	if tickSpacing == 0 || tickSpacing > uint64(MaxTick) {
+		return sdkerrors.Wrapf(ErrInvalidTickSpacing, "tick spacing should be between 1 and %d", MaxTick)
+	}
+	return nil
+}
+
+// initialSqrtPrice returns the square root of price, which has to be within the prices of MinTick and MaxTick.
+func initialSqrtPrice(price sdk.Dec) (sdk.Dec, error) {
+	if price.IsNil() || !price.IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrInvalidSqrtPrice, "price should be positive")
+	}
+
+	sqrtPrice, err := price.ApproxSqrt()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if sqrtPrice.LT(TickToSqrtPrice(MinTick)) || sqrtPrice.GT(TickToSqrtPrice(MaxTick)) {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrInvalidSqrtPrice, "price %s is out of the range of the ticks", price)
+	}
+
+	return sqrtPrice, nil
+}
+
+// ValidatePositionTicks checks that the bounds of a position are ordered multiples of the tick spacing,
+// within [MinTick, MaxTick].
+func (pa ConcentratedPool) ValidatePositionTicks(lowerTick, upperTick int64) error {
+	if lowerTick >= upperTick {
+		return sdkerrors.Wrapf(ErrInvalidTick, "lower tick %d should be lesser than upper tick %d", lowerTick, upperTick)
+	}
+	if lowerTick < MinTick || upperTick > MaxTick {
+		return sdkerrors.Wrapf(ErrInvalidTick, "ticks should be between %d and %d", MinTick, MaxTick)
+	}
+	spacing := int64(pa.TickSpacing)
+	if lowerTick%spacing != 0 || upperTick%spacing != 0 {
+		return sdkerrors.Wrapf(ErrInvalidTick, "ticks should be multiples of the tick spacing %d", spacing)
+	}
+	return nil
+}
+
+// GetAddress returns the address of a pool.
+// If the pool address is not bech32 valid, it returns an empty address.
+func (pa ConcentratedPool) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(pa.Address)
+	if err != nil {
+		panic(fmt.Sprintf("could not bech32 decode address of pool with id: %d", pa.GetId()))
+	}
+	return addr
+}
+
+func (pa ConcentratedPool) GetId() uint64 {
+	return pa.Id
+}
+
+func (pa ConcentratedPool) GetPoolSwapFee() sdk.Dec {
+	return pa.PoolParams.SwapFee
+}
+
+// GetPoolExitFee returns zero, positions are withdrawn without fee.
+func (pa ConcentratedPool) GetPoolExitFee() sdk.Dec {
+	return sdk.ZeroDec()
+}
+
+func (pa ConcentratedPool) GetPoolParams() ConcentratedPoolParams {
+	return pa.PoolParams
+}
+
+func (pa ConcentratedPool) GetTotalWeight() sdk.Int {
+	return ConcentratedAssetWeight.MulRaw(2)
+}
+
+// GetTotalShares returns zero shares, the liquidity of concentrated pools is owned by positions.
+func (pa ConcentratedPool) GetTotalShares() sdk.Coin {
+	return sdk.NewCoin(GetPoolShareDenom(pa.Id), sdk.ZeroInt())
+}
+
+// AddTotalShares is a no-op, concentrated pools have no shares.
+func (pa *ConcentratedPool) AddTotalShares(amt sdk.Int) {}
+
+// SubTotalShares is a no-op, concentrated pools have no shares.
+func (pa *ConcentratedPool) SubTotalShares(amt sdk.Int) {}
+
+func (pa ConcentratedPool) GetPoolAsset(denom string) (PoolAsset, error) {
+	switch denom {
+	case pa.Token0.Denom:
+		return PoolAsset{Token: pa.Token0, Weight: ConcentratedAssetWeight}, nil
+	case pa.Token1.Denom:
+		return PoolAsset{Token: pa.Token1, Weight: ConcentratedAssetWeight}, nil
+	default:
+		return PoolAsset{}, fmt.Errorf("can't find the PoolAsset (%s)", denom)
+	}
+}
+
+// UpdatePoolAssetBalance updates the balance of one of the pool's tokens.
+// Unlike other pools, the balance can be zero when the price is out of the range of every position.
+func (pa *ConcentratedPool) UpdatePoolAssetBalance(coin sdk.Coin) error {
+	if coin.Amount.IsNegative() {
+		return fmt.Errorf("can't set the pool's balance of a token to be negative")
+	}
+
+	switch coin.Denom {
+	case pa.Token0.Denom:
+		pa.Token0 = coin
+	case pa.Token1.Denom:
+		pa.Token1 = coin
+	default:
+		return fmt.Errorf("can't find the PoolAsset (%s)", coin.Denom)
+	}
+	return nil
+}
+
+func (pa *ConcentratedPool) UpdatePoolAssetBalances(coins sdk.Coins) error {
+	for _, coin := range coins {
+		err := pa.UpdatePoolAssetBalance(coin)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (pa ConcentratedPool) GetPoolAssets(denoms ...string) ([]PoolAsset, error) {
+	result := make([]PoolAsset, 0, len(denoms))
+
+	for _, denom := range denoms {
+		PoolAsset, err := pa.GetPoolAsset(denom)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, PoolAsset)
+	}
+
+	return result, nil
+}
+
+func (pa ConcentratedPool) GetAllPoolAssets() []PoolAsset {
+	return []PoolAsset{
+		{Token: pa.Token0, Weight: ConcentratedAssetWeight},
+		{Token: pa.Token1, Weight: ConcentratedAssetWeight},
+	}
+}
+
+// PokeTokenWeights is a no-op, concentrated pools have constant weights.
+func (pa *ConcentratedPool) PokeTokenWeights(blockTime time.Time) {}
+
+func (pa ConcentratedPool) GetTokenWeight(denom string) (sdk.Int, error) {
+	PoolAsset, err := pa.GetPoolAsset(denom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return PoolAsset.Weight, nil
+}
+
+func (pa ConcentratedPool) GetTokenBalance(denom string) (sdk.Int, error) {
+	PoolAsset, err := pa.GetPoolAsset(denom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return PoolAsset.Token.Amount, nil
+}
+
+func (pa ConcentratedPool) NumAssets() int {
+	return 2
+}
+
+func (pa ConcentratedPool) IsActive(curBlockTime time.Time) bool {
+	return true
+}
+
+func (pa ConcentratedPool) GetFuturePoolGovernor() string {
+	return pa.FuturePoolGovernor
+}
+
+func (pa *ConcentratedPool) SetPoolSwapFee(swapFee sdk.Dec) error {
+	if err := ValidateSwapFee(swapFee); err != nil {
+		return err
+	}
+
+	pa.PoolParams.SwapFee = swapFee
+	return nil
+}
+
+// SetPoolExitFee is not supported, concentrated pools have no exit fee.
+func (pa *ConcentratedPool) SetPoolExitFee(exitFee sdk.Dec) error {
+	return sdkerrors.Wrapf(ErrUnsupportedPoolOperation, "concentrated pool %d has no exit fee", pa.Id)
+}
+
+// ScheduleWeightChange is not supported, concentrated pools have no weights.
+func (pa *ConcentratedPool) ScheduleWeightChange(startTime time.Time, duration time.Duration, targetWeights []PoolAsset) error {
+	return sdkerrors.Wrapf(ErrUnsupportedPoolOperation, "concentrated pool %d has no weights to change", pa.Id)
+}
+
+// isZeroForOne returns whether swapping tokenInDenom for tokenOutDenom swaps token0 for token1.
+func (pa ConcentratedPool) isZeroForOne(tokenInDenom, tokenOutDenom string) (bool, error) {
+	switch {
+	case tokenInDenom == pa.Token0.Denom && tokenOutDenom == pa.Token1.Denom:
+		return true, nil
+	case tokenInDenom == pa.Token1.Denom && tokenOutDenom == pa.Token0.Denom:
+		return false, nil
+	default:
+		return false, fmt.Errorf("pool %d does not swap %s for %s", pa.Id, tokenInDenom, tokenOutDenom)
+	}
+}
+
+// SetTickStore sets the store the ticks of the pool are read from, and forgets the ticks changed so far.
+func (pa *ConcentratedPool) SetTickStore(ticks TickStore) {
+	pa.ticks = ticks
+	pa.tickChanges = nil
+}
+
+// TickChanges returns the ticks changed since the pool was loaded, sorted by tick index,
+// and the indexes of the ticks removed.
+func (pa ConcentratedPool) TickChanges() (updated []TickInfo, removed []int64) {
+	indexes := make([]int64, 0, len(pa.tickChanges))
+	for tick := range pa.tickChanges {
+		indexes = append(indexes, tick)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+	for _, tick := range indexes {
+		if info := pa.tickChanges[tick]; info != nil {
+			updated = append(updated, *info)
+		} else {
+			removed = append(removed, tick)
+		}
+	}
+	return updated, removed
+}
+
+// getTick returns the initialized tick, if tick is one.
+func (pa ConcentratedPool) getTick(tick int64) (TickInfo, bool) {
+	if info, changed := pa.tickChanges[tick]; changed {
+		if info == nil {
+			return TickInfo{}, false
+		}
+		return *info, true
+	}
+	if pa.ticks == nil {
+		return TickInfo{}, false
+	}
+	return pa.ticks.GetTick(tick)
+}
+
+func (pa *ConcentratedPool) setTick(info TickInfo) {
+	if pa.tickChanges == nil {
+		pa.tickChanges = make(map[int64]*TickInfo)
+	}
+	pa.tickChanges[info.TickIndex] = &info
+}
+
+func (pa *ConcentratedPool) removeTick(tick int64) {
+	if pa.tickChanges == nil {
+		pa.tickChanges = make(map[int64]*TickInfo)
+	}
+	pa.tickChanges[tick] = nil
+}
+
+// nextInitializedTick returns the next initialized tick the price reaches
+// when swapping in the given direction, if there is one.
+// Going down, it is the highest tick lower than or equal to the current tick.
+// Going up, it is the lowest tick greater than the current tick.
+func (pa ConcentratedPool) nextInitializedTick(zeroForOne bool) (TickInfo, bool) {
+	// closer returns whether tick is reached before other.
+	closer := func(tick, other int64) bool {
+		if zeroForOne {
+			return tick > other
+		}
+		return tick < other
+	}
+
+	// The next stored tick that isn't removed.
+	next, found := int64(0), false
+	if pa.ticks != nil {
+		from := pa.CurrentTick
+		for {
+			info, ok := pa.ticks.NextInitializedTick(from, zeroForOne)
+			if !ok {
+				break
+			}
+			if changed, isChanged := pa.tickChanges[info.TickIndex]; !isChanged || changed != nil {
+				next, found = info.TickIndex, true
+				break
+			}
+			from = info.TickIndex
+			if zeroForOne {
+				from--
+			}
+		}
+	}
+
+	// The ticks initialized since the pool was loaded can be closer.
+	for tick, info := range pa.tickChanges {
+		if info == nil || (zeroForOne && tick > pa.CurrentTick) || (!zeroForOne && tick <= pa.CurrentTick) {
+			continue
+		}
+		if !found || closer(tick, next) {
+			next, found = tick, true
+		}
+	}
+
+	if !found {
+		return TickInfo{}, false
+	}
+	return pa.getTick(next)
+}
+
+// crossTick moves the active liquidity and the fee growth outside of the tick
+// to the other side of the tick.
+func (pa *ConcentratedPool) crossTick(tick TickInfo, zeroForOne bool) {
+	tick.FeeGrowthOutside0 = pa.FeeGrowthGlobal0.Sub(tick.FeeGrowthOutside0)
+	tick.FeeGrowthOutside1 = pa.FeeGrowthGlobal1.Sub(tick.FeeGrowthOutside1)
+	pa.setTick(tick)
+
+	if zeroForOne {
+		pa.Liquidity = pa.Liquidity.Sub(tick.LiquidityNet)
+		pa.CurrentTick = tick.TickIndex - 1
+	} else {
+		pa.Liquidity = pa.Liquidity.Add(tick.LiquidityNet)
+		pa.CurrentTick = tick.TickIndex
+	}
+}
+
+// computeSwap returns the state of the pool after swapping tokenInDenom for tokenOutDenom, along with the
+// amounts swapped in, including fees, and out. amountSpecified is the amount swapped in if exactIn,
+// or the amount swapped out otherwise. The protocolFeeShare of the fees doesn't accrue to the positions,
+// and is returned as protocolFee. The balances of the returned pool are not updated, and the
+// receiver is not mutated.
+func (pa ConcentratedPool) computeSwap(
+	tokenInDenom, tokenOutDenom string, amountSpecified sdk.Dec, exactIn bool, swapFee, protocolFeeShare sdk.Dec,
+) (pool ConcentratedPool, amountIn, amountOut, protocolFee sdk.Dec, err error) {
+	zeroForOne, err := pa.isZeroForOne(tokenInDenom, tokenOutDenom)
+	if err != nil {
+		return ConcentratedPool{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	pool = pa
+	pool.tickChanges = make(map[int64]*TickInfo, len(pa.tickChanges))
+	for tick, info := range pa.tickChanges {
+		pool.tickChanges[tick] = info
+	}
+
+	amountRemaining := amountSpecified
+	amountIn, amountOut, protocolFee = sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()
+	for amountRemaining.IsPositive() {
+		nextTick, found := pool.nextInitializedTick(zeroForOne)
+		targetTick := MaxTick
+		switch {
+		case found:
+			targetTick = nextTick.TickIndex
+		case zeroForOne:
+			targetTick = MinTick
+		}
+		sqrtPriceTarget := TickToSqrtPrice(targetTick)
+
+		sqrtPriceNext, stepAmountIn, stepAmountOut, feeAmount := computeSwapStep(
+			pool.CurrentSqrtPrice, sqrtPriceTarget, pool.Liquidity, amountRemaining, swapFee, exactIn,
+		)
+
+		if exactIn {
+			amountRemaining = amountRemaining.Sub(stepAmountIn).Sub(feeAmount)
+		} else {
+			amountRemaining = amountRemaining.Sub(stepAmountOut)
+		}
+		amountIn = amountIn.Add(stepAmountIn).Add(feeAmount)
+		amountOut = amountOut.Add(stepAmountOut)
+
+		stepProtocolFee := feeAmount.Mul(protocolFeeShare)
+		protocolFee = protocolFee.Add(stepProtocolFee)
+		if pool.Liquidity.IsPositive() {
+			feeGrowth := feeAmount.Sub(stepProtocolFee).Quo(pool.Liquidity)
+			if zeroForOne {
+				pool.FeeGrowthGlobal0 = pool.FeeGrowthGlobal0.Add(feeGrowth)
+			} else {
+				pool.FeeGrowthGlobal1 = pool.FeeGrowthGlobal1.Add(feeGrowth)
+			}
+		}
+
+		pool.CurrentSqrtPrice = sqrtPriceNext
+		switch {
+		case sqrtPriceNext.Equal(sqrtPriceTarget) && found:
+			pool.crossTick(nextTick, zeroForOne)
+		case sqrtPriceNext.Equal(sqrtPriceTarget) && amountRemaining.IsPositive():
+			return ConcentratedPool{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, sdkerrors.Wrapf(ErrNotEnoughLiquidity,
+				"pool %d can't swap %s for %s", pa.Id, amountSpecified, tokenInDenom)
+		default:
+			pool.CurrentTick = SqrtPriceToTick(sqrtPriceNext)
+		}
+	}
+
+	return pool, amountIn, amountOut, protocolFee, nil
+}
+
+func (pa ConcentratedPool) SwapOutGivenIn(tokenIn sdk.Coin, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	_, _, amountOut, _, err := pa.computeSwap(tokenIn.Denom, tokenOutDenom, tokenIn.Amount.ToDec(), true, swapFee, sdk.ZeroDec())
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenOutAmount := amountOut.TruncateInt()
+	balance, err := pa.GetTokenBalance(tokenOutDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if tokenOutAmount.GT(balance) {
+		return sdk.Coin{}, sdkerrors.Wrapf(ErrTooManyTokensOut,
+			"can't get more tokens out than there are tokens in the pool")
+	}
+
+	return sdk.Coin{Denom: tokenOutDenom, Amount: tokenOutAmount}, nil
+}
+
+func (pa ConcentratedPool) SwapInGivenOut(tokenOut sdk.Coin, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	balance, err := pa.GetTokenBalance(tokenOut.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if tokenOut.Amount.GT(balance) {
+		return sdk.Coin{}, sdkerrors.Wrapf(ErrTooManyTokensOut,
+			"can't get more tokens out than there are tokens in the pool")
+	}
+
+	_, amountIn, _, _, err := pa.computeSwap(tokenInDenom, tokenOut.Denom, tokenOut.Amount.ToDec(), false, swapFee, sdk.ZeroDec())
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.Coin{Denom: tokenInDenom, Amount: amountIn.Ceil().TruncateInt()}, nil
+}
+
+// ApplySwap moves the price of the pool by swapping tokenOut out of it, and updates its balances.
+// tokenIn has to cover the amount the swap requires, up to rounding, so that the pool
+// always holds the tokens its positions are owed.
+func (pa *ConcentratedPool) ApplySwap(tokenIn sdk.Coin, tokenOut sdk.Coin, swapFee, protocolFeeShare sdk.Dec) (sdk.Coin, error) {
+	pool, amountIn, _, protocolFeeAmount, err := pa.computeSwap(
+		tokenIn.Denom, tokenOut.Denom, tokenOut.Amount.ToDec(), false, swapFee, protocolFeeShare,
+	)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if amountIn.TruncateInt().GT(tokenIn.Amount) {
+		return sdk.Coin{}, sdkerrors.Wrapf(ErrInvalidMathApprox, "swapping out %s requires more than %s", tokenOut, tokenIn)
+	}
+
+	balanceIn, err := pool.GetTokenBalance(tokenIn.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	balanceOut, err := pool.GetTokenBalance(tokenOut.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if balanceOut.LT(tokenOut.Amount) {
+		return sdk.Coin{}, sdkerrors.Wrapf(ErrTooManyTokensOut,
+			"can't get more tokens out than there are tokens in the pool")
+	}
+
+	// The protocol fee is rounded down, so that the pool keeps the rounding error.
+	protocolFee := sdk.NewCoin(tokenIn.Denom, protocolFeeAmount.TruncateInt())
+
+	*pa = pool
+	err = pa.UpdatePoolAssetBalances(sdk.Coins{
+		sdk.NewCoin(tokenIn.Denom, balanceIn.Add(tokenIn.Amount).Sub(protocolFee.Amount)),
+		sdk.NewCoin(tokenOut.Denom, balanceOut.Sub(tokenOut.Amount)),
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return protocolFee, nil
+}
+
+// SpotPrice returns the price of tokenOutDenom in terms of tokenInDenom at the current price
+// of the pool, including swapFee.
+func (pa ConcentratedPool) SpotPrice(tokenInDenom, tokenOutDenom string, swapFee sdk.Dec) (sdk.Dec, error) {
+	zeroForOne, err := pa.isZeroForOne(tokenInDenom, tokenOutDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// The price of token0 in units of token1.
+	price := pa.CurrentSqrtPrice.Mul(pa.CurrentSqrtPrice)
+	if zeroForOne {
+		price = sdk.OneDec().Quo(price)
+	}
+
+	return price.Quo(sdk.OneDec().Sub(swapFee)), nil
+}
+
+// JoinPoolCoins is not supported, liquidity is provided to concentrated pools with positions.
+func (pa ConcentratedPool) JoinPoolCoins(shareOutAmount sdk.Int) (sdk.Coins, error) {
+	return nil, pa.errShareOperation()
+}
+
+// JoinPoolShares is not supported, liquidity is provided to concentrated pools with positions.
+func (pa ConcentratedPool) JoinPoolShares(tokenIn sdk.Coin) (shareOutAmount sdk.Int, err error) {
+	return sdk.Int{}, pa.errShareOperation()
+}
+
+// JoinPoolTokenIn is not supported, liquidity is provided to concentrated pools with positions.
+func (pa ConcentratedPool) JoinPoolTokenIn(tokenInDenom string, shareOutAmount sdk.Int) (tokenIn sdk.Coin, err error) {
+	return sdk.Coin{}, pa.errShareOperation()
+}
+
+// ExitPoolCoins is not supported, liquidity is withdrawn from concentrated pools with positions.
+func (pa ConcentratedPool) ExitPoolCoins(shareInAmount sdk.Int) (sdk.Coins, error) {
+	return nil, pa.errShareOperation()
+}
+
+// ExitPoolTokenOut is not supported, liquidity is withdrawn from concentrated pools with positions.
+func (pa ConcentratedPool) ExitPoolTokenOut(tokenOutDenom string, shareInAmount sdk.Int) (tokenOut sdk.Coin, err error) {
+	return sdk.Coin{}, pa.errShareOperation()
+}
+
+// ExitPoolShares is not supported, liquidity is withdrawn from concentrated pools with positions.
+func (pa ConcentratedPool) ExitPoolShares(tokenOut sdk.Coin) (shareInAmount sdk.Int, err error) {
+	return sdk.Int{}, pa.errShareOperation()
+}
+
+func (pa ConcentratedPool) errShareOperation() error {
+	return sdkerrors.Wrapf(ErrUnsupportedPoolOperation,
+		"concentrated pool %d has no shares, liquidity is provided with positions", pa.Id)
+}
+
+// LiquidityForAmounts returns the largest liquidity between lowerTick and upperTick
+// that can be provided with amount0 and amount1 at the current price.
+func (pa ConcentratedPool) LiquidityForAmounts(lowerTick, upperTick int64, amount0, amount1 sdk.Int) sdk.Dec {
+	return calcLiquidityForAmounts(pa.CurrentSqrtPrice, TickToSqrtPrice(lowerTick), TickToSqrtPrice(upperTick),
+		amount0.ToDec(), amount1.ToDec())
+}
+
+// AmountsForLiquidity returns the amounts of token0 and token1 held by the liquidity
+// between lowerTick and upperTick at the current price.
+func (pa ConcentratedPool) AmountsForLiquidity(lowerTick, upperTick int64, liquidity sdk.Dec) (amount0, amount1 sdk.Dec) {
+	return calcAmountsForLiquidity(pa.CurrentSqrtPrice, TickToSqrtPrice(lowerTick), TickToSqrtPrice(upperTick), liquidity)
+}
+
+// getFeeGrowthOutside returns the fee growth outside of tick, or zero if it is not initialized.
+func (pa ConcentratedPool) getFeeGrowthOutside(tick int64) (feeGrowthOutside0, feeGrowthOutside1 sdk.Dec) {
+	info, found := pa.getTick(tick)
+	if !found {
+		return sdk.ZeroDec(), sdk.ZeroDec()
+	}
+	return info.FeeGrowthOutside0, info.FeeGrowthOutside1
+}
+
+// FeeGrowthInside returns the fees collected per unit of liquidity between lowerTick and upperTick,
+// up to a constant set when the ticks were initialized.
+func (pa ConcentratedPool) FeeGrowthInside(lowerTick, upperTick int64) (feeGrowthInside0, feeGrowthInside1 sdk.Dec) {
+	lowerOutside0, lowerOutside1 := pa.getFeeGrowthOutside(lowerTick)
+	upperOutside0, upperOutside1 := pa.getFeeGrowthOutside(upperTick)
+
+	inside := func(global, lowerOutside, upperOutside sdk.Dec) sdk.Dec {
+		below, above := lowerOutside, upperOutside
+		if pa.CurrentTick < lowerTick {
+			below = global.Sub(lowerOutside)
+		}
+		if pa.CurrentTick >= upperTick {
+			above = global.Sub(upperOutside)
+		}
+		return global.Sub(below).Sub(above)
+	}
+
+	return inside(pa.FeeGrowthGlobal0, lowerOutside0, upperOutside0),
+		inside(pa.FeeGrowthGlobal1, lowerOutside1, upperOutside1)
+}
+
+// initTick initializes tick if it is not initialized yet, and returns it.
+func (pa *ConcentratedPool) initTick(tick int64) TickInfo {
+	info, found := pa.getTick(tick)
+	if found {
+		return info
+	}
+
+	info = TickInfo{
+		TickIndex:         tick,
+		LiquidityGross:    sdk.ZeroDec(),
+		LiquidityNet:      sdk.ZeroDec(),
+		FeeGrowthOutside0: sdk.ZeroDec(),
+		FeeGrowthOutside1: sdk.ZeroDec(),
+		PoolId:            pa.Id,
+	}
+	// By convention, all the fees collected so far were collected below a tick at or below the current tick.
+	if tick <= pa.CurrentTick {
+		info.FeeGrowthOutside0 = pa.FeeGrowthGlobal0
+		info.FeeGrowthOutside1 = pa.FeeGrowthGlobal1
+	}
+	pa.setTick(info)
+	return info
+}
+
+// updateTick adds liquidityDelta to the liquidity bounded by tick.
+// The tick is removed when no liquidity is bounded by it anymore.
+func (pa *ConcentratedPool) updateTick(tick int64, liquidityDelta sdk.Dec, upper bool) error {
+	info := pa.initTick(tick)
+
+	info.LiquidityGross = info.LiquidityGross.Add(liquidityDelta)
+	if info.LiquidityGross.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidLiquidity, "tick %d has less liquidity than removed", tick)
+	}
+	if upper {
+		info.LiquidityNet = info.LiquidityNet.Sub(liquidityDelta)
+	} else {
+		info.LiquidityNet = info.LiquidityNet.Add(liquidityDelta)
+	}
+
+	if info.LiquidityGross.IsZero() {
+		pa.removeTick(tick)
+	} else {
+		pa.setTick(info)
+	}
+	return nil
+}
+
+// UpdatePosition adds liquidityDelta, which is negative when liquidity is removed, to the liquidity
+// between lowerTick and upperTick. It returns the fee growth inside the range, computed before
+// any tick is removed, to settle the fees of the position.
+// The balances of the pool are not updated.
+func (pa *ConcentratedPool) UpdatePosition(lowerTick, upperTick int64, liquidityDelta sdk.Dec) (feeGrowthInside0, feeGrowthInside1 sdk.Dec, err error) {
+	err = pa.ValidatePositionTicks(lowerTick, upperTick)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	// The ticks are initialized first, so that the fee growth inside is computed against them.
+	pa.initTick(lowerTick)
+	pa.initTick(upperTick)
+	feeGrowthInside0, feeGrowthInside1 = pa.FeeGrowthInside(lowerTick, upperTick)
+
+	err = pa.updateTick(lowerTick, liquidityDelta, false)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	err = pa.updateTick(upperTick, liquidityDelta, true)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	if lowerTick <= pa.CurrentTick && pa.CurrentTick < upperTick {
+		pa.Liquidity = pa.Liquidity.Add(liquidityDelta)
+	}
+
+	return feeGrowthInside0, feeGrowthInside1, nil
+}
+
+// UpdateFees adds the fees earned since the last update of the position to its uncollected fees,
+// given the current fee growth inside its range.
+func (p *Position) UpdateFees(feeGrowthInside0, feeGrowthInside1 sdk.Dec, denom0, denom1 string) {
+	// The fee growth inside never decreases, up to rounding errors.
+	earned := func(feeGrowthInside, feeGrowthInsideLast sdk.Dec) sdk.Dec {
+		return sdk.MaxDec(feeGrowthInside.Sub(feeGrowthInsideLast), sdk.ZeroDec()).Mul(p.Liquidity)
+	}
+	fees := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(denom0, earned(feeGrowthInside0, p.FeeGrowthInside0Last)),
+		sdk.NewDecCoinFromDec(denom1, earned(feeGrowthInside1, p.FeeGrowthInside1Last)),
+	)
+	p.UncollectedFees = p.UncollectedFees.Add(fees...)
+	p.FeeGrowthInside0Last = feeGrowthInside0
+	p.FeeGrowthInside1Last = feeGrowthInside1
+}
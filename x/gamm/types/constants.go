@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,6 +11,28 @@ const (
 	MaxPoolAssets = 8
 
 	OneShareExponent = 18
+
+	// MaxRouteHops is the maximum number of pools in a route searched by EstimateBestRoute.
+	MaxRouteHops = 4
+	// MaxRouteSplits is the maximum number of routes a swap can be split across.
+	MaxRouteSplits = 8
+
+	// MaxFlashSwapMsgs is the maximum number of msgs executed within a flash swap.
+	MaxFlashSwapMsgs = 10
+
+	// MaxPoolShareSymbolLength is the maximum length of the display denom chosen for pool shares.
+	MaxPoolShareSymbolLength = 32
+
+	// MinLimitOrderAmount is the least amount of token in a limit order can be placed for,
+	// so that resting orders can't be placed for dust.
+	MinLimitOrderAmount = 1000
+	// MaxLimitOrderFillsPerBlock bounds the number of limit orders whose fill is attempted at the end of a block.
+	// The triggered orders left over are filled in the next blocks.
+	MaxLimitOrderFillsPerBlock = 100
+
+	// PoolCreationFeeTwapWindow is the window of the TWAP the pool creation fee is converted at, so that the
+	// price of the fee can't be moved within a block. The pricing pool must have a history that long.
+	PoolCreationFeeTwapWindow = time.Hour
 )
 
 var (
@@ -27,4 +27,56 @@ var (
 
 	ErrPoolParamsInvalidDenom     = sdkerrors.Register(ModuleName, 50, "pool params' LBP params has an invalid denomination")
 	ErrPoolParamsInvalidNumDenoms = sdkerrors.Register(ModuleName, 51, "pool params' LBP doesn't have same number of params as underlying pool")
+
+	ErrInvalidAmplificationParameter = sdkerrors.Register(ModuleName, 60, "stableswap amplification parameter must be between 1 and 1000000")
+	ErrUnsupportedPoolOperation      = sdkerrors.Register(ModuleName, 61, "operation is not supported by this pool type")
+
+	ErrInvalidTwapTimeRange   = sdkerrors.Register(ModuleName, 62, "invalid twap time range")
+	ErrTwapHistoryUnavailable = sdkerrors.Register(ModuleName, 63, "twap history is not available for the requested time")
+
+	ErrNotPoolGovernor          = sdkerrors.Register(ModuleName, 70, "sender is not the future governor of the pool")
+	ErrInvalidWeightChangeStart = sdkerrors.Register(ModuleName, 71, "weight change cannot start before the current block time")
+
+	ErrNoRouteFound        = sdkerrors.Register(ModuleName, 80, "no route found between the denominations")
+	ErrInvalidSplitRoutes  = sdkerrors.Register(ModuleName, 81, "invalid split routes")
+	ErrInvalidRouteOptions = sdkerrors.Register(ModuleName, 82, "invalid route search options")
+
+	ErrInvalidTickSpacing = sdkerrors.Register(ModuleName, 90, "invalid tick spacing")
+	ErrInvalidTick        = sdkerrors.Register(ModuleName, 91, "invalid position ticks")
+	ErrInvalidSqrtPrice   = sdkerrors.Register(ModuleName, 92, "invalid concentrated pool price")
+	ErrNotEnoughLiquidity = sdkerrors.Register(ModuleName, 93, "not enough liquidity in the pool")
+	ErrPositionNotFound   = sdkerrors.Register(ModuleName, 94, "position not found")
+	ErrNotPositionOwner   = sdkerrors.Register(ModuleName, 95, "sender is not the owner of the position")
+	ErrInvalidLiquidity   = sdkerrors.Register(ModuleName, 96, "liquidity should be positive and at most the position's liquidity")
+
+	ErrPoolInBatchMode    = sdkerrors.Register(ModuleName, 100, "swaps on the pool are executed in batches")
+	ErrInvalidBatchSwap   = sdkerrors.Register(ModuleName, 101, "invalid batch swap")
+	ErrBatchResultMissing = sdkerrors.Register(ModuleName, 102, "no batch of swaps was executed on the pool")
+
+	ErrInvalidLimitOrder  = sdkerrors.Register(ModuleName, 110, "invalid limit order")
+	ErrLimitOrderNotFound = sdkerrors.Register(ModuleName, 111, "limit order not found")
+	ErrNotLimitOrderOwner = sdkerrors.Register(ModuleName, 112, "sender is not the owner of the limit order")
+
+	ErrInvalidFlashSwap   = sdkerrors.Register(ModuleName, 120, "invalid flash swap")
+	ErrFlashSwapUnhealthy = sdkerrors.Register(ModuleName, 121, "pool is worth less per share after the flash swap")
+
+	ErrInvalidPoolShareSymbol = sdkerrors.Register(ModuleName, 130, "invalid pool share symbol")
+
+	ErrInvalidLBP      = sdkerrors.Register(ModuleName, 140, "invalid liquidity bootstrapping pool")
+	ErrNotLBP          = sdkerrors.Register(ModuleName, 141, "pool is not an unfinalized liquidity bootstrapping pool")
+	ErrLBPJoinDenied   = sdkerrors.Register(ModuleName, 142, "only the creator can join a liquidity bootstrapping pool")
+	ErrNotLBPCreator   = sdkerrors.Register(ModuleName, 143, "sender is not the creator of the liquidity bootstrapping pool")
+	ErrLBPSaleNotEnded = sdkerrors.Register(ModuleName, 144, "the sale of the liquidity bootstrapping pool didn't end")
+
+	ErrPoolPaused           = sdkerrors.Register(ModuleName, 150, "pool is paused, it can only be exited")
+	ErrPoolDeprecated       = sdkerrors.Register(ModuleName, 151, "pool is deprecated, it can only be exited")
+	ErrPoolMigrated         = sdkerrors.Register(ModuleName, 152, "pool liquidity was migrated to another pool")
+	ErrInvalidPoolStatus    = sdkerrors.Register(ModuleName, 153, "invalid pool status")
+	ErrInvalidPoolMigration = sdkerrors.Register(ModuleName, 154, "invalid pool migration")
+
+	ErrInvalidPoolCreationFeeDenom   = sdkerrors.Register(ModuleName, 160, "pool creation fee can't be paid in denom")
+	ErrPoolCreationFeeRecordNotFound = sdkerrors.Register(ModuleName, 161, "pool creation fee record not found")
+
+	ErrMaxPriceImpactExceeded = sdkerrors.Register(ModuleName, 170, "price impact of the swap is above the max")
+	ErrMaxSpotPriceExceeded   = sdkerrors.Register(ModuleName, 171, "spot price is above the max")
 )
@@ -6,9 +6,53 @@ const (
 	TypeEvtPoolCreated  = "pool_created"
 	TypeEvtTokenSwapped = "token_swapped"
 
+	TypeEvtPositionCreated = "position_created"
+	TypeEvtFeesCollected   = "fees_collected"
+
+	TypeEvtPoolSwapFeeSet            = "pool_swap_fee_set"
+	TypeEvtPoolExitFeeSet            = "pool_exit_fee_set"
+	TypeEvtPoolWeightChangeScheduled = "pool_weight_change_scheduled"
+	TypeEvtPoolBatchModeSet          = "pool_batch_mode_set"
+
+	TypeEvtSwapQueued       = "swap_queued"
+	TypeEvtBatchSwapSettled = "batch_swap_settled"
+
+	TypeEvtLimitOrderPlaced    = "limit_order_placed"
+	TypeEvtLimitOrderCancelled = "limit_order_cancelled"
+	TypeEvtLimitOrderFilled    = "limit_order_filled"
+
+	TypeEvtFlashSwap = "flash_swap"
+
+	TypeEvtLBPFinalized = "lbp_finalized"
+
+	TypeEvtPoolStatusSet = "pool_status_set"
+	TypeEvtPoolMigrated  = "pool_migrated"
+
+	TypeEvtPoolSharesMigrated = "pool_shares_migrated"
+
+	TypeEvtPoolCreationFeePaid = "pool_creation_fee_paid"
+
 	AttributeValueCategory = ModuleName
 	AttributeKeyPoolId     = "pool_id"
 	AttributeKeySwapFee    = "swap_fee"
 	AttributeKeyTokensIn   = "tokens_in"
 	AttributeKeyTokensOut  = "tokens_out"
+	AttributeKeyExitFee    = "exit_fee"
+	AttributeKeyStartTime  = "start_time"
+	AttributeKeyDuration   = "duration"
+	AttributeKeyWeights    = "target_weights"
+	AttributeKeyPositionId = "position_id"
+	AttributeKeyEnabled    = "enabled"
+	AttributeKeySwapId     = "queued_swap_id"
+	AttributeKeyFilled     = "filled"
+	AttributeKeyRefund     = "refund"
+	AttributeKeyOrderId    = "order_id"
+	AttributeKeyPrice      = "trigger_price"
+	AttributeKeyReopened   = "reopened"
+	AttributeKeyStatus     = "status"
+	AttributeKeyNewPoolId  = "new_pool_id"
+	AttributeKeyShares     = "migrated_shares"
+	AttributeKeyFee        = "fee"
+	AttributeKeyPayer      = "payer"
+	AttributeKeyFeeTo      = "destination"
 )
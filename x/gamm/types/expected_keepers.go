@@ -1,10 +1,14 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/x/lockup/types"
 )
 
 // AccountKeeper defines the account contract that must be fulfilled when
@@ -42,6 +46,7 @@ type BankKeeper interface {
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 
+	GetDenomMetaData(ctx sdk.Context, denom string) banktypes.Metadata
 	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
 
 	// Only needed for simulation interface matching
@@ -61,3 +66,19 @@ type BankKeeper interface {
 type DistrKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+// PoolIncentivesKeeper defines the pool incentives contract needed to seed the gauges of new pools
+type PoolIncentivesKeeper interface {
+	AddToPoolGauge(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, coins sdk.Coins) error
+}
+
+// LockupKeeper defines the lockup contract needed to resolve lock based pool governors
+type LockupKeeper interface {
+	GetAccountLockedLongerDurationDenom(ctx sdk.Context, addr sdk.AccAddress, denom string, duration time.Duration) []lockuptypes.PeriodLock
+	GetLockedDenom(ctx sdk.Context, denom string, duration time.Duration) sdk.Int
+}
+
+// EpochKeeper defines the epochs contract needed to record pool stats by epoch
+type EpochKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) epochstypes.EpochInfo
+}
@@ -1,15 +1,19 @@
 package types
 
 import (
+	"fmt"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
 
 // DefaultGenesis creates a default GenesisState object
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Pools:          []*codectypes.Any{},
-		NextPoolNumber: 1,
-		Params:         DefaultParams(),
+		Pools:            []*codectypes.Any{},
+		NextPoolNumber:   1,
+		Params:           DefaultParams(),
+		NextPositionId:   1,
+		NextLimitOrderId: 1,
 	}
 }
 
@@ -19,5 +23,42 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	if err := gs.ProtocolFees.Validate(); err != nil {
+		return err
+	}
+	for _, order := range gs.LimitOrders {
+		if err := ValidateTriggerPrice(order.TriggerPrice); err != nil {
+			return err
+		}
+	}
+	for _, stats := range gs.PoolStats {
+		if stats.Epoch < 0 {
+			return fmt.Errorf("pool %d stats have a negative epoch: %d", stats.PoolId, stats.Epoch)
+		}
+	}
+	for _, record := range gs.PoolStatuses {
+		if err := ValidatePoolStatus(record.Status); err != nil {
+			return err
+		}
+		if (record.Status == PoolMigrated) != (record.MigratedToPoolId != 0) {
+			return fmt.Errorf("pool %d should have a pool it was migrated to if and only if it is migrated", record.PoolId)
+		}
+	}
+	for _, tick := range gs.Ticks {
+		if tick.TickIndex < MinTick || tick.TickIndex > MaxTick {
+			return fmt.Errorf("tick %d of pool %d is out of range", tick.TickIndex, tick.PoolId)
+		}
+		if tick.LiquidityGross.IsNil() || !tick.LiquidityGross.IsPositive() {
+			return fmt.Errorf("tick %d of pool %d has no liquidity", tick.TickIndex, tick.PoolId)
+		}
+	}
+	for _, record := range gs.PoolCreationFeeRecords {
+		if err := validatePoolCreationFeeDestination(record.Destination); err != nil {
+			return err
+		}
+		if err := record.Fee.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -10,16 +10,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,6 +34,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params holds parameters for the incentives module
 type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	// TWAP records older than this are pruned.
+	TwapPruningHorizon time.Duration `protobuf:"bytes,2,opt,name=twap_pruning_horizon,json=twapPruningHorizon,proto3,stdduration" json:"twap_pruning_horizon" yaml:"twap_pruning_horizon"`
+	// Fraction of the swap fees sent to the community pool instead of
+	// accruing to liquidity providers.
+	ProtocolFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=protocol_fee_share,json=protocolFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_fee_share" yaml:"protocol_fee_share"`
+	// Pool stats are recorded by epoch of this epoch identifier.
+	StatsEpochIdentifier string `protobuf:"bytes,4,opt,name=stats_epoch_identifier,json=statsEpochIdentifier,proto3" json:"stats_epoch_identifier,omitempty" yaml:"stats_epoch_identifier"`
+	// Pool stats of epochs more than this many epochs before the current one
+	// are pruned. Zero keeps them all.
+	StatsRetentionEpochs       uint64                     `protobuf:"varint,5,opt,name=stats_retention_epochs,json=statsRetentionEpochs,proto3" json:"stats_retention_epochs,omitempty" yaml:"stats_retention_epochs"`
+	PoolCreationFeeDestination PoolCreationFeeDestination `protobuf:"varint,6,opt,name=pool_creation_fee_destination,json=poolCreationFeeDestination,proto3,enum=osmosis.gamm.v1beta1.PoolCreationFeeDestination" json:"pool_creation_fee_destination,omitempty" yaml:"pool_creation_fee_destination"`
+	// Denoms the pool creation fee can be paid in instead, when it is a single
+	// coin.
+	PoolCreationFeeDenoms []PoolCreationFeeDenom `protobuf:"bytes,7,rep,name=pool_creation_fee_denoms,json=poolCreationFeeDenoms,proto3" json:"pool_creation_fee_denoms" yaml:"pool_creation_fee_denoms"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -72,11 +90,57 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Params) GetTwapPruningHorizon() time.Duration {
+	if m != nil {
+		return m.TwapPruningHorizon
+	}
+	return 0
+}
+
+func (m *Params) GetStatsEpochIdentifier() string {
+	if m != nil {
+		return m.StatsEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetStatsRetentionEpochs() uint64 {
+	if m != nil {
+		return m.StatsRetentionEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetPoolCreationFeeDestination() PoolCreationFeeDestination {
+	if m != nil {
+		return m.PoolCreationFeeDestination
+	}
+	return FeeToCommunityPool
+}
+
+func (m *Params) GetPoolCreationFeeDenoms() []PoolCreationFeeDenom {
+	if m != nil {
+		return m.PoolCreationFeeDenoms
+	}
+	return nil
+}
+
 // GenesisState defines the gamm module's genesis state.
 type GenesisState struct {
-	Pools          []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
-	NextPoolNumber uint64        `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
-	Params         Params        `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	Pools                  []*types1.Any                            `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	NextPoolNumber         uint64                                   `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
+	Params                 Params                                   `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	TwapRecords            []TwapRecord                             `protobuf:"bytes,4,rep,name=twap_records,json=twapRecords,proto3" json:"twap_records"`
+	Positions              []Position                               `protobuf:"bytes,5,rep,name=positions,proto3" json:"positions"`
+	NextPositionId         uint64                                   `protobuf:"varint,6,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty"`
+	ProtocolFees           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=protocol_fees,json=protocolFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"protocol_fees"`
+	BatchModePoolIds       []uint64                                 `protobuf:"varint,8,rep,packed,name=batch_mode_pool_ids,json=batchModePoolIds,proto3" json:"batch_mode_pool_ids,omitempty"`
+	LimitOrders            []LimitOrder                             `protobuf:"bytes,9,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders"`
+	NextLimitOrderId       uint64                                   `protobuf:"varint,10,opt,name=next_limit_order_id,json=nextLimitOrderId,proto3" json:"next_limit_order_id,omitempty"`
+	PoolStats              []PoolEpochStats                         `protobuf:"bytes,11,rep,name=pool_stats,json=poolStats,proto3" json:"pool_stats"`
+	PoolStatuses           []PoolStatusRecord                       `protobuf:"bytes,12,rep,name=pool_statuses,json=poolStatuses,proto3" json:"pool_statuses"`
+	PoolCreationFeeRecords []PoolCreationFeeRecord                  `protobuf:"bytes,13,rep,name=pool_creation_fee_records,json=poolCreationFeeRecords,proto3" json:"pool_creation_fee_records"`
+	Ticks                  []TickInfo                               `protobuf:"bytes,14,rep,name=ticks,proto3" json:"ticks"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -133,6 +197,83 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTwapRecords() []TwapRecord {
+	if m != nil {
+		return m.TwapRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPositions() []Position {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextPositionId() uint64 {
+	if m != nil {
+		return m.NextPositionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetProtocolFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProtocolFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBatchModePoolIds() []uint64 {
+	if m != nil {
+		return m.BatchModePoolIds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLimitOrders() []LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextLimitOrderId() uint64 {
+	if m != nil {
+		return m.NextLimitOrderId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPoolStats() []PoolEpochStats {
+	if m != nil {
+		return m.PoolStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolStatuses() []PoolStatusRecord {
+	if m != nil {
+		return m.PoolStatuses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolCreationFeeRecords() []PoolCreationFeeRecord {
+	if m != nil {
+		return m.PoolCreationFeeRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTicks() []TickInfo {
+	if m != nil {
+		return m.Ticks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.gamm.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.gamm.GenesisState")
@@ -143,32 +284,67 @@ func init() {
 }
 
 var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 390 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0x3d, 0xeb, 0xd3, 0x40,
-	0x18, 0xcf, 0xf9, 0xff, 0xb7, 0x60, 0x2c, 0xbe, 0x84, 0x0e, 0x69, 0x87, 0xb4, 0x64, 0xca, 0x60,
-	0xef, 0x68, 0xc5, 0xc5, 0xcd, 0x14, 0x2a, 0x82, 0x48, 0x89, 0x9b, 0x4b, 0xb8, 0xc4, 0x6b, 0x0c,
-	0x26, 0xf7, 0x84, 0xdc, 0x55, 0x9a, 0x6f, 0x21, 0xb8, 0xbb, 0xb8, 0x39, 0xfb, 0x21, 0x8a, 0x53,
-	0x47, 0xa7, 0x2a, 0xed, 0x37, 0xf0, 0x13, 0xc8, 0xbd, 0x54, 0x0a, 0x4e, 0xc9, 0xef, 0x7e, 0x2f,
-	0xf7, 0x3c, 0xbf, 0xc4, 0x0d, 0x41, 0xd4, 0x20, 0x4a, 0x41, 0x0a, 0x5a, 0xd7, 0xe4, 0xe3, 0x3c,
-	0x63, 0x92, 0xce, 0x49, 0xc1, 0x38, 0x13, 0xa5, 0xc0, 0x4d, 0x0b, 0x12, 0xbc, 0x81, 0xd5, 0x60,
-	0xa5, 0x19, 0x0f, 0x0b, 0x28, 0x40, 0x13, 0x44, 0xbd, 0x19, 0xcd, 0x78, 0x54, 0x00, 0x14, 0x15,
-	0x23, 0x1a, 0x65, 0xdb, 0x0d, 0xa1, 0xbc, 0xbb, 0x50, 0xb9, 0xf6, 0xa7, 0xc6, 0x63, 0x80, 0xa5,
-	0x02, 0x83, 0x48, 0x46, 0x05, 0xfb, 0x77, 0x79, 0x0e, 0x25, 0x37, 0x7c, 0xf8, 0x05, 0xb9, 0xfd,
-	0x35, 0x6d, 0x69, 0x2d, 0xbc, 0xcf, 0xc8, 0x7d, 0xd4, 0x00, 0x54, 0x69, 0xde, 0x32, 0x2a, 0x4b,
-	0xe0, 0xe9, 0x86, 0x31, 0x1f, 0x4d, 0x6f, 0xa2, 0x7b, 0x8b, 0x11, 0xb6, 0xa9, 0x2a, 0x07, 0xdb,
-	0x1c, 0xbc, 0x84, 0x92, 0xc7, 0xaf, 0xf6, 0xc7, 0x89, 0xf3, 0xe7, 0x38, 0xf1, 0x3b, 0x5a, 0x57,
-	0xcf, 0xc2, 0xff, 0x12, 0xc2, 0x6f, 0xbf, 0x26, 0x51, 0x51, 0xca, 0xf7, 0xdb, 0x0c, 0xe7, 0x50,
-	0xdb, 0xf1, 0xec, 0x63, 0x26, 0xde, 0x7d, 0x20, 0xb2, 0x6b, 0x98, 0xd0, 0x61, 0x22, 0x79, 0xa0,
-	0xfc, 0x4b, 0x6b, 0x5f, 0x31, 0x16, 0x7e, 0x45, 0xee, 0xe0, 0x85, 0x29, 0xeb, 0x8d, 0xa4, 0x92,
-	0x79, 0x4f, 0xdd, 0x9e, 0xd2, 0x08, 0x3b, 0xd9, 0x10, 0x9b, 0x5e, 0xf0, 0xa5, 0x17, 0xfc, 0x9c,
-	0x77, 0xf1, 0xdd, 0x1f, 0xdf, 0x67, 0xbd, 0x35, 0x40, 0xf5, 0x32, 0x31, 0x6a, 0x2f, 0x72, 0x1f,
-	0x72, 0xb6, 0x93, 0xa9, 0x9e, 0x8f, 0x6f, 0xeb, 0x8c, 0xb5, 0xfe, 0x9d, 0x29, 0x8a, 0x6e, 0x93,
-	0xfb, 0xea, 0x5c, 0x69, 0x5f, 0xeb, 0x53, 0x6f, 0xe1, 0xf6, 0x1b, 0xdd, 0x88, 0x7f, 0x33, 0x45,
-	0xfa, 0x86, 0xeb, 0xaf, 0x83, 0x4d, 0x5b, 0xf1, 0xad, 0x5a, 0x3b, 0xb1, 0xca, 0x78, 0xb5, 0x3f,
-	0x05, 0xe8, 0x70, 0x0a, 0xd0, 0xef, 0x53, 0x80, 0x3e, 0x9d, 0x03, 0xe7, 0x70, 0x0e, 0x9c, 0x9f,
-	0xe7, 0xc0, 0x79, 0xfb, 0xf8, 0x6a, 0x75, 0x9b, 0x33, 0xab, 0x68, 0x26, 0x2e, 0x80, 0xec, 0xcc,
-	0x8f, 0xa1, 0x4b, 0xc8, 0xfa, 0x7a, 0x8b, 0x27, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf2, 0x6a,
-	0x74, 0x74, 0x35, 0x02, 0x00, 0x00,
+	// 951 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x12, 0xc7, 0x25, 0x13, 0x27, 0x84, 0x21, 0x54, 0x9b, 0xa0, 0x7a, 0xcd, 0xaa, 0x04,
+	0x8b, 0x36, 0xde, 0x36, 0x88, 0x4b, 0x6f, 0xb8, 0x21, 0x60, 0x51, 0x20, 0x6c, 0x2a, 0x21, 0x71,
+	0x59, 0xad, 0x77, 0x27, 0xf6, 0x28, 0xbb, 0x33, 0xab, 0x9d, 0x31, 0xad, 0xf9, 0x0d, 0x1c, 0x90,
+	0xb8, 0x70, 0xe1, 0x0f, 0x70, 0xe6, 0xce, 0xb5, 0xe2, 0xd4, 0x23, 0xe2, 0xe0, 0xa2, 0xe4, 0x0f,
+	0xa0, 0xfc, 0x02, 0x34, 0x6f, 0x66, 0xed, 0x4d, 0xbc, 0x8e, 0xd2, 0x93, 0x3d, 0xef, 0x7d, 0xef,
+	0x7b, 0x6f, 0xde, 0xfb, 0xde, 0x0e, 0x72, 0xb9, 0x48, 0xb9, 0xa0, 0xc2, 0x1b, 0x84, 0x69, 0xea,
+	0xfd, 0xf0, 0xb0, 0x4f, 0x64, 0xf8, 0xd0, 0x1b, 0x10, 0x46, 0x04, 0x15, 0x9d, 0x2c, 0xe7, 0x92,
+	0xe3, 0x86, 0xc1, 0x74, 0x14, 0x66, 0x67, 0x6b, 0xc0, 0x07, 0x1c, 0x1c, 0x9e, 0xfa, 0xa7, 0x31,
+	0x3b, 0xdb, 0x03, 0xce, 0x07, 0x09, 0xf1, 0xe0, 0xd4, 0x1f, 0x9d, 0x78, 0x21, 0x1b, 0x17, 0xae,
+	0x08, 0xe2, 0x03, 0x1d, 0xa3, 0x0f, 0xc6, 0xd5, 0xbc, 0x1a, 0x15, 0x8f, 0xf2, 0x50, 0x52, 0xce,
+	0x0a, 0xbf, 0x46, 0x7b, 0xfd, 0x50, 0x90, 0x69, 0x71, 0x11, 0xa7, 0x85, 0xdf, 0xa9, 0xac, 0x5e,
+	0x3e, 0x0b, 0x33, 0x03, 0xb8, 0x57, 0x09, 0x88, 0x38, 0x8b, 0x08, 0x93, 0x79, 0x28, 0x49, 0x7c,
+	0xc4, 0x79, 0x62, 0xc0, 0xbb, 0x95, 0xe0, 0x84, 0xa6, 0x54, 0x06, 0x3c, 0x8f, 0x49, 0x6e, 0x70,
+	0x1f, 0x54, 0xe2, 0x32, 0xce, 0x93, 0x40, 0xc8, 0x50, 0x8a, 0x6b, 0xe9, 0xa6, 0xb0, 0x51, 0x81,
+	0xbb, 0xbf, 0x18, 0x17, 0xe5, 0x04, 0xda, 0x11, 0x9c, 0x10, 0xa2, 0xd1, 0xee, 0x7f, 0x75, 0x54,
+	0x3f, 0x0a, 0xf3, 0x30, 0x15, 0xf8, 0x17, 0x0b, 0xbd, 0x3d, 0x07, 0xb3, 0xad, 0xd6, 0x72, 0x7b,
+	0x6d, 0x7f, 0xbb, 0x63, 0x1a, 0xad, 0x5a, 0xd7, 0x31, 0xa4, 0x9d, 0xc7, 0x9c, 0xb2, 0xee, 0x93,
+	0x17, 0x13, 0x67, 0xe9, 0x62, 0xe2, 0xd8, 0xe3, 0x30, 0x4d, 0x1e, 0xb9, 0x73, 0x0c, 0xee, 0xef,
+	0xaf, 0x9c, 0xf6, 0x80, 0xca, 0xe1, 0xa8, 0xdf, 0x89, 0x78, 0x6a, 0x26, 0x66, 0x7e, 0xf6, 0x44,
+	0x7c, 0xea, 0xc9, 0x71, 0x46, 0x04, 0x90, 0x09, 0xff, 0x2d, 0x15, 0xff, 0xd8, 0x84, 0x1f, 0x12,
+	0x82, 0x25, 0xda, 0x52, 0x03, 0x08, 0xb2, 0x7c, 0xc4, 0x28, 0x1b, 0x04, 0x43, 0x9e, 0xd3, 0x1f,
+	0x39, 0xb3, 0xdf, 0x68, 0x59, 0x50, 0x97, 0x1e, 0x79, 0xa7, 0x18, 0x79, 0xe7, 0xc0, 0x8c, 0xbc,
+	0xfb, 0xa1, 0xa9, 0xeb, 0x3d, 0x5d, 0x57, 0x15, 0x89, 0xfb, 0xeb, 0x2b, 0xc7, 0xf2, 0xb1, 0x72,
+	0x1d, 0x69, 0xcf, 0x17, 0xda, 0x81, 0xc7, 0x08, 0x03, 0x63, 0xc4, 0x13, 0x75, 0x87, 0x40, 0x0c,
+	0xc3, 0x9c, 0xd8, 0xcb, 0x2d, 0xab, 0xbd, 0xda, 0xfd, 0x52, 0x11, 0xff, 0x33, 0x71, 0x76, 0x6f,
+	0x70, 0xa9, 0x03, 0x12, 0x5d, 0x4c, 0x9c, 0x6d, 0xd3, 0x9a, 0x39, 0x46, 0xd7, 0xdf, 0x2c, 0x8c,
+	0x87, 0x84, 0x1c, 0x2b, 0x13, 0xfe, 0x0e, 0xdd, 0x86, 0xb1, 0x07, 0x24, 0xe3, 0xd1, 0x30, 0xa0,
+	0x31, 0x61, 0x92, 0x9e, 0x50, 0x92, 0xdb, 0x35, 0x48, 0xff, 0xfe, 0xc5, 0xc4, 0xb9, 0xa3, 0x09,
+	0xab, 0x71, 0xae, 0xbf, 0x05, 0x8e, 0xcf, 0x94, 0xbd, 0x37, 0x35, 0xcf, 0x88, 0x73, 0x22, 0x95,
+	0x91, 0x33, 0x1d, 0x2a, 0xec, 0x95, 0x96, 0xd5, 0xae, 0xcd, 0x13, 0x5f, 0xc5, 0x15, 0xc4, 0x7e,
+	0x61, 0x87, 0x0c, 0x02, 0xff, 0x66, 0xa1, 0x3b, 0x73, 0x63, 0x0f, 0x62, 0x22, 0x24, 0x65, 0x70,
+	0xb6, 0xeb, 0x2d, 0xab, 0xbd, 0xb1, 0xff, 0xa0, 0x53, 0xde, 0xfc, 0xa9, 0x8a, 0x8e, 0x2e, 0x4f,
+	0xfc, 0x60, 0x16, 0xd7, 0x6d, 0x5f, 0x4c, 0x9c, 0xbb, 0x0b, 0x74, 0x55, 0x4e, 0xe0, 0xfa, 0x3b,
+	0xd9, 0x42, 0x16, 0xfc, 0x93, 0x85, 0xec, 0xaa, 0x70, 0xc6, 0x53, 0x61, 0xdf, 0x02, 0x7d, 0x7f,
+	0x74, 0xc3, 0xd2, 0x18, 0x4f, 0xa7, 0xc2, 0x72, 0x16, 0x17, 0xa6, 0x98, 0x5d, 0xff, 0xdd, 0xac,
+	0x22, 0x5c, 0xb8, 0x7f, 0xde, 0x42, 0x8d, 0xcf, 0xf5, 0x17, 0xf1, 0x58, 0x86, 0x92, 0xe0, 0x4f,
+	0xd0, 0x8a, 0x42, 0x0a, 0xb3, 0x6b, 0x5b, 0x73, 0x9a, 0xfe, 0x94, 0x8d, 0xbb, 0xab, 0x7f, 0xfd,
+	0xb1, 0xb7, 0xa2, 0xea, 0xe9, 0xf9, 0x1a, 0x8d, 0xdb, 0x68, 0x93, 0x91, 0xe7, 0x32, 0x80, 0x02,
+	0xd8, 0x28, 0xed, 0x93, 0x1c, 0xb6, 0xa2, 0xe6, 0x6f, 0x28, 0xbb, 0xc2, 0x7e, 0x0d, 0x56, 0xbc,
+	0x8f, 0xea, 0x19, 0xec, 0x38, 0x28, 0x58, 0x65, 0xb8, 0x74, 0x5b, 0xbd, 0xff, 0xdd, 0x9a, 0xba,
+	0x97, 0x6f, 0x90, 0xb8, 0x87, 0x1a, 0xb0, 0x32, 0x39, 0x89, 0x78, 0x1e, 0x0b, 0xbb, 0x06, 0xb5,
+	0xb5, 0xaa, 0xfb, 0xf4, 0xf4, 0x59, 0x98, 0xf9, 0x00, 0x34, 0x2c, 0x6b, 0x72, 0x6a, 0x11, 0xb8,
+	0x8b, 0x56, 0x33, 0x2e, 0xa8, 0xea, 0x82, 0xd2, 0x9a, 0xe2, 0x69, 0x2e, 0xea, 0xb7, 0x86, 0x19,
+	0x96, 0x59, 0x58, 0xe9, 0xb2, 0xda, 0x12, 0xd0, 0xd8, 0xae, 0x97, 0x2f, 0xab, 0xcd, 0xbd, 0x18,
+	0x67, 0x68, 0xbd, 0xbc, 0x68, 0xc5, 0x84, 0xaf, 0xf9, 0x82, 0x3d, 0x50, 0xc9, 0x5e, 0xeb, 0x2b,
+	0xd5, 0x28, 0x6d, 0xad, 0xc0, 0x7b, 0xe8, 0x9d, 0x7e, 0x28, 0xa3, 0x61, 0x90, 0xf2, 0x98, 0xe8,
+	0x71, 0xd0, 0x58, 0xd8, 0x6f, 0xb6, 0x96, 0xdb, 0x35, 0x7f, 0x13, 0x5c, 0x5f, 0xf1, 0x98, 0xc0,
+	0xf0, 0x62, 0xe8, 0x6c, 0xe9, 0x11, 0x10, 0xf6, 0xea, 0x75, 0x9d, 0x7d, 0xa2, 0x90, 0xdf, 0x28,
+	0x60, 0xd1, 0xd9, 0x64, 0x6a, 0x81, 0xcc, 0xd0, 0x95, 0x12, 0x9f, 0x6a, 0x0c, 0x82, 0xc6, 0x40,
+	0xc3, 0x66, 0xf1, 0xbd, 0x18, 0xf7, 0x10, 0x9a, 0x3d, 0x2b, 0xf6, 0x1a, 0xe4, 0xbd, 0xbb, 0x58,
+	0xf9, 0xb0, 0xde, 0x4a, 0xa2, 0x62, 0x36, 0x0f, 0x9e, 0x80, 0x01, 0x7f, 0x8b, 0xd6, 0x4b, 0x4f,
+	0x0f, 0x11, 0x76, 0x03, 0xd8, 0x76, 0x17, 0xb3, 0x1d, 0x03, 0xf2, 0x92, 0x4a, 0x1a, 0xd9, 0xd4,
+	0x4e, 0x04, 0x4e, 0xd0, 0xf6, 0xfc, 0x2e, 0x15, 0xf2, 0x5b, 0x07, 0xfa, 0x7b, 0x37, 0x5a, 0xd3,
+	0x4b, 0x39, 0x6e, 0x67, 0x55, 0x4e, 0x81, 0x1f, 0xa1, 0x15, 0x49, 0xa3, 0x53, 0x61, 0x6f, 0x5c,
+	0x27, 0xc8, 0xa7, 0x34, 0x3a, 0xed, 0xb1, 0x13, 0x6e, 0xc8, 0x74, 0x48, 0xf7, 0xf0, 0xc5, 0x59,
+	0xd3, 0x7a, 0x79, 0xd6, 0xb4, 0xfe, 0x3d, 0x6b, 0x5a, 0x3f, 0x9f, 0x37, 0x97, 0x5e, 0x9e, 0x37,
+	0x97, 0xfe, 0x3e, 0x6f, 0x2e, 0x7d, 0x7f, 0xbf, 0x24, 0x21, 0x43, 0xb8, 0x97, 0x84, 0x7d, 0x51,
+	0x1c, 0xbc, 0xe7, 0xfa, 0x59, 0x06, 0x31, 0xf5, 0xeb, 0x20, 0xa3, 0x8f, 0xff, 0x1f, 0x00, 0x31,
+	0x4b, 0x8d, 0xf3, 0x36, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -191,6 +367,55 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolCreationFeeDenoms) > 0 {
+		for iNdEx := len(m.PoolCreationFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolCreationFeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.PoolCreationFeeDestination != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolCreationFeeDestination))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StatsRetentionEpochs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StatsRetentionEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.StatsEpochIdentifier) > 0 {
+		i -= len(m.StatsEpochIdentifier)
+		copy(dAtA[i:], m.StatsEpochIdentifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StatsEpochIdentifier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.ProtocolFeeShare.Size()
+		i -= size
+		if _, err := m.ProtocolFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapPruningHorizon, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapPruningHorizon):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
 	if len(m.PoolCreationFee) > 0 {
 		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -228,6 +453,146 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Ticks) > 0 {
+		for iNdEx := len(m.Ticks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ticks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PoolCreationFeeRecords) > 0 {
+		for iNdEx := len(m.PoolCreationFeeRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolCreationFeeRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PoolStatuses) > 0 {
+		for iNdEx := len(m.PoolStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PoolStats) > 0 {
+		for iNdEx := len(m.PoolStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.NextLimitOrderId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextLimitOrderId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.BatchModePoolIds) > 0 {
+		dAtA3 := make([]byte, len(m.BatchModePoolIds)*10)
+		var j2 int
+		for _, num := range m.BatchModePoolIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintGenesis(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ProtocolFees) > 0 {
+		for iNdEx := len(m.ProtocolFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NextPositionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPositionId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TwapRecords) > 0 {
+		for iNdEx := len(m.TwapRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TwapRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -283,6 +648,26 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapPruningHorizon)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.ProtocolFeeShare.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.StatsEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.StatsRetentionEpochs != 0 {
+		n += 1 + sovGenesis(uint64(m.StatsRetentionEpochs))
+	}
+	if m.PoolCreationFeeDestination != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolCreationFeeDestination))
+	}
+	if len(m.PoolCreationFeeDenoms) > 0 {
+		for _, e := range m.PoolCreationFeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -303,6 +688,67 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TwapRecords) > 0 {
+		for _, e := range m.TwapRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPositionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPositionId))
+	}
+	if len(m.ProtocolFees) > 0 {
+		for _, e := range m.ProtocolFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchModePoolIds) > 0 {
+		l = 0
+		for _, e := range m.BatchModePoolIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextLimitOrderId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextLimitOrderId))
+	}
+	if len(m.PoolStats) > 0 {
+		for _, e := range m.PoolStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolStatuses) > 0 {
+		for _, e := range m.PoolStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolCreationFeeRecords) > 0 {
+		for _, e := range m.PoolCreationFeeRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Ticks) > 0 {
+		for _, e := range m.Ticks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -375,24 +821,195 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapPruningHorizon", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapPruningHorizon, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatsEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsRetentionEpochs", wireType)
+			}
+			m.StatsRetentionEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatsRetentionEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFeeDestination", wireType)
+			}
+			m.PoolCreationFeeDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolCreationFeeDestination |= PoolCreationFeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFeeDenoms = append(m.PoolCreationFeeDenoms, PoolCreationFeeDenom{})
+			if err := m.PoolCreationFeeDenoms[len(m.PoolCreationFeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
@@ -511,6 +1128,392 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapRecords = append(m.TwapRecords, TwapRecord{})
+			if err := m.TwapRecords[len(m.TwapRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPositionId", wireType)
+			}
+			m.NextPositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFees = append(m.ProtocolFees, types.Coin{})
+			if err := m.ProtocolFees[len(m.ProtocolFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BatchModePoolIds = append(m.BatchModePoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BatchModePoolIds) == 0 {
+					m.BatchModePoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BatchModePoolIds = append(m.BatchModePoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchModePoolIds", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLimitOrderId", wireType)
+			}
+			m.NextLimitOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLimitOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolStats = append(m.PoolStats, PoolEpochStats{})
+			if err := m.PoolStats[len(m.PoolStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolStatuses = append(m.PoolStatuses, PoolStatusRecord{})
+			if err := m.PoolStatuses[len(m.PoolStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFeeRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFeeRecords = append(m.PoolCreationFeeRecords, PoolCreationFeeRecord{})
+			if err := m.PoolCreationFeeRecords[len(m.PoolCreationFeeRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticks = append(m.Ticks, TickInfo{})
+			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
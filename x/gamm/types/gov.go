@@ -0,0 +1,151 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeSetPoolStatus = "SetPoolStatus"
+	ProposalTypeMigratePool   = "MigratePool"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetPoolStatus)
+	govtypes.RegisterProposalTypeCodec(&SetPoolStatusProposal{}, "osmosis/SetPoolStatusProposal")
+	govtypes.RegisterProposalType(ProposalTypeMigratePool)
+	govtypes.RegisterProposalTypeCodec(&MigratePoolProposal{}, "osmosis/MigratePoolProposal")
+}
+
+var _ govtypes.Content = &SetPoolStatusProposal{}
+var _ govtypes.Content = &MigratePoolProposal{}
+
+func NewSetPoolStatusProposal(title, description string, poolId uint64, status PoolStatus) govtypes.Content {
+	return &SetPoolStatusProposal{
+		Title:       title,
+		Description: description,
+		PoolId:      poolId,
+		Status:      status,
+	}
+}
+
+func (p *SetPoolStatusProposal) GetTitle() string { return p.Title }
+
+func (p *SetPoolStatusProposal) GetDescription() string { return p.Description }
+
+func (p *SetPoolStatusProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetPoolStatusProposal) ProposalType() string { return ProposalTypeSetPoolStatus }
+
+func (p *SetPoolStatusProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if err := ValidatePoolStatus(p.Status); err != nil {
+		return err
+	}
+	if p.Status == PoolMigrated {
+		return sdkerrors.Wrapf(ErrInvalidPoolStatus, "pools can only be migrated by a pool migration")
+	}
+
+	return nil
+}
+
+func (p SetPoolStatusProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Pool Status Proposal:
+  Title:       %s
+  Description: %s
+  Pool Id:     %d
+  Status:      %s
+`, p.Title, p.Description, p.PoolId, p.Status))
+	return b.String()
+}
+
+func NewMigratePoolProposal(
+	title, description string,
+	poolId uint64,
+	poolParams BalancerPoolParams,
+	poolAssets []PoolAsset,
+	futurePoolGovernor string,
+) govtypes.Content {
+	return &MigratePoolProposal{
+		Title:              title,
+		Description:        description,
+		PoolId:             poolId,
+		PoolParams:         poolParams,
+		PoolAssets:         poolAssets,
+		FuturePoolGovernor: futurePoolGovernor,
+	}
+}
+
+func (p *MigratePoolProposal) GetTitle() string { return p.Title }
+
+func (p *MigratePoolProposal) GetDescription() string { return p.Description }
+
+func (p *MigratePoolProposal) ProposalRoute() string { return RouterKey }
+
+func (p *MigratePoolProposal) ProposalType() string { return ProposalTypeMigratePool }
+
+func (p *MigratePoolProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.PoolAssets) < MinPoolAssets {
+		return ErrTooFewPoolAssets
+	}
+	if len(p.PoolAssets) > MaxPoolAssets {
+		return sdkerrors.Wrapf(ErrTooManyPoolAssets, "%d", len(p.PoolAssets))
+	}
+
+	denoms := map[string]bool{}
+	for _, asset := range p.PoolAssets {
+		if err := sdk.ValidateDenom(asset.Token.Denom); err != nil {
+			return err
+		}
+		if denoms[asset.Token.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidPoolMigration, "duplicate denom %s", asset.Token.Denom)
+		}
+		denoms[asset.Token.Denom] = true
+
+		if err := ValidateUserSpecifiedWeight(asset.Weight); err != nil {
+			return err
+		}
+	}
+
+	if p.PoolParams.LbpParams != nil {
+		return sdkerrors.Wrapf(ErrInvalidPoolMigration, "pools can't be migrated to liquidity bootstrapping pools")
+	}
+	if err := p.PoolParams.Validate(p.PoolAssets); err != nil {
+		return err
+	}
+
+	return ValidateFutureGovernor(p.FuturePoolGovernor)
+}
+
+func (p MigratePoolProposal) String() string {
+	weightsStr := ""
+	for _, asset := range p.PoolAssets {
+		weightsStr = weightsStr + fmt.Sprintf("(Denom: %s, Weight: %s) ", asset.Token.Denom, asset.Weight)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Migrate Pool Proposal:
+  Title:                %s
+  Description:          %s
+  Pool Id:              %d
+  Swap Fee:             %s
+  Exit Fee:             %s
+  Weights:              %s
+  Future Pool Governor: %s
+`, p.Title, p.Description, p.PoolId, p.PoolParams.SwapFee, p.PoolParams.ExitFee, weightsStr, p.FuturePoolGovernor))
+	return b.String()
+}
@@ -0,0 +1,156 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSetPoolStatusProposal(t *testing.T) {
+	tests := []struct {
+		name       string
+		proposal   SetPoolStatusProposal
+		expectPass bool
+	}{
+		{
+			name:       "pause",
+			proposal:   SetPoolStatusProposal{Title: "title", Description: "description", PoolId: 1, Status: PoolPaused},
+			expectPass: true,
+		},
+		{
+			name:       "deprecate",
+			proposal:   SetPoolStatusProposal{Title: "title", Description: "description", PoolId: 1, Status: PoolDeprecated},
+			expectPass: true,
+		},
+		{
+			name:       "no title",
+			proposal:   SetPoolStatusProposal{Description: "description", PoolId: 1, Status: PoolPaused},
+			expectPass: false,
+		},
+		{
+			name:       "migrated status",
+			proposal:   SetPoolStatusProposal{Title: "title", Description: "description", PoolId: 1, Status: PoolMigrated},
+			expectPass: false,
+		},
+		{
+			name:       "unknown status",
+			proposal:   SetPoolStatusProposal{Title: "title", Description: "description", PoolId: 1, Status: 10},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.proposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.proposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMigratePoolProposal(t *testing.T) {
+	createProposal := func(after func(p MigratePoolProposal) MigratePoolProposal) MigratePoolProposal {
+		properProposal := MigratePoolProposal{
+			Title:       "title",
+			Description: "description",
+			PoolId:      1,
+			PoolParams: BalancerPoolParams{
+				SwapFee: sdk.NewDecWithPrec(1, 2),
+				ExitFee: sdk.ZeroDec(),
+			},
+			PoolAssets: []PoolAsset{
+				{
+					Weight: sdk.NewInt(100),
+					Token:  sdk.NewCoin("test", sdk.ZeroInt()),
+				},
+				{
+					Weight: sdk.NewInt(100),
+					Token:  sdk.NewCoin("test2", sdk.ZeroInt()),
+				},
+			},
+		}
+
+		return after(properProposal)
+	}
+
+	tests := []struct {
+		name       string
+		proposal   MigratePoolProposal
+		expectPass bool
+	}{
+		{
+			name: "proper proposal",
+			proposal: createProposal(func(p MigratePoolProposal) MigratePoolProposal {
+				// Do nothing
+				return p
+			}),
+			expectPass: true,
+		},
+		{
+			name: "no description",
+			proposal: createProposal(func(p MigratePoolProposal) MigratePoolProposal {
+				p.Description = ""
+				return p
+			}),
+			expectPass: false,
+		},
+		{
+			name: "single asset",
+			proposal: createProposal(func(p MigratePoolProposal) MigratePoolProposal {
+				p.PoolAssets = p.PoolAssets[:1]
+				return p
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate denom",
+			proposal: createProposal(func(p MigratePoolProposal) MigratePoolProposal {
+				p.PoolAssets[1].Token.Denom = "test"
+				return p
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero weight",
+			proposal: createProposal(func(p MigratePoolProposal) MigratePoolProposal {
+				p.PoolAssets[0].Weight = sdk.ZeroInt()
+				return p
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative swap fee",
+			proposal: createProposal(func(p MigratePoolProposal) MigratePoolProposal {
+				p.PoolParams.SwapFee = sdk.NewDecWithPrec(-1, 2)
+				return p
+			}),
+			expectPass: false,
+		},
+		{
+			name: "liquidity bootstrapping pool",
+			proposal: createProposal(func(p MigratePoolProposal) MigratePoolProposal {
+				p.PoolParams.LbpParams = &LBPParams{}
+				return p
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid future governor",
+			proposal: createProposal(func(p MigratePoolProposal) MigratePoolProposal {
+				p.FuturePoolGovernor = "invalid"
+				return p
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.proposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.proposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
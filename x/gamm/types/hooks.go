@@ -11,6 +11,9 @@ type GammHooks interface {
 	AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins)
 	// AfterSwap is called after SwapExactAmountIn and SwapExactAmountOut
 	AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins)
+	// AfterPoolMigrated is called after the liquidity of a pool is migrated to a new pool,
+	// once every share of the old pool was replaced by a share of the new pool
+	AfterPoolMigrated(ctx sdk.Context, oldPoolId uint64, newPoolId uint64)
 }
 
 var _ GammHooks = MultiGammHooks{}
@@ -46,3 +49,9 @@ func (h MultiGammHooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId
 		h[i].AfterSwap(ctx, sender, poolId, input, output)
 	}
 }
+
+func (h MultiGammHooks) AfterPoolMigrated(ctx sdk.Context, oldPoolId uint64, newPoolId uint64) {
+	for i := range h {
+		h[i].AfterPoolMigrated(ctx, oldPoolId, newPoolId)
+	}
+}
@@ -2,8 +2,10 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/osmosis-labs/osmosis/v043_temp/address"
 )
 
 const (
@@ -23,12 +25,201 @@ var (
 	KeyPrefixPools = []byte{0x02}
 	// KeyTotalLiquidity defines key to store total liquidity
 	KeyTotalLiquidity = []byte{0x03}
+	// KeyPrefixTwapMostRecent defines prefix to store the most recent TWAP record of each pool asset pair
+	KeyPrefixTwapMostRecent = []byte{0x04}
+	// KeyPrefixTwapHistorical defines prefix to store TWAP records, indexed by pool, asset pair and time
+	KeyPrefixTwapHistorical = []byte{0x05}
+	// KeyPrefixTwapPruning defines prefix to store the TWAP records superseded by a newer record of their asset pair,
+	// indexed by the time of the newer record first for pruning
+	KeyPrefixTwapPruning = []byte{0x06}
+	// KeyPrefixTwapChangedPools defines prefix to store the pools changed in the current block
+	KeyPrefixTwapChangedPools = []byte{0x07}
+	// KeyPrefixPositions defines prefix to store concentrated liquidity positions
+	KeyPrefixPositions = []byte{0x08}
+	// KeyNextPositionId defines key to store the next position ID to be used
+	KeyNextPositionId = []byte{0x09}
+	// KeyPrefixPositionsByOwner defines prefix to index positions by owner
+	KeyPrefixPositionsByOwner = []byte{0x0A}
+	// KeyProtocolFees defines key to store the cumulative swap fees sent to the community pool
+	KeyProtocolFees = []byte{0x0B}
+	// KeyPrefixBatchModePools defines prefix to store the pools in batch mode
+	KeyPrefixBatchModePools = []byte{0x0C}
+	// KeyPrefixQueuedSwaps defines prefix to store the swaps queued on pools in batch mode, by pool
+	KeyPrefixQueuedSwaps = []byte{0x0D}
+	// KeyNextQueuedSwapId defines key to store the next queued swap ID to be used
+	KeyNextQueuedSwapId = []byte{0x0E}
+	// KeyPrefixBatchResults defines prefix to store the last batch result of each pool
+	KeyPrefixBatchResults = []byte{0x0F}
+	// KeyPrefixLimitOrders defines prefix to store limit orders
+	KeyPrefixLimitOrders = []byte{0x10}
+	// KeyNextLimitOrderId defines key to store the next limit order ID to be used
+	KeyNextLimitOrderId = []byte{0x11}
+	// KeyPrefixLimitOrdersByOwner defines prefix to index limit orders by owner
+	KeyPrefixLimitOrdersByOwner = []byte{0x12}
+	// KeyPrefixLimitOrdersByPrice defines prefix to index limit orders by pool, asset pair and trigger price
+	KeyPrefixLimitOrdersByPrice = []byte{0x13}
+	// KeyPrefixPoolStats defines prefix to store the stats of pools, by pool and epoch
+	KeyPrefixPoolStats = []byte{0x14}
+	// KeyPrefixPoolStatuses defines prefix to store the statuses of the pools that aren't active
+	KeyPrefixPoolStatuses = []byte{0x15}
+	// KeyPrefixPoolCreationFees defines prefix to store the creation fees paid for pools
+	KeyPrefixPoolCreationFees = []byte{0x16}
+	// KeyPrefixTicks defines prefix to store the initialized ticks of concentrated pools, by pool
+	KeyPrefixTicks = []byte{0x17}
+
+	// KeySeparator separates denoms and times in TWAP keys.
+	// It is not a valid denom character.
+	KeySeparator = []byte("|")
 )
 
 func GetPoolShareDenom(poolId uint64) string {
 	return fmt.Sprintf("gamm/pool/%d", poolId)
 }
 
+// GetPoolIdFromShareDenom returns the id of the pool whose shares are denom.
+func GetPoolIdFromShareDenom(denom string) (uint64, error) {
+	var poolId uint64
+	if _, err := fmt.Sscanf(denom, "gamm/pool/%d", &poolId); err != nil || GetPoolShareDenom(poolId) != denom {
+		return 0, fmt.Errorf("%s is not a pool share denom", denom)
+	}
+	return poolId, nil
+}
+
+// GetPoolShareDisplayDenom returns the display denom of the shares of a pool created without a share symbol.
+func GetPoolShareDisplayDenom(poolId uint64) string {
+	return fmt.Sprintf("GAMM-%d", poolId)
+}
+
 func GetKeyPrefixPools(poolId uint64) []byte {
 	return append(KeyPrefixPools, sdk.Uint64ToBigEndian(poolId)...)
 }
+
+func GetKeyTwapChangedPool(poolId uint64) []byte {
+	return append(KeyPrefixTwapChangedPools, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyPrefixTwapPair returns the suffix identifying a pool and asset pair in TWAP keys.
+func GetKeyPrefixTwapPair(poolId uint64, denom0, denom1 string) []byte {
+	key := sdk.Uint64ToBigEndian(poolId)
+	key = append(key, []byte(denom0)...)
+	key = append(key, KeySeparator...)
+	return append(key, []byte(denom1)...)
+}
+
+func GetKeyTwapMostRecent(poolId uint64, denom0, denom1 string) []byte {
+	return combineKeys(KeyPrefixTwapMostRecent, GetKeyPrefixTwapPair(poolId, denom0, denom1))
+}
+
+func GetKeyPrefixTwapHistorical(poolId uint64, denom0, denom1 string) []byte {
+	return combineKeys(KeyPrefixTwapHistorical, GetKeyPrefixTwapPair(poolId, denom0, denom1), KeySeparator)
+}
+
+func GetKeyTwapHistorical(poolId uint64, denom0, denom1 string, t time.Time) []byte {
+	return combineKeys(GetKeyPrefixTwapHistorical(poolId, denom0, denom1), sdk.FormatTimeBytes(t))
+}
+
+func GetKeyPrefixTwapPruning(supersededAt time.Time) []byte {
+	return combineKeys(KeyPrefixTwapPruning, sdk.FormatTimeBytes(supersededAt))
+}
+
+func GetKeyTwapPruning(poolId uint64, denom0, denom1 string, supersededAt time.Time) []byte {
+	return combineKeys(GetKeyPrefixTwapPruning(supersededAt), KeySeparator, GetKeyPrefixTwapPair(poolId, denom0, denom1))
+}
+
+func GetKeyPosition(positionId uint64) []byte {
+	return append(KeyPrefixPositions, sdk.Uint64ToBigEndian(positionId)...)
+}
+
+func GetKeyPrefixPositionsByOwner(owner sdk.AccAddress) []byte {
+	return combineKeys(KeyPrefixPositionsByOwner, address.MustLengthPrefix(owner))
+}
+
+func GetKeyPositionByOwner(owner sdk.AccAddress, positionId uint64) []byte {
+	return combineKeys(GetKeyPrefixPositionsByOwner(owner), sdk.Uint64ToBigEndian(positionId))
+}
+
+func GetKeyBatchModePool(poolId uint64) []byte {
+	return combineKeys(KeyPrefixBatchModePools, sdk.Uint64ToBigEndian(poolId))
+}
+
+func GetKeyPrefixQueuedSwaps(poolId uint64) []byte {
+	return combineKeys(KeyPrefixQueuedSwaps, sdk.Uint64ToBigEndian(poolId))
+}
+
+func GetKeyQueuedSwap(poolId, swapId uint64) []byte {
+	return combineKeys(GetKeyPrefixQueuedSwaps(poolId), sdk.Uint64ToBigEndian(swapId))
+}
+
+func GetKeyBatchResult(poolId uint64) []byte {
+	return combineKeys(KeyPrefixBatchResults, sdk.Uint64ToBigEndian(poolId))
+}
+
+func GetKeyLimitOrder(orderId uint64) []byte {
+	return combineKeys(KeyPrefixLimitOrders, sdk.Uint64ToBigEndian(orderId))
+}
+
+func GetKeyPrefixLimitOrdersByOwner(owner sdk.AccAddress) []byte {
+	return combineKeys(KeyPrefixLimitOrdersByOwner, address.MustLengthPrefix(owner))
+}
+
+func GetKeyLimitOrderByOwner(owner sdk.AccAddress, orderId uint64) []byte {
+	return combineKeys(GetKeyPrefixLimitOrdersByOwner(owner), sdk.Uint64ToBigEndian(orderId))
+}
+
+func GetKeyPrefixPoolLimitOrders(poolId uint64) []byte {
+	return combineKeys(KeyPrefixLimitOrdersByPrice, sdk.Uint64ToBigEndian(poolId))
+}
+
+// GetKeyPrefixLimitOrdersByPrice returns the prefix of the limit orders selling tokenInDenom for tokenOutDenom on a pool.
+// The separator is appended, so that it isn't a prefix of the keys of denoms starting with tokenOutDenom.
+func GetKeyPrefixLimitOrdersByPrice(poolId uint64, tokenInDenom, tokenOutDenom string) []byte {
+	return combineKeys(KeyPrefixLimitOrdersByPrice, GetKeyPrefixTwapPair(poolId, tokenInDenom, tokenOutDenom), KeySeparator)
+}
+
+// GetKeyLimitOrderByPrice returns the key of a limit order in the price index.
+// Orders sort by increasing trigger price, then by id.
+func GetKeyLimitOrderByPrice(poolId uint64, tokenInDenom, tokenOutDenom string, triggerPrice sdk.Dec, orderId uint64) []byte {
+	return combineKeys(GetKeyPrefixLimitOrdersByPrice(poolId, tokenInDenom, tokenOutDenom), sdk.SortableDecBytes(triggerPrice), sdk.Uint64ToBigEndian(orderId))
+}
+
+func GetKeyPrefixPoolStats(poolId uint64) []byte {
+	return combineKeys(KeyPrefixPoolStats, sdk.Uint64ToBigEndian(poolId))
+}
+
+// GetKeyPoolStats returns the key of the stats of a pool during an epoch.
+// Epochs are not negative, so that the stats of a pool sort by increasing epoch.
+func GetKeyPoolStats(poolId uint64, epoch int64) []byte {
+	return combineKeys(GetKeyPrefixPoolStats(poolId), sdk.Uint64ToBigEndian(uint64(epoch)))
+}
+
+func GetKeyPoolStatus(poolId uint64) []byte {
+	return combineKeys(KeyPrefixPoolStatuses, sdk.Uint64ToBigEndian(poolId))
+}
+
+func GetKeyPoolCreationFee(poolId uint64) []byte {
+	return combineKeys(KeyPrefixPoolCreationFees, sdk.Uint64ToBigEndian(poolId))
+}
+
+func GetKeyPrefixTicks(poolId uint64) []byte {
+	return combineKeys(KeyPrefixTicks, sdk.Uint64ToBigEndian(poolId))
+}
+
+// GetKeyTick returns the key of an initialized tick of a concentrated pool.
+// The ticks of a pool sort by increasing tick index.
+func GetKeyTick(poolId uint64, tick int64) []byte {
+	return combineKeys(GetKeyPrefixTicks(poolId), TickIndexBytes(tick))
+}
+
+// TickIndexBytes returns the big endian encoding of tick with its sign bit flipped,
+// so that negative ticks sort before positive ones.
+func TickIndexBytes(tick int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(tick) ^ (1 << 63))
+}
+
+func combineKeys(keys ...[]byte) []byte {
+	combined := []byte{}
+	for _, key := range keys {
+		combined = append(combined, key...)
+	}
+	return combined
+}
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"bytes"
 	"math"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -22,3 +24,52 @@ func TestGetPoolShareDenom(t *testing.T) {
 	require.NoError(t, sdk.ValidateDenom(denom))
 	require.Equal(t, "gamm/pool/18446744073709551615", denom)
 }
+
+func TestGetPoolIdFromShareDenom(t *testing.T) {
+	poolId, err := GetPoolIdFromShareDenom(GetPoolShareDenom(10))
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), poolId)
+
+	for _, denom := range []string{"uosmo", "gamm/pool/", "gamm/pool/1a", "gamm/pool/01", "gamm/pool/-1"} {
+		_, err = GetPoolIdFromShareDenom(denom)
+		require.Error(t, err, denom)
+	}
+}
+
+func TestTwapHistoricalKeyOrdering(t *testing.T) {
+	earlier := time.Unix(1000, 0)
+	later := time.Unix(1000, int64(time.Millisecond))
+
+	// Records of a pair are ordered by time, and stay within the pair's prefix.
+	prefix := GetKeyPrefixTwapHistorical(1, "bar", "foo")
+	earlierKey := GetKeyTwapHistorical(1, "bar", "foo", earlier)
+	laterKey := GetKeyTwapHistorical(1, "bar", "foo", later)
+	require.True(t, bytes.HasPrefix(earlierKey, prefix))
+	require.True(t, bytes.HasPrefix(laterKey, prefix))
+	require.Equal(t, -1, bytes.Compare(earlierKey, laterKey))
+
+	// The denoms are separated, so that pairs never share a prefix.
+	require.False(t, bytes.HasPrefix(GetKeyTwapHistorical(1, "bar", "foo2", earlier), prefix))
+
+	// The pruning index is ordered by time first.
+	require.Equal(t, -1, bytes.Compare(
+		GetKeyTwapPruning(2, "bar", "foo", earlier),
+		GetKeyTwapPruning(1, "bar", "foo", later),
+	))
+	require.Equal(t, -1, bytes.Compare(
+		GetKeyTwapPruning(2, "bar", "foo", earlier),
+		GetKeyPrefixTwapPruning(later),
+	))
+}
+
+func TestTickKeyOrdering(t *testing.T) {
+	// Ticks of a pool are ordered by tick index, negative ticks first.
+	ticks := []int64{MinTick, -1000, -1, 0, 1, 1000, MaxTick}
+	for i := 1; i < len(ticks); i++ {
+		require.Equal(t, -1, bytes.Compare(GetKeyTick(1, ticks[i-1]), GetKeyTick(1, ticks[i])), ticks[i])
+		require.True(t, bytes.HasPrefix(GetKeyTick(1, ticks[i]), GetKeyPrefixTicks(1)))
+	}
+
+	// And stay within the pool's prefix.
+	require.Equal(t, -1, bytes.Compare(GetKeyTick(1, MaxTick), GetKeyPrefixTicks(2)))
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks the sale window of the LBP params given at pool creation.
+// The sale start time can be left blank.
+func (params LBPParams) Validate() error {
+	if params.SaleEndTime.Unix() <= 0 {
+		return sdkerrors.Wrap(ErrInvalidLBP, "sale end time must be set")
+	}
+
+	if params.SaleStartTime.Unix() > 0 && !params.SaleEndTime.After(params.SaleStartTime) {
+		return sdkerrors.Wrapf(ErrInvalidLBP, "sale end time %s is not after sale start time %s",
+			params.SaleEndTime, params.SaleStartTime)
+	}
+	return nil
+}
+
+// Status returns the status of the sale at curTime.
+func (params LBPParams) Status(curTime time.Time) LBPStatus {
+	switch {
+	case params.Finalized:
+		return LBPFinalized
+	case curTime.Before(params.SaleStartTime):
+		return LBPSalePending
+	case curTime.Before(params.SaleEndTime):
+		return LBPSaleOngoing
+	default:
+		return LBPSaleEnded
+	}
+}
+
+// IsLBP returns whether the pool is a liquidity bootstrapping pool that wasn't reopened.
+func (pa BalancerPool) IsLBP() bool {
+	return pa.PoolParams.LbpParams != nil
+}
+
+// LBPExitCoins returns the coins the creator of a liquidity bootstrapping pool withdraws
+// for shareInAmount when finalizing it, which don't pay the exit fee.
+func (pa BalancerPool) LBPExitCoins(shareInAmount sdk.Int) (sdk.Coins, error) {
+	if shareInAmount.GTE(pa.TotalShares.Amount) {
+		return nil, sdkerrors.Wrapf(ErrInvalidLBP, "cannot withdraw all the %s shares of the pool", pa.TotalShares.Amount)
+	}
+	return getProportionalCoins(pa.PoolAssets, pa.TotalShares.Amount, shareInAmount)
+}
+
+// ReopenLBP turns a liquidity bootstrapping pool into a regular balancer pool with the given weights,
+// stopping any weight change.
+func (pa *BalancerPool) ReopenLBP(weights []PoolAsset) error {
+	if len(weights) != len(pa.PoolAssets) {
+		return ErrPoolParamsInvalidNumDenoms
+	}
+
+	newWeights := SortPoolAssetsOutOfPlaceByDenom(weights)
+	for i, v := range newWeights {
+		if v.Token.Denom != pa.PoolAssets[i].Token.Denom {
+			return ErrPoolParamsInvalidDenom
+		}
+		if err := ValidateUserSpecifiedWeight(v.Weight); err != nil {
+			return err
+		}
+		newWeights[i] = PoolAsset{
+			Weight: v.Weight.MulRaw(GuaranteedWeightPrecision),
+			Token:  v.Token,
+		}
+	}
+
+	pa.updateAllWeights(newWeights)
+	pa.PoolParams.SmoothWeightChangeParams = nil
+	pa.PoolParams.LbpParams = nil
+	return nil
+}
+
+// CloseLBP finalizes a liquidity bootstrapping pool without reopening it, so that it can't be
+// swapped against or joined anymore.
+func (pa *BalancerPool) CloseLBP() {
+	pa.PoolParams.LbpParams.Finalized = true
+}
+
+// ProjectedSpotPrice returns the spot price of baseDenom in quoteDenom at projectedTime, assuming the
+// pool balances don't change until then, so that only its weight change applies.
+func (pa BalancerPool) ProjectedSpotPrice(projectedTime time.Time, baseDenom, quoteDenom string) (sdk.Dec, error) {
+	projected := pa
+	projected.PoolAssets = append([]PoolAsset{}, pa.PoolAssets...)
+	projected.PokeTokenWeights(projectedTime)
+	return projected.SpotPrice(quoteDenom, baseDenom, sdk.ZeroDec())
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateTriggerPrice checks that a limit order's trigger price is positive, and small enough to be indexed.
+func ValidateTriggerPrice(triggerPrice sdk.Dec) error {
+	if triggerPrice.IsNil() || !triggerPrice.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidLimitOrder, "trigger price should be positive")
+	}
+	if triggerPrice.GT(sdk.MaxSortableDec) {
+		return sdkerrors.Wrapf(ErrInvalidLimitOrder, "trigger price should be at most %s", sdk.MaxSortableDec)
+	}
+	return nil
+}
+
+// MinTokenOut returns the least amount of the order's token out a fill can return.
+func (order LimitOrder) MinTokenOut() sdk.Coin {
+	return sdk.NewCoin(order.TokenOutDenom, order.TriggerPrice.MulInt(order.TokenIn.Amount).Ceil().TruncateInt())
+}
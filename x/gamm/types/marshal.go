@@ -116,3 +116,91 @@ func (pa *BalancerPool) UnmarshalJSON(bz []byte) error {
 
 	return nil
 }
+
+type stableswapPoolPretty struct {
+	Address                sdk.AccAddress       `json:"address" yaml:"address"`
+	Id                     uint64               `json:"id" yaml:"id"`
+	PoolParams             StableswapPoolParams `json:"pool_params" yaml:"pool_params"`
+	FuturePoolGovernor     string               `json:"future_pool_governor" yaml:"future_pool_governor"`
+	AmplificationParameter uint64               `json:"amplification_parameter" yaml:"amplification_parameter"`
+	TotalShares            sdk.Coin             `json:"total_shares" yaml:"total_shares"`
+	PoolAssets             []PoolAsset          `json:"pool_assets" yaml:"pool_assets"`
+}
+
+type concentratedPoolPretty struct {
+	Address            sdk.AccAddress         `json:"address" yaml:"address"`
+	Id                 uint64                 `json:"id" yaml:"id"`
+	PoolParams         ConcentratedPoolParams `json:"pool_params" yaml:"pool_params"`
+	FuturePoolGovernor string                 `json:"future_pool_governor" yaml:"future_pool_governor"`
+	Token0             sdk.Coin               `json:"token0" yaml:"token0"`
+	Token1             sdk.Coin               `json:"token1" yaml:"token1"`
+	TickSpacing        uint64                 `json:"tick_spacing" yaml:"tick_spacing"`
+	CurrentSqrtPrice   sdk.Dec                `json:"current_sqrt_price" yaml:"current_sqrt_price"`
+	CurrentTick        int64                  `json:"current_tick" yaml:"current_tick"`
+	Liquidity          sdk.Dec                `json:"liquidity" yaml:"liquidity"`
+	FeeGrowthGlobal0   sdk.Dec                `json:"fee_growth_global0" yaml:"fee_growth_global0"`
+	FeeGrowthGlobal1   sdk.Dec                `json:"fee_growth_global1" yaml:"fee_growth_global1"`
+}
+
+func (pa ConcentratedPool) String() string {
+	out, _ := pa.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ConcentratedPool.
+func (pa ConcentratedPool) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(pa.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := yaml.Marshal(concentratedPoolPretty{
+		Address:            accAddr,
+		Id:                 pa.Id,
+		PoolParams:         pa.PoolParams,
+		FuturePoolGovernor: pa.FuturePoolGovernor,
+		Token0:             pa.Token0,
+		Token1:             pa.Token1,
+		TickSpacing:        pa.TickSpacing,
+		CurrentSqrtPrice:   pa.CurrentSqrtPrice,
+		CurrentTick:        pa.CurrentTick,
+		Liquidity:          pa.Liquidity,
+		FeeGrowthGlobal0:   pa.FeeGrowthGlobal0,
+		FeeGrowthGlobal1:   pa.FeeGrowthGlobal1,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bz), nil
+}
+
+func (pa StableswapPool) String() string {
+	out, _ := pa.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a StableswapPool.
+func (pa StableswapPool) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(pa.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := yaml.Marshal(stableswapPoolPretty{
+		Address:                accAddr,
+		Id:                     pa.Id,
+		PoolParams:             pa.PoolParams,
+		FuturePoolGovernor:     pa.FuturePoolGovernor,
+		AmplificationParameter: pa.AmplificationParameter,
+		TotalShares:            pa.TotalShares,
+		PoolAssets:             pa.PoolAssets,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bz), nil
+}
@@ -43,6 +43,7 @@ func TestBalancerPoolMarshalYAML(t *testing.T) {
     swap_fee: "0.025000000000000000"
     exit_fee: "0.025000000000000000"
     smooth_weight_change_params: null
+    lbp_params: null
   future_pool_governor: ""
   total_weight: "300.000000000000000000"
   total_shares:
@@ -123,6 +124,7 @@ func TestLBPPoolMarshalYAML(t *testing.T) {
           denom: test2
           amount: "0"
         weight: "300.000000000000000000"
+    lbp_params: null
   future_pool_governor: ""
   total_weight: "300.000000000000000000"
   total_shares:
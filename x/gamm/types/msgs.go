@@ -2,24 +2,44 @@ package types
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // constants
 const (
-	TypeMsgCreatePool              = "create_pool"
-	TypeMsgSwapExactAmountIn       = "swap_exact_amount_in"
-	TypeMsgSwapExactAmountOut      = "swap_exact_amount_out"
-	TypeMsgJoinPool                = "join_pool"
-	TypeMsgExitPool                = "exit_pool"
-	TypeMsgJoinSwapExternAmountIn  = "join_swap_extern_amount_in"
-	TypeMsgJoinSwapShareAmountOut  = "join_swap_share_amount_out"
-	TypeMsgExitSwapExternAmountOut = "exit_swap_extern_amount_out"
-	TypeMsgExitSwapShareAmountIn   = "exit_swap_share_amount_in"
+	TypeMsgCreatePool                  = "create_pool"
+	TypeMsgCreateStableswapPool        = "create_stableswap_pool"
+	TypeMsgCreateConcentratedPool      = "create_concentrated_pool"
+	TypeMsgCreatePosition              = "create_position"
+	TypeMsgWithdrawPosition            = "withdraw_position"
+	TypeMsgCollectFees                 = "collect_fees"
+	TypeMsgSwapExactAmountIn           = "swap_exact_amount_in"
+	TypeMsgSwapExactAmountOut          = "swap_exact_amount_out"
+	TypeMsgSplitRouteSwapExactAmountIn = "split_route_swap_exact_amount_in"
+	TypeMsgJoinPool                    = "join_pool"
+	TypeMsgExitPool                    = "exit_pool"
+	TypeMsgJoinSwapExternAmountIn      = "join_swap_extern_amount_in"
+	TypeMsgJoinSwapShareAmountOut      = "join_swap_share_amount_out"
+	TypeMsgExitSwapExternAmountOut     = "exit_swap_extern_amount_out"
+	TypeMsgExitSwapShareAmountIn       = "exit_swap_share_amount_in"
+	TypeMsgSetPoolSwapFee              = "set_pool_swap_fee"
+	TypeMsgSetPoolExitFee              = "set_pool_exit_fee"
+	TypeMsgScheduleWeightChange        = "schedule_weight_change"
+	TypeMsgSetPoolBatchMode            = "set_pool_batch_mode"
+	TypeMsgPlaceLimitOrder             = "place_limit_order"
+	TypeMsgCancelLimitOrder            = "cancel_limit_order"
+	TypeMsgFlashSwap                   = "flash_swap"
+	TypeMsgFinalizeLBP                 = "finalize_lbp"
+	TypeMsgMigratePoolShares           = "migrate_pool_shares"
+
+	TypeMsgSwapExactAmountInWithPriceImpact  = "swap_exact_amount_in_with_price_impact"
+	TypeMsgSwapExactAmountOutWithPriceImpact = "swap_exact_amount_out_with_price_impact"
 )
 
 func ValidateFutureGovernor(governor string) error {
@@ -63,6 +83,38 @@ func ValidateFutureGovernor(governor string) error {
 	return nil
 }
 
+var rePoolShareSymbol = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]{2,}$`)
+
+// ValidatePoolShareSymbol validates the display denom a pool creator chose for the pool shares.
+// An empty symbol is valid, the shares are then displayed as GAMM-<pool id>.
+func ValidatePoolShareSymbol(symbol string) error {
+	if symbol == "" {
+		return nil
+	}
+
+	if len(symbol) > MaxPoolShareSymbolLength {
+		return sdkerrors.Wrapf(ErrInvalidPoolShareSymbol, "symbol %s is longer than %d characters", symbol, MaxPoolShareSymbolLength)
+	}
+
+	if !rePoolShareSymbol.MatchString(symbol) {
+		return sdkerrors.Wrapf(ErrInvalidPoolShareSymbol, "symbol %s should be letters and digits, starting with a letter", symbol)
+	}
+
+	// The gamm prefix is kept for the share denoms and their default display denoms.
+	if strings.HasPrefix(strings.ToLower(symbol), "gamm") {
+		return sdkerrors.Wrapf(ErrInvalidPoolShareSymbol, "symbol %s starts with gamm", symbol)
+	}
+	return nil
+}
+
+// validatePoolCreationFeeDenom checks the denom a pool creation fee is paid in, which is empty to pay it as set in the params.
+func validatePoolCreationFeeDenom(feeDenom string) error {
+	if feeDenom == "" {
+		return nil
+	}
+	return sdk.ValidateDenom(feeDenom)
+}
+
 var _ sdk.Msg = &MsgCreateBalancerPool{}
 
 func (msg MsgCreateBalancerPool) Route() string { return RouterKey }
@@ -89,7 +141,11 @@ func (msg MsgCreateBalancerPool) ValidateBasic() error {
 		return err
 	}
 
-	return nil
+	if err = ValidatePoolShareSymbol(msg.ShareSymbol); err != nil {
+		return err
+	}
+
+	return validatePoolCreationFeeDenom(msg.FeeDenom)
 }
 func (msg MsgCreateBalancerPool) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
@@ -102,6 +158,191 @@ func (msg MsgCreateBalancerPool) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgCreateStableswapPool{}
+
+func (msg MsgCreateStableswapPool) Route() string { return RouterKey }
+func (msg MsgCreateStableswapPool) Type() string  { return TypeMsgCreateStableswapPool }
+func (msg MsgCreateStableswapPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	// The pool must be swapping between at least two assets
+	if len(msg.InitialPoolLiquidity) < MinPoolAssets {
+		return ErrTooFewPoolAssets
+	}
+
+	if len(msg.InitialPoolLiquidity) > MaxPoolAssets {
+		return sdkerrors.Wrapf(ErrTooManyPoolAssets, "%d", len(msg.InitialPoolLiquidity))
+	}
+
+	if !msg.InitialPoolLiquidity.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.InitialPoolLiquidity.String())
+	}
+
+	err = msg.PoolParams.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = ValidateAmplificationParameter(msg.AmplificationParameter)
+	if err != nil {
+		return err
+	}
+
+	// validation for future owner
+	if err = ValidateFutureGovernor(msg.FuturePoolGovernor); err != nil {
+		return err
+	}
+
+	return validatePoolCreationFeeDenom(msg.FeeDenom)
+}
+func (msg MsgCreateStableswapPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgCreateStableswapPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCreateConcentratedPool{}
+
+func (msg MsgCreateConcentratedPool) Route() string { return RouterKey }
+func (msg MsgCreateConcentratedPool) Type() string  { return TypeMsgCreateConcentratedPool }
+func (msg MsgCreateConcentratedPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = msg.PoolParams.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = ValidateConcentratedPoolDenoms(msg.Denom0, msg.Denom1)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateTickSpacing(msg.TickSpacing)
+	if err != nil {
+		return err
+	}
+
+	_, err = initialSqrtPrice(msg.InitialPrice)
+	if err != nil {
+		return err
+	}
+
+	// validation for future owner
+	if err = ValidateFutureGovernor(msg.FuturePoolGovernor); err != nil {
+		return err
+	}
+
+	return validatePoolCreationFeeDenom(msg.FeeDenom)
+}
+func (msg MsgCreateConcentratedPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgCreateConcentratedPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCreatePosition{}
+
+func (msg MsgCreatePosition) Route() string { return RouterKey }
+func (msg MsgCreatePosition) Type() string  { return TypeMsgCreatePosition }
+func (msg MsgCreatePosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.LowerTick >= msg.UpperTick || msg.LowerTick < MinTick || msg.UpperTick > MaxTick {
+		return sdkerrors.Wrapf(ErrInvalidTick, "ticks should be ordered and between %d and %d", MinTick, MaxTick)
+	}
+
+	if msg.TokenDesired0.IsNegative() || msg.TokenDesired1.IsNegative() ||
+		!msg.TokenDesired0.Add(msg.TokenDesired1).IsPositive() {
+		return sdkerrors.Wrapf(ErrNotPositiveRequireAmount, "desired amounts should not be negative, and not both zero")
+	}
+
+	if msg.TokenMinAmount0.IsNegative() || msg.TokenMinAmount1.IsNegative() {
+		return sdkerrors.Wrapf(ErrNotPositiveCriteria, "min amounts should not be negative")
+	}
+
+	return nil
+}
+func (msg MsgCreatePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgCreatePosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgWithdrawPosition{}
+
+func (msg MsgWithdrawPosition) Route() string { return RouterKey }
+func (msg MsgWithdrawPosition) Type() string  { return TypeMsgWithdrawPosition }
+func (msg MsgWithdrawPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.Liquidity.IsNil() || !msg.Liquidity.IsPositive() {
+		return ErrInvalidLiquidity
+	}
+
+	return nil
+}
+func (msg MsgWithdrawPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgWithdrawPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCollectFees{}
+
+func (msg MsgCollectFees) Route() string { return RouterKey }
+func (msg MsgCollectFees) Type() string  { return TypeMsgCollectFees }
+func (msg MsgCollectFees) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+func (msg MsgCollectFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgCollectFees) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgSwapExactAmountIn{}
 
 func (msg MsgSwapExactAmountIn) Route() string { return RouterKey }
@@ -138,6 +379,43 @@ func (msg MsgSwapExactAmountIn) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgSplitRouteSwapExactAmountIn{}
+
+func (msg MsgSplitRouteSwapExactAmountIn) Route() string { return RouterKey }
+func (msg MsgSplitRouteSwapExactAmountIn) Type() string  { return TypeMsgSplitRouteSwapExactAmountIn }
+func (msg MsgSplitRouteSwapExactAmountIn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = SwapAmountInSplitRoutes(msg.Routes).Validate()
+	if err != nil {
+		return err
+	}
+
+	err = sdk.ValidateDenom(msg.TokenInDenom)
+	if err != nil {
+		return err
+	}
+
+	if !msg.TokenOutMinAmount.IsPositive() {
+		return ErrNotPositiveCriteria
+	}
+
+	return nil
+}
+func (msg MsgSplitRouteSwapExactAmountIn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgSplitRouteSwapExactAmountIn) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgSwapExactAmountOut{}
 
 func (msg MsgSwapExactAmountOut) Route() string { return RouterKey }
@@ -372,3 +650,438 @@ func (msg MsgExitSwapShareAmountIn) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetPoolSwapFee{}
+
+func (msg MsgSetPoolSwapFee) Route() string { return RouterKey }
+func (msg MsgSetPoolSwapFee) Type() string  { return TypeMsgSetPoolSwapFee }
+func (msg MsgSetPoolSwapFee) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return ValidateSwapFee(msg.SwapFee)
+}
+func (msg MsgSetPoolSwapFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgSetPoolSwapFee) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetPoolExitFee{}
+
+func (msg MsgSetPoolExitFee) Route() string { return RouterKey }
+func (msg MsgSetPoolExitFee) Type() string  { return TypeMsgSetPoolExitFee }
+func (msg MsgSetPoolExitFee) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return ValidateExitFee(msg.ExitFee)
+}
+func (msg MsgSetPoolExitFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgSetPoolExitFee) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgScheduleWeightChange{}
+
+func (msg MsgScheduleWeightChange) Route() string { return RouterKey }
+func (msg MsgScheduleWeightChange) Type() string  { return TypeMsgScheduleWeightChange }
+func (msg MsgScheduleWeightChange) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.Duration <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "weight change duration must be positive: %s", msg.Duration)
+	}
+
+	if len(msg.TargetPoolWeights) == 0 {
+		return ErrEmptyPoolAssets
+	}
+
+	for _, asset := range msg.TargetPoolWeights {
+		if err := sdk.ValidateDenom(asset.Token.Denom); err != nil {
+			return err
+		}
+
+		if err := ValidateUserSpecifiedWeight(asset.Weight); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+func (msg MsgScheduleWeightChange) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgScheduleWeightChange) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetPoolBatchMode{}
+
+func (msg MsgSetPoolBatchMode) Route() string { return RouterKey }
+func (msg MsgSetPoolBatchMode) Type() string  { return TypeMsgSetPoolBatchMode }
+func (msg MsgSetPoolBatchMode) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+func (msg MsgSetPoolBatchMode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgSetPoolBatchMode) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgPlaceLimitOrder{}
+
+func (msg MsgPlaceLimitOrder) Route() string { return RouterKey }
+func (msg MsgPlaceLimitOrder) Type() string  { return TypeMsgPlaceLimitOrder }
+func (msg MsgPlaceLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenIn.String())
+	}
+
+	err = sdk.ValidateDenom(msg.TokenOutDenom)
+	if err != nil {
+		return err
+	}
+
+	if msg.TokenIn.Denom == msg.TokenOutDenom {
+		return sdkerrors.Wrapf(ErrInvalidLimitOrder, "cannot trade same denomination in and out")
+	}
+
+	return ValidateTriggerPrice(msg.TriggerPrice)
+}
+func (msg MsgPlaceLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCancelLimitOrder{}
+
+func (msg MsgCancelLimitOrder) Route() string { return RouterKey }
+func (msg MsgCancelLimitOrder) Type() string  { return TypeMsgCancelLimitOrder }
+func (msg MsgCancelLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+func (msg MsgCancelLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var (
+	_ sdk.Msg                            = &MsgFlashSwap{}
+	_ codectypes.UnpackInterfacesMessage = MsgFlashSwap{}
+)
+
+// NewMsgFlashSwap creates a new MsgFlashSwap executing the given gamm msgs.
+func NewMsgFlashSwap(sender sdk.AccAddress, poolId uint64, tokenInDenom string, tokenInMaxAmount sdk.Int, tokenOut sdk.Coin, msgs []sdk.Msg) (*MsgFlashSwap, error) {
+	msg := &MsgFlashSwap{
+		Sender:           sender.String(),
+		PoolId:           poolId,
+		TokenInDenom:     tokenInDenom,
+		TokenInMaxAmount: tokenInMaxAmount,
+		TokenOut:         tokenOut,
+	}
+	for _, nested := range msgs {
+		any, err := codectypes.NewAnyWithValue(nested)
+		if err != nil {
+			return nil, err
+		}
+		msg.Msgs = append(msg.Msgs, any)
+	}
+	return msg, nil
+}
+
+func (msg MsgFlashSwap) Route() string { return RouterKey }
+func (msg MsgFlashSwap) Type() string  { return TypeMsgFlashSwap }
+func (msg MsgFlashSwap) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenOut.IsValid() || !msg.TokenOut.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenOut.String())
+	}
+
+	err = sdk.ValidateDenom(msg.TokenInDenom)
+	if err != nil {
+		return err
+	}
+
+	if msg.TokenInDenom == msg.TokenOut.Denom {
+		return sdkerrors.Wrapf(ErrInvalidFlashSwap, "cannot trade same denomination in and out")
+	}
+
+	if msg.TokenInMaxAmount.IsNil() || !msg.TokenInMaxAmount.IsPositive() {
+		return sdkerrors.Wrapf(ErrNotPositiveRequireAmount, "token in max amount should be positive")
+	}
+
+	if len(msg.Msgs) == 0 || len(msg.Msgs) > MaxFlashSwapMsgs {
+		return sdkerrors.Wrapf(ErrInvalidFlashSwap, "number of msgs should be between 1 and %d", MaxFlashSwapMsgs)
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	for _, nested := range msgs {
+		if !IsFlashSwapNestedMsg(nested) {
+			return sdkerrors.Wrapf(ErrInvalidFlashSwap, "%T can't be executed in a flash swap", nested)
+		}
+
+		signers := nested.GetSigners()
+		if len(signers) != 1 || signers[0].String() != msg.Sender {
+			return sdkerrors.Wrapf(ErrInvalidFlashSwap, "%T should be signed by the sender", nested)
+		}
+
+		err = nested.ValidateBasic()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+func (msg MsgFlashSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgFlashSwap) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// GetMessages returns the unpacked gamm msgs executed by the flash swap.
+func (msg MsgFlashSwap) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, any := range msg.Msgs {
+		nested, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidFlashSwap, "msg %d is not a sdk.Msg", i)
+		}
+		msgs[i] = nested
+	}
+	return msgs, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgFlashSwap) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range msg.Msgs {
+		var nested sdk.Msg
+		err := unpacker.UnpackAny(any, &nested)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsFlashSwapNestedMsg returns whether the msg can be executed within a flash swap.
+// Only swaps, joins and exits can be.
+func IsFlashSwapNestedMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *MsgSwapExactAmountIn, *MsgSwapExactAmountOut, *MsgSplitRouteSwapExactAmountIn,
+		*MsgSwapExactAmountInWithPriceImpact, *MsgSwapExactAmountOutWithPriceImpact,
+		*MsgJoinPool, *MsgExitPool,
+		*MsgJoinSwapExternAmountIn, *MsgJoinSwapShareAmountOut,
+		*MsgExitSwapExternAmountOut, *MsgExitSwapShareAmountIn:
+		return true
+	default:
+		return false
+	}
+}
+
+var _ sdk.Msg = &MsgFinalizeLBP{}
+
+func (msg MsgFinalizeLBP) Route() string { return RouterKey }
+func (msg MsgFinalizeLBP) Type() string  { return TypeMsgFinalizeLBP }
+func (msg MsgFinalizeLBP) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	reopen := len(msg.ReopenPoolWeights) != 0
+	if msg.ShareInAmount.IsNil() || msg.ShareInAmount.IsNegative() || (!reopen && msg.ShareInAmount.IsZero()) {
+		return sdkerrors.Wrapf(ErrInvalidLBP, "invalid share amount")
+	}
+
+	for _, asset := range msg.ReopenPoolWeights {
+		if err := sdk.ValidateDenom(asset.Token.Denom); err != nil {
+			return err
+		}
+
+		if err := ValidateUserSpecifiedWeight(asset.Weight); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+func (msg MsgFinalizeLBP) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgFinalizeLBP) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMigratePoolShares{}
+
+func (msg MsgMigratePoolShares) Route() string { return RouterKey }
+func (msg MsgMigratePoolShares) Type() string  { return TypeMsgMigratePoolShares }
+func (msg MsgMigratePoolShares) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+func (msg MsgMigratePoolShares) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgMigratePoolShares) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// ValidatePriceImpactLimits checks the max price impact of a swap, and its max spot price, which is optional.
+func ValidatePriceImpactLimits(maxPriceImpact, maxSpotPrice sdk.Dec) error {
+	if maxPriceImpact.IsNil() || maxPriceImpact.IsNegative() {
+		return sdkerrors.Wrapf(ErrNotPositiveCriteria, "max price impact must not be negative")
+	}
+	if !maxSpotPrice.IsNil() && maxSpotPrice.IsNegative() {
+		return sdkerrors.Wrapf(ErrNotPositiveCriteria, "max spot price must not be negative")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgSwapExactAmountInWithPriceImpact{}
+
+func (msg MsgSwapExactAmountInWithPriceImpact) Route() string { return RouterKey }
+func (msg MsgSwapExactAmountInWithPriceImpact) Type() string {
+	return TypeMsgSwapExactAmountInWithPriceImpact
+}
+func (msg MsgSwapExactAmountInWithPriceImpact) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = SwapAmountInRoutes(msg.Routes).Validate()
+	if err != nil {
+		return err
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenIn.String())
+	}
+
+	return ValidatePriceImpactLimits(msg.MaxPriceImpact, msg.MaxSpotPrice)
+}
+func (msg MsgSwapExactAmountInWithPriceImpact) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgSwapExactAmountInWithPriceImpact) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSwapExactAmountOutWithPriceImpact{}
+
+func (msg MsgSwapExactAmountOutWithPriceImpact) Route() string { return RouterKey }
+func (msg MsgSwapExactAmountOutWithPriceImpact) Type() string {
+	return TypeMsgSwapExactAmountOutWithPriceImpact
+}
+func (msg MsgSwapExactAmountOutWithPriceImpact) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	err = SwapAmountOutRoutes(msg.Routes).Validate()
+	if err != nil {
+		return err
+	}
+
+	if !msg.TokenOut.IsValid() || !msg.TokenOut.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenOut.String())
+	}
+
+	return ValidatePriceImpactLimits(msg.MaxPriceImpact, msg.MaxSpotPrice)
+}
+func (msg MsgSwapExactAmountOutWithPriceImpact) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+func (msg MsgSwapExactAmountOutWithPriceImpact) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -266,6 +268,217 @@ func TestMsgCreateBalancerPool(t *testing.T) {
 			}),
 			expectPass: true,
 		},
+		{
+			name: "LBP sale",
+			msg: createMsg(func(msg MsgCreateBalancerPool) MsgCreateBalancerPool {
+				msg.PoolParams.LbpParams = &LBPParams{
+					SaleStartTime: time.Now(),
+					SaleEndTime:   time.Now().Add(time.Hour),
+				}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "LBP sale without end time",
+			msg: createMsg(func(msg MsgCreateBalancerPool) MsgCreateBalancerPool {
+				msg.PoolParams.LbpParams = &LBPParams{
+					SaleStartTime: time.Now(),
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "LBP sale ending before its start",
+			msg: createMsg(func(msg MsgCreateBalancerPool) MsgCreateBalancerPool {
+				msg.PoolParams.LbpParams = &LBPParams{
+					SaleStartTime: time.Now(),
+					SaleEndTime:   time.Now().Add(-time.Hour),
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "valid share symbol",
+			msg: createMsg(func(msg MsgCreateBalancerPool) MsgCreateBalancerPool {
+				msg.ShareSymbol = "TESTLP"
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid share symbol",
+			msg: createMsg(func(msg MsgCreateBalancerPool) MsgCreateBalancerPool {
+				msg.ShareSymbol = "TEST/LP"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "too long share symbol",
+			msg: createMsg(func(msg MsgCreateBalancerPool) MsgCreateBalancerPool {
+				msg.ShareSymbol = strings.Repeat("T", MaxPoolShareSymbolLength+1)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "share symbol of the share denoms",
+			msg: createMsg(func(msg MsgCreateBalancerPool) MsgCreateBalancerPool {
+				msg.ShareSymbol = "GAMM2"
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCreateStableswapPool(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgCreateStableswapPool) MsgCreateStableswapPool) MsgCreateStableswapPool {
+		properMsg := MsgCreateStableswapPool{
+			Sender: addr1,
+			PoolParams: StableswapPoolParams{
+				SwapFee: sdk.NewDecWithPrec(1, 3),
+				ExitFee: sdk.NewDecWithPrec(1, 3),
+			},
+			InitialPoolLiquidity:   sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)), sdk.NewCoin("test2", sdk.NewInt(100))),
+			AmplificationParameter: 100,
+		}
+
+		return after(properMsg)
+	}
+
+	default_msg := createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, default_msg.Route(), RouterKey)
+	require.Equal(t, default_msg.Type(), "create_stableswap_pool")
+	signers := default_msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgCreateStableswapPool
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "has no liquidity",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.InitialPoolLiquidity = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "has one asset",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.InitialPoolLiquidity = sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "has too many assets",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.InitialPoolLiquidity = sdk.Coins{}
+				for i := 0; i <= MaxPoolAssets; i++ {
+					msg.InitialPoolLiquidity = append(msg.InitialPoolLiquidity,
+						sdk.NewCoin(fmt.Sprintf("test%d", i), sdk.NewInt(100)))
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "unsorted liquidity",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.InitialPoolLiquidity = sdk.Coins{sdk.NewCoin("test2", sdk.NewInt(100)), sdk.NewCoin("test", sdk.NewInt(100))}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative swap fee",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.PoolParams.SwapFee = sdk.NewDecWithPrec(-1, 2)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative exit fee",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.PoolParams.ExitFee = sdk.NewDecWithPrec(-1, 2)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amplification parameter",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.AmplificationParameter = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "too large amplification parameter",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.AmplificationParameter = MaxStableswapAmplification + 1
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid governor",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.FuturePoolGovernor = "invalid_cosmos_address"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "valid governor: just lock duration for pool token",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.FuturePoolGovernor = "1000h"
+				return msg
+			}),
+			expectPass: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -407,6 +620,139 @@ func TestMsgSwapExactAmountIn(t *testing.T) {
 	}
 }
 
+func TestMsgSplitRouteSwapExactAmountIn(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+		properMsg := MsgSplitRouteSwapExactAmountIn{
+			Sender: addr1,
+			Routes: []SwapAmountInSplitRoute{{
+				Pools: []SwapAmountInRoute{{
+					PoolId:        0,
+					TokenOutDenom: "test2",
+				}},
+				TokenInAmount: sdk.NewInt(100),
+			}, {
+				Pools: []SwapAmountInRoute{{
+					PoolId:        1,
+					TokenOutDenom: "test3",
+				}, {
+					PoolId:        2,
+					TokenOutDenom: "test2",
+				}},
+				TokenInAmount: sdk.NewInt(50),
+			}},
+			TokenInDenom:      "test",
+			TokenOutMinAmount: sdk.NewInt(200),
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "split_route_swap_exact_amount_in")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgSplitRouteSwapExactAmountIn
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.Routes = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty pools of a route",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "too many routes",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				for len(msg.Routes) <= MaxRouteSplits {
+					msg.Routes = append(msg.Routes, msg.Routes[0])
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "routes ending in different denoms",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools[1].TokenOutDenom = "test3"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount route",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[0].TokenInAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.TokenInDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount criteria",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.TokenOutMinAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func TestMsgSwapExactAmountOut(t *testing.T) {
 	appParams.SetAddressPrefixes()
 	pk1 := ed25519.GenPrivKey().PubKey()
@@ -1133,3 +1479,911 @@ func TestMsgExitSwapShareAmountIn(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgSetPoolSwapFee(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgSetPoolSwapFee) MsgSetPoolSwapFee) MsgSetPoolSwapFee {
+		properMsg := MsgSetPoolSwapFee{
+			Sender:  addr1,
+			PoolId:  1,
+			SwapFee: sdk.NewDecWithPrec(1, 2),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgSetPoolSwapFee) MsgSetPoolSwapFee {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "set_pool_swap_fee")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgSetPoolSwapFee
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgSetPoolSwapFee) MsgSetPoolSwapFee {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgSetPoolSwapFee) MsgSetPoolSwapFee {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero swap fee",
+			msg: createMsg(func(msg MsgSetPoolSwapFee) MsgSetPoolSwapFee {
+				msg.SwapFee = sdk.ZeroDec()
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "negative swap fee",
+			msg: createMsg(func(msg MsgSetPoolSwapFee) MsgSetPoolSwapFee {
+				msg.SwapFee = sdk.NewDecWithPrec(-1, 2)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "swap fee of 100%",
+			msg: createMsg(func(msg MsgSetPoolSwapFee) MsgSetPoolSwapFee {
+				msg.SwapFee = sdk.OneDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSetPoolExitFee(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgSetPoolExitFee) MsgSetPoolExitFee) MsgSetPoolExitFee {
+		properMsg := MsgSetPoolExitFee{
+			Sender:  addr1,
+			PoolId:  1,
+			ExitFee: sdk.NewDecWithPrec(1, 2),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgSetPoolExitFee) MsgSetPoolExitFee {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "set_pool_exit_fee")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgSetPoolExitFee
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgSetPoolExitFee) MsgSetPoolExitFee {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgSetPoolExitFee) MsgSetPoolExitFee {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative exit fee",
+			msg: createMsg(func(msg MsgSetPoolExitFee) MsgSetPoolExitFee {
+				msg.ExitFee = sdk.NewDecWithPrec(-1, 2)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "exit fee of 100%",
+			msg: createMsg(func(msg MsgSetPoolExitFee) MsgSetPoolExitFee {
+				msg.ExitFee = sdk.OneDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgScheduleWeightChange(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgScheduleWeightChange) MsgScheduleWeightChange) MsgScheduleWeightChange {
+		properMsg := MsgScheduleWeightChange{
+			Sender:   addr1,
+			PoolId:   1,
+			Duration: time.Hour,
+			TargetPoolWeights: []PoolAsset{
+				{
+					Weight: sdk.NewInt(200),
+					Token:  sdk.NewCoin("test", sdk.ZeroInt()),
+				},
+				{
+					Weight: sdk.NewInt(100),
+					Token:  sdk.NewCoin("test2", sdk.ZeroInt()),
+				},
+			},
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "schedule_weight_change")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgScheduleWeightChange
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero duration",
+			msg: createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+				msg.Duration = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty target weights",
+			msg: createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+				msg.TargetPoolWeights = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero weight",
+			msg: createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+				msg.TargetPoolWeights[0].Weight = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "too large weight",
+			msg: createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+				msg.TargetPoolWeights[0].Weight = sdk.NewInt(1 << 21)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: createMsg(func(msg MsgScheduleWeightChange) MsgScheduleWeightChange {
+				msg.TargetPoolWeights[0].Token.Denom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCreatePosition(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgCreatePosition) MsgCreatePosition) MsgCreatePosition {
+		properMsg := MsgCreatePosition{
+			Sender:          addr1,
+			PoolId:          1,
+			LowerTick:       -1000,
+			UpperTick:       1000,
+			TokenDesired0:   sdk.NewInt(100),
+			TokenDesired1:   sdk.NewInt(100),
+			TokenMinAmount0: sdk.ZeroInt(),
+			TokenMinAmount1: sdk.ZeroInt(),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "create_position")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgCreatePosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "single sided deposit",
+			msg: createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+				msg.TokenDesired1 = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "inverted ticks",
+			msg: createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+				msg.LowerTick, msg.UpperTick = msg.UpperTick, msg.LowerTick
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "out of range tick",
+			msg: createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+				msg.LowerTick = MinTick - 1
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "no desired amounts",
+			msg: createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+				msg.TokenDesired0 = sdk.ZeroInt()
+				msg.TokenDesired1 = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative desired amount",
+			msg: createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+				msg.TokenDesired0 = sdk.NewInt(-1)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative min amount",
+			msg: createMsg(func(msg MsgCreatePosition) MsgCreatePosition {
+				msg.TokenMinAmount1 = sdk.NewInt(-1)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSetPoolBatchMode(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgSetPoolBatchMode) MsgSetPoolBatchMode) MsgSetPoolBatchMode {
+		properMsg := MsgSetPoolBatchMode{
+			Sender:  addr1,
+			PoolId:  1,
+			Enabled: true,
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgSetPoolBatchMode) MsgSetPoolBatchMode {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "set_pool_batch_mode")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgSetPoolBatchMode
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgSetPoolBatchMode) MsgSetPoolBatchMode {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "disable batch mode",
+			msg: createMsg(func(msg MsgSetPoolBatchMode) MsgSetPoolBatchMode {
+				msg.Enabled = false
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgSetPoolBatchMode) MsgSetPoolBatchMode {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgPlaceLimitOrder(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgPlaceLimitOrder) MsgPlaceLimitOrder) MsgPlaceLimitOrder {
+		properMsg := MsgPlaceLimitOrder{
+			Sender:        addr1,
+			PoolId:        1,
+			TokenIn:       sdk.NewCoin("test", sdk.NewInt(100)),
+			TokenOutDenom: "test2",
+			TriggerPrice:  sdk.NewDecWithPrec(15, 1),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgPlaceLimitOrder) MsgPlaceLimitOrder {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "place_limit_order")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgPlaceLimitOrder
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgPlaceLimitOrder) MsgPlaceLimitOrder {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgPlaceLimitOrder) MsgPlaceLimitOrder {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero token in",
+			msg: createMsg(func(msg MsgPlaceLimitOrder) MsgPlaceLimitOrder {
+				msg.TokenIn.Amount = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token out denom",
+			msg: createMsg(func(msg MsgPlaceLimitOrder) MsgPlaceLimitOrder {
+				msg.TokenOutDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "same denom in and out",
+			msg: createMsg(func(msg MsgPlaceLimitOrder) MsgPlaceLimitOrder {
+				msg.TokenOutDenom = "test"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero trigger price",
+			msg: createMsg(func(msg MsgPlaceLimitOrder) MsgPlaceLimitOrder {
+				msg.TriggerPrice = sdk.ZeroDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "trigger price too large",
+			msg: createMsg(func(msg MsgPlaceLimitOrder) MsgPlaceLimitOrder {
+				msg.TriggerPrice = sdk.MaxSortableDec.Add(sdk.OneDec())
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgFlashSwap(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	invalidAddr := sdk.AccAddress("invalid")
+
+	swapMsg := func(sender sdk.AccAddress) sdk.Msg {
+		return &MsgSwapExactAmountIn{
+			Sender:            sender.String(),
+			Routes:            []SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "test2"}},
+			TokenIn:           sdk.NewCoin("test", sdk.NewInt(100)),
+			TokenOutMinAmount: sdk.NewInt(110),
+		}
+	}
+
+	createMsg := func(msgs ...sdk.Msg) MsgFlashSwap {
+		if len(msgs) == 0 {
+			msgs = []sdk.Msg{swapMsg(addr1)}
+		}
+		msg, err := NewMsgFlashSwap(addr1, 1, "test2", sdk.NewInt(110), sdk.NewCoin("test", sdk.NewInt(100)), msgs)
+		require.NoError(t, err)
+		return *msg
+	}
+
+	msg := createMsg()
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "flash_swap")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	nestedFlashSwap := createMsg()
+	tooManyMsgs := make([]sdk.Msg, MaxFlashSwapMsgs+1)
+	for i := range tooManyMsgs {
+		tooManyMsgs[i] = swapMsg(addr1)
+	}
+
+	tests := []struct {
+		name       string
+		msg        MsgFlashSwap
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        createMsg(),
+			expectPass: true,
+		},
+		{
+			name: "proper msg with join and exit",
+			msg: createMsg(
+				&MsgJoinPool{Sender: addr1.String(), PoolId: 2, ShareOutAmount: sdk.NewInt(10), TokenInMaxs: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100)))},
+				&MsgExitSwapShareAmountIn{Sender: addr1.String(), PoolId: 2, TokenOutDenom: "test2", ShareInAmount: sdk.NewInt(10), TokenOutMinAmount: sdk.NewInt(1)},
+			),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: func() MsgFlashSwap {
+				msg := createMsg()
+				msg.Sender = invalidAddr.String()
+				return msg
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "zero token out",
+			msg: func() MsgFlashSwap {
+				msg := createMsg()
+				msg.TokenOut.Amount = sdk.ZeroInt()
+				return msg
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "same denom in and out",
+			msg: func() MsgFlashSwap {
+				msg := createMsg()
+				msg.TokenInDenom = "test"
+				return msg
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "zero token in max amount",
+			msg: func() MsgFlashSwap {
+				msg := createMsg()
+				msg.TokenInMaxAmount = sdk.ZeroInt()
+				return msg
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "no msgs",
+			msg: func() MsgFlashSwap {
+				msg := createMsg()
+				msg.Msgs = nil
+				return msg
+			}(),
+			expectPass: false,
+		},
+		{
+			name:       "too many msgs",
+			msg:        createMsg(tooManyMsgs...),
+			expectPass: false,
+		},
+		{
+			name:       "msg signed by someone else",
+			msg:        createMsg(swapMsg(addr2)),
+			expectPass: false,
+		},
+		{
+			name:       "nested flash swap",
+			msg:        createMsg(&nestedFlashSwap),
+			expectPass: false,
+		},
+		{
+			name:       "msg other than swaps, joins and exits",
+			msg:        createMsg(&MsgSetPoolSwapFee{Sender: addr1.String(), PoolId: 2, SwapFee: sdk.ZeroDec()}),
+			expectPass: false,
+		},
+		{
+			name: "invalid nested msg",
+			msg: createMsg(&MsgSwapExactAmountIn{
+				Sender:            addr1.String(),
+				TokenIn:           sdk.NewCoin("test", sdk.NewInt(100)),
+				TokenOutMinAmount: sdk.NewInt(110),
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgFinalizeLBP(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgFinalizeLBP) MsgFinalizeLBP) MsgFinalizeLBP {
+		properMsg := MsgFinalizeLBP{
+			Sender:        addr1,
+			PoolId:        1,
+			ShareInAmount: sdk.NewInt(100),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgFinalizeLBP) MsgFinalizeLBP {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "finalize_lbp")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	reopenWeights := []PoolAsset{
+		{
+			Weight: sdk.NewInt(100),
+			Token:  sdk.NewCoin("test", sdk.ZeroInt()),
+		},
+		{
+			Weight: sdk.NewInt(100),
+			Token:  sdk.NewCoin("test2", sdk.ZeroInt()),
+		},
+	}
+
+	tests := []struct {
+		name       string
+		msg        MsgFinalizeLBP
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgFinalizeLBP) MsgFinalizeLBP {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgFinalizeLBP) MsgFinalizeLBP {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero share amount",
+			msg: createMsg(func(msg MsgFinalizeLBP) MsgFinalizeLBP {
+				msg.ShareInAmount = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative share amount",
+			msg: createMsg(func(msg MsgFinalizeLBP) MsgFinalizeLBP {
+				msg.ShareInAmount = sdk.NewInt(-10)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "reopen without withdrawing",
+			msg: createMsg(func(msg MsgFinalizeLBP) MsgFinalizeLBP {
+				msg.ShareInAmount = sdk.ZeroInt()
+				msg.ReopenPoolWeights = reopenWeights
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "reopen with zero weight",
+			msg: createMsg(func(msg MsgFinalizeLBP) MsgFinalizeLBP {
+				msg.ReopenPoolWeights = []PoolAsset{reopenWeights[0], {Weight: sdk.ZeroInt(), Token: reopenWeights[1].Token}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "reopen with invalid denom",
+			msg: createMsg(func(msg MsgFinalizeLBP) MsgFinalizeLBP {
+				msg.ReopenPoolWeights = []PoolAsset{reopenWeights[0], {Weight: sdk.NewInt(100), Token: sdk.Coin{Denom: "1", Amount: sdk.ZeroInt()}}}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSwapExactAmountInWithPriceImpact(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgSwapExactAmountInWithPriceImpact) MsgSwapExactAmountInWithPriceImpact) MsgSwapExactAmountInWithPriceImpact {
+		properMsg := MsgSwapExactAmountInWithPriceImpact{
+			Sender: addr1,
+			Routes: []SwapAmountInRoute{{
+				PoolId:        0,
+				TokenOutDenom: "test",
+			}, {
+				PoolId:        1,
+				TokenOutDenom: "test2",
+			}},
+			TokenIn:        sdk.NewCoin("test", sdk.NewInt(100)),
+			MaxPriceImpact: sdk.NewDecWithPrec(1, 2),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgSwapExactAmountInWithPriceImpact) MsgSwapExactAmountInWithPriceImpact {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "swap_exact_amount_in_with_price_impact")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgSwapExactAmountInWithPriceImpact
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgSwapExactAmountInWithPriceImpact) MsgSwapExactAmountInWithPriceImpact {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgSwapExactAmountInWithPriceImpact) MsgSwapExactAmountInWithPriceImpact {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(func(msg MsgSwapExactAmountInWithPriceImpact) MsgSwapExactAmountInWithPriceImpact {
+				msg.Routes = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount token",
+			msg: createMsg(func(msg MsgSwapExactAmountInWithPriceImpact) MsgSwapExactAmountInWithPriceImpact {
+				msg.TokenIn.Amount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "no max price impact",
+			msg: createMsg(func(msg MsgSwapExactAmountInWithPriceImpact) MsgSwapExactAmountInWithPriceImpact {
+				msg.MaxPriceImpact = sdk.Dec{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative max price impact",
+			msg: createMsg(func(msg MsgSwapExactAmountInWithPriceImpact) MsgSwapExactAmountInWithPriceImpact {
+				msg.MaxPriceImpact = sdk.NewDecWithPrec(-1, 2)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "max spot price",
+			msg: createMsg(func(msg MsgSwapExactAmountInWithPriceImpact) MsgSwapExactAmountInWithPriceImpact {
+				msg.MaxSpotPrice = sdk.NewDec(2)
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "negative max spot price",
+			msg: createMsg(func(msg MsgSwapExactAmountInWithPriceImpact) MsgSwapExactAmountInWithPriceImpact {
+				msg.MaxSpotPrice = sdk.NewDec(-2)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -2,15 +2,25 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	appparams "github.com/osmosis-labs/osmosis/app/params"
+	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
 // Parameter store keys
 var (
-	KeyPoolCreationFee = []byte("PoolCreationFee")
+	KeyPoolCreationFee    = []byte("PoolCreationFee")
+	KeyTwapPruningHorizon = []byte("TwapPruningHorizon")
+	KeyProtocolFeeShare   = []byte("ProtocolFeeShare")
+
+	KeyStatsEpochIdentifier = []byte("StatsEpochIdentifier")
+	KeyStatsRetentionEpochs = []byte("StatsRetentionEpochs")
+
+	KeyPoolCreationFeeDestination = []byte("PoolCreationFeeDestination")
+	KeyPoolCreationFeeDenoms      = []byte("PoolCreationFeeDenoms")
 )
 
 // ParamTable for gamm module.
@@ -18,16 +28,24 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// NewParams returns the default gamm module parameters with the given pool creation fee.
 func NewParams(poolCreationFee sdk.Coins) Params {
-	return Params{
-		PoolCreationFee: poolCreationFee,
-	}
+	params := DefaultParams()
+	params.PoolCreationFee = poolCreationFee
+	return params
 }
 
 // default gamm module parameters
 func DefaultParams() Params {
 	return Params{
-		PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		PoolCreationFee:      sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		TwapPruningHorizon:   48 * time.Hour,
+		ProtocolFeeShare:     sdk.ZeroDec(),
+		StatsEpochIdentifier: "day",
+		StatsRetentionEpochs: 90,
+
+		PoolCreationFeeDestination: FeeToCommunityPool,
+		PoolCreationFeeDenoms:      []PoolCreationFeeDenom{},
 	}
 }
 
@@ -37,6 +55,38 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateTwapPruningHorizon(p.TwapPruningHorizon); err != nil {
+		return err
+	}
+
+	if err := validateProtocolFeeShare(p.ProtocolFeeShare); err != nil {
+		return err
+	}
+
+	if err := epochtypes.ValidateEpochIdentifierString(p.StatsEpochIdentifier); err != nil {
+		return err
+	}
+
+	if err := validateStatsRetentionEpochs(p.StatsRetentionEpochs); err != nil {
+		return err
+	}
+
+	if err := validatePoolCreationFeeDestination(p.PoolCreationFeeDestination); err != nil {
+		return err
+	}
+
+	if err := validatePoolCreationFeeDenoms(p.PoolCreationFeeDenoms); err != nil {
+		return err
+	}
+	if len(p.PoolCreationFeeDenoms) != 0 && len(p.PoolCreationFee) != 1 {
+		return fmt.Errorf("the pool creation fee can only be paid in other denoms if it is a single coin: %s", p.PoolCreationFee)
+	}
+	for _, feeDenom := range p.PoolCreationFeeDenoms {
+		if p.PoolCreationFee.AmountOf(feeDenom.Denom).IsPositive() {
+			return fmt.Errorf("the pool creation fee is already paid in %s", feeDenom.Denom)
+		}
+	}
+
 	return nil
 
 }
@@ -45,6 +95,12 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyTwapPruningHorizon, &p.TwapPruningHorizon, validateTwapPruningHorizon),
+		paramtypes.NewParamSetPair(KeyProtocolFeeShare, &p.ProtocolFeeShare, validateProtocolFeeShare),
+		paramtypes.NewParamSetPair(KeyStatsEpochIdentifier, &p.StatsEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyStatsRetentionEpochs, &p.StatsRetentionEpochs, validateStatsRetentionEpochs),
+		paramtypes.NewParamSetPair(KeyPoolCreationFeeDestination, &p.PoolCreationFeeDestination, validatePoolCreationFeeDestination),
+		paramtypes.NewParamSetPair(KeyPoolCreationFeeDenoms, &p.PoolCreationFeeDenoms, validatePoolCreationFeeDenoms),
 	}
 }
 
@@ -60,3 +116,75 @@ func validatePoolCreationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateTwapPruningHorizon(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("twap pruning horizon must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateProtocolFeeShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("protocol fee share must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateStatsRetentionEpochs(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePoolCreationFeeDestination(i interface{}) error {
+	v, ok := i.(PoolCreationFeeDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := PoolCreationFeeDestination_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown pool creation fee destination: %d", v)
+	}
+
+	return nil
+}
+
+func validatePoolCreationFeeDenoms(i interface{}) error {
+	v, ok := i.([]PoolCreationFeeDenom)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := map[string]bool{}
+	for _, feeDenom := range v {
+		if err := sdk.ValidateDenom(feeDenom.Denom); err != nil {
+			return err
+		}
+		if denoms[feeDenom.Denom] {
+			return fmt.Errorf("duplicate pool creation fee denom: %s", feeDenom.Denom)
+		}
+		denoms[feeDenom.Denom] = true
+
+		if feeDenom.PoolId == 0 {
+			return fmt.Errorf("pool creation fee denom %s has no pool", feeDenom.Denom)
+		}
+	}
+
+	return nil
+}
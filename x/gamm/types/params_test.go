@@ -0,0 +1,93 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPoolCreationFeeParams(t *testing.T) {
+	createParams := func(after func(p Params) Params) Params {
+		properParams := DefaultParams()
+		properParams.PoolCreationFeeDestination = FeeToPoolIncentives
+		properParams.PoolCreationFeeDenoms = []PoolCreationFeeDenom{
+			{Denom: "uatom", PoolId: 1},
+			{Denom: "uion", PoolId: 2},
+		}
+
+		return after(properParams)
+	}
+
+	tests := []struct {
+		name       string
+		params     Params
+		expectPass bool
+	}{
+		{
+			name: "proper params",
+			params: createParams(func(p Params) Params {
+				// Do nothing
+				return p
+			}),
+			expectPass: true,
+		},
+		{
+			name: "unknown destination",
+			params: createParams(func(p Params) Params {
+				p.PoolCreationFeeDestination = 10
+				return p
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			params: createParams(func(p Params) Params {
+				p.PoolCreationFeeDenoms[0].Denom = "1"
+				return p
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate denom",
+			params: createParams(func(p Params) Params {
+				p.PoolCreationFeeDenoms[1].Denom = "uatom"
+				return p
+			}),
+			expectPass: false,
+		},
+		{
+			name: "no pool",
+			params: createParams(func(p Params) Params {
+				p.PoolCreationFeeDenoms[0].PoolId = 0
+				return p
+			}),
+			expectPass: false,
+		},
+		{
+			name: "denom of the fee",
+			params: createParams(func(p Params) Params {
+				p.PoolCreationFeeDenoms[0].Denom = p.PoolCreationFee[0].Denom
+				return p
+			}),
+			expectPass: false,
+		},
+		{
+			name: "fee of several coins",
+			params: createParams(func(p Params) Params {
+				p.PoolCreationFee = p.PoolCreationFee.Add(sdk.NewInt64Coin("uakt", 1))
+				return p
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.params.Validate(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.params.Validate(), "test: %v", test.name)
+		}
+	}
+}
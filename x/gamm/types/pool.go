@@ -8,6 +8,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	proto "github.com/gogo/protobuf/proto"
 	"github.com/osmosis-labs/osmosis/v043_temp/address"
 )
@@ -40,10 +41,48 @@ type PoolI interface {
 	GetTokenBalance(denom string) (sdk.Int, error)
 	NumAssets() int
 	IsActive(curBlockTime time.Time) bool
+
+	// GetFuturePoolGovernor returns the governor allowed to change the pool's parameters.
+	GetFuturePoolGovernor() string
+	SetPoolSwapFee(swapFee sdk.Dec) error
+	SetPoolExitFee(exitFee sdk.Dec) error
+	// ScheduleWeightChange replaces any weight change in progress with a smooth change
+	// from the current weights to targetWeights, starting at startTime and lasting duration.
+	ScheduleWeightChange(startTime time.Time, duration time.Duration, targetWeights []PoolAsset) error
+
+	// SwapOutGivenIn returns the amount of tokenOutDenom received for swapping tokenIn into the pool.
+	// The pool state is not mutated.
+	SwapOutGivenIn(tokenIn sdk.Coin, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error)
+	// SwapInGivenOut returns the amount of tokenInDenom that has to be swapped into the pool
+	// to receive tokenOut. The pool state is not mutated.
+	SwapInGivenOut(tokenOut sdk.Coin, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error)
+	// ApplySwap updates the pool's state for swapping tokenIn for tokenOut,
+	// as computed by SwapOutGivenIn or SwapInGivenOut with swapFee.
+	// The protocolFeeShare of the swap fee doesn't go to the pool, and is returned as protocolFee.
+	ApplySwap(tokenIn sdk.Coin, tokenOut sdk.Coin, swapFee, protocolFeeShare sdk.Dec) (protocolFee sdk.Coin, err error)
+	// SpotPrice returns the price of tokenOutDenom in terms of tokenInDenom, including swapFee.
+	SpotPrice(tokenInDenom, tokenOutDenom string, swapFee sdk.Dec) (sdk.Dec, error)
+	// JoinPoolCoins returns the coins that have to be deposited for shareOutAmount of
+	// newly minted shares, proportionally to the pool's balances.
+	JoinPoolCoins(shareOutAmount sdk.Int) (sdk.Coins, error)
+	// JoinPoolShares returns the shares minted for joining the pool with the single asset tokenIn.
+	JoinPoolShares(tokenIn sdk.Coin) (shareOutAmount sdk.Int, err error)
+	// JoinPoolTokenIn returns the amount of tokenInDenom that has to be deposited
+	// for shareOutAmount of newly minted shares.
+	JoinPoolTokenIn(tokenInDenom string, shareOutAmount sdk.Int) (tokenIn sdk.Coin, err error)
+	// ExitPoolCoins returns the coins withdrawn for burning shareInAmount,
+	// proportionally to the pool's balances, after the exit fee.
+	ExitPoolCoins(shareInAmount sdk.Int) (sdk.Coins, error)
+	// ExitPoolTokenOut returns the amount of tokenOutDenom withdrawn for burning shareInAmount.
+	ExitPoolTokenOut(tokenOutDenom string, shareInAmount sdk.Int) (tokenOut sdk.Coin, err error)
+	// ExitPoolShares returns the shares that have to be burned to withdraw the single asset tokenOut.
+	ExitPoolShares(tokenOut sdk.Coin) (shareInAmount sdk.Int, err error)
 }
 
 var (
 	_                         PoolI   = (*BalancerPool)(nil)
+	_                         PoolI   = (*StableswapPool)(nil)
+	_                         PoolI   = (*ConcentratedPool)(nil)
 	MaxUserSpecifiedWeight    sdk.Int = sdk.NewIntFromUint64(1 << 20)
 	GuaranteedWeightPrecision int64   = 1 << 30
 )
@@ -93,21 +132,39 @@ func NewBalancerPool(poolId uint64, balancerPoolParams BalancerPoolParams, asset
 	return pool, nil
 }
 
-func (params BalancerPoolParams) Validate(poolWeights []PoolAsset) error {
-	if params.ExitFee.IsNegative() {
+// ValidateSwapFee checks that the swap fee is within [0, 1).
+func ValidateSwapFee(swapFee sdk.Dec) error {
+	if swapFee.IsNegative() {
+		return ErrNegativeSwapFee
+	}
+
+	if swapFee.GTE(sdk.OneDec()) {
+		return ErrTooMuchSwapFee
+	}
+
+	return nil
+}
+
+// ValidateExitFee checks that the exit fee is within [0, 1).
+func ValidateExitFee(exitFee sdk.Dec) error {
+	if exitFee.IsNegative() {
 		return ErrNegativeExitFee
 	}
 
-	if params.ExitFee.GTE(sdk.OneDec()) {
+	if exitFee.GTE(sdk.OneDec()) {
 		return ErrTooMuchExitFee
 	}
 
-	if params.SwapFee.IsNegative() {
-		return ErrNegativeSwapFee
+	return nil
+}
+
+func (params BalancerPoolParams) Validate(poolWeights []PoolAsset) error {
+	if err := ValidateExitFee(params.ExitFee); err != nil {
+		return err
 	}
 
-	if params.SwapFee.GTE(sdk.OneDec()) {
-		return ErrTooMuchSwapFee
+	if err := ValidateSwapFee(params.SwapFee); err != nil {
+		return err
 	}
 
 	if params.SmoothWeightChangeParams != nil {
@@ -143,6 +200,12 @@ func (params BalancerPoolParams) Validate(poolWeights []PoolAsset) error {
 		}
 	}
 
+	if params.LbpParams != nil {
+		if err := params.LbpParams.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -172,6 +235,50 @@ func (pa BalancerPool) GetPoolParams() BalancerPoolParams {
 	return pa.PoolParams
 }
 
+func (pa BalancerPool) GetFuturePoolGovernor() string {
+	return pa.FuturePoolGovernor
+}
+
+func (pa *BalancerPool) SetPoolSwapFee(swapFee sdk.Dec) error {
+	if err := ValidateSwapFee(swapFee); err != nil {
+		return err
+	}
+
+	pa.PoolParams.SwapFee = swapFee
+	return nil
+}
+
+func (pa *BalancerPool) SetPoolExitFee(exitFee sdk.Dec) error {
+	if err := ValidateExitFee(exitFee); err != nil {
+		return err
+	}
+
+	pa.PoolParams.ExitFee = exitFee
+	return nil
+}
+
+func (pa *BalancerPool) ScheduleWeightChange(startTime time.Time, duration time.Duration, targetWeights []PoolAsset) error {
+	// Copy the target weights, as they get sorted and scaled in place.
+	targetPoolWeights := make([]PoolAsset, len(targetWeights))
+	copy(targetPoolWeights, targetWeights)
+
+	params := pa.PoolParams
+	params.SmoothWeightChangeParams = &SmoothWeightChangeParams{
+		StartTime:         startTime,
+		Duration:          duration,
+		TargetPoolWeights: targetPoolWeights,
+	}
+
+	sortedPoolAssets := pa.GetAllPoolAssets()
+	err := params.Validate(sortedPoolAssets)
+	if err != nil {
+		return err
+	}
+
+	// The weight change starts from the current weights.
+	return pa.setInitialPoolParams(params, sortedPoolAssets, startTime)
+}
+
 func (pa BalancerPool) GetTotalWeight() sdk.Int {
 	return pa.TotalWeight
 }
@@ -272,6 +379,16 @@ func (pa *BalancerPool) setInitialPoolParams(params BalancerPoolParams, sortedAs
 		}
 	}
 
+	if params.LbpParams != nil {
+		// Set sale start time if not present.
+		if params.LbpParams.SaleStartTime.Unix() <= 0 {
+			params.LbpParams.SaleStartTime = time.Unix(curBlockTime.Unix(), 0)
+		}
+		if !params.LbpParams.SaleEndTime.After(curBlockTime) {
+			return sdkerrors.Wrapf(ErrInvalidLBP, "sale end time %s is not after the pool creation", params.LbpParams.SaleEndTime)
+		}
+	}
+
 	return nil
 }
 
@@ -285,30 +402,36 @@ func (pa BalancerPool) GetPoolAsset(denom string) (PoolAsset, error) {
 
 // Returns a pool asset, and its index. If err != nil, then the index will be valid.
 func (pa BalancerPool) getPoolAssetAndIndex(denom string) (int, PoolAsset, error) {
+	return getPoolAssetAndIndex(pa.PoolAssets, denom)
+}
+
+// getPoolAssetAndIndex searches the sorted poolAssets for the given denom,
+// and returns the asset alongside its index.
+func getPoolAssetAndIndex(poolAssets []PoolAsset, denom string) (int, PoolAsset, error) {
 	if denom == "" {
 		return -1, PoolAsset{}, fmt.Errorf("you tried to find the PoolAsset with empty denom")
 	}
 
-	if len(pa.PoolAssets) == 0 {
+	if len(poolAssets) == 0 {
 		return -1, PoolAsset{}, fmt.Errorf("can't find the PoolAsset (%s)", denom)
 	}
 
-	i := sort.Search(len(pa.PoolAssets), func(i int) bool {
-		PoolAssetA := pa.PoolAssets[i]
+	i := sort.Search(len(poolAssets), func(i int) bool {
+		PoolAssetA := poolAssets[i]
 
 		compare := strings.Compare(PoolAssetA.Token.Denom, denom)
 		return compare >= 0
 	})
 
-	if i < 0 || i >= len(pa.PoolAssets) {
+	if i < 0 || i >= len(poolAssets) {
 		return -1, PoolAsset{}, fmt.Errorf("can't find the PoolAsset (%s)", denom)
 	}
 
-	if pa.PoolAssets[i].Token.Denom != denom {
+	if poolAssets[i].Token.Denom != denom {
 		return -1, PoolAsset{}, fmt.Errorf("can't find the PoolAsset (%s)", denom)
 	}
 
-	return i, pa.PoolAssets[i], nil
+	return i, poolAssets[i], nil
 }
 
 func (pa *BalancerPool) UpdatePoolAssetBalance(coin sdk.Coin) error {
@@ -478,8 +601,222 @@ func (pa BalancerPool) NumAssets() int {
 }
 
 func (pa BalancerPool) IsActive(curBlockTime time.Time) bool {
+	// Liquidity bootstrapping pools are only active during their sale.
+	if pa.IsLBP() {
+		return pa.PoolParams.LbpParams.Status(curBlockTime) == LBPSaleOngoing
+	}
 
 	// Add frozen pool checking, etc...
 
 	return true
 }
+
+func (pa BalancerPool) SwapOutGivenIn(tokenIn sdk.Coin, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	inPoolAsset, err := pa.GetPoolAsset(tokenIn.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	outPoolAsset, err := pa.GetPoolAsset(tokenOutDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenOutAmount := calcOutGivenIn(
+		inPoolAsset.Token.Amount.ToDec(),
+		inPoolAsset.Weight.ToDec(),
+		outPoolAsset.Token.Amount.ToDec(),
+		outPoolAsset.Weight.ToDec(),
+		tokenIn.Amount.ToDec(),
+		swapFee,
+	).TruncateInt()
+
+	return sdk.Coin{Denom: tokenOutDenom, Amount: tokenOutAmount}, nil
+}
+
+func (pa BalancerPool) SwapInGivenOut(tokenOut sdk.Coin, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	inPoolAsset, err := pa.GetPoolAsset(tokenInDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	outPoolAsset, err := pa.GetPoolAsset(tokenOut.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if tokenOut.Amount.GTE(outPoolAsset.Token.Amount) {
+		return sdk.Coin{}, sdkerrors.Wrapf(ErrTooManyTokensOut,
+			"can't get more tokens out than there are tokens in the pool")
+	}
+
+	tokenInAmount := calcInGivenOut(
+		inPoolAsset.Token.Amount.ToDec(),
+		inPoolAsset.Weight.ToDec(),
+		outPoolAsset.Token.Amount.ToDec(),
+		outPoolAsset.Weight.ToDec(),
+		tokenOut.Amount.ToDec(),
+		swapFee,
+	).TruncateInt()
+
+	return sdk.Coin{Denom: tokenInDenom, Amount: tokenInAmount}, nil
+}
+
+func (pa *BalancerPool) ApplySwap(tokenIn sdk.Coin, tokenOut sdk.Coin, swapFee, protocolFeeShare sdk.Dec) (sdk.Coin, error) {
+	return applySwapToBalances(pa, tokenIn, tokenOut, swapFee, protocolFeeShare)
+}
+
+// applySwapToBalances updates the balances of a pool whose swap math only depends on its balances and weights.
+// The swap fee is the swapFee fraction of tokenIn, of which the protocolFeeShare is kept out of the pool.
+func applySwapToBalances(pool PoolI, tokenIn sdk.Coin, tokenOut sdk.Coin, swapFee, protocolFeeShare sdk.Dec) (sdk.Coin, error) {
+	inPoolAsset, err := pool.GetPoolAsset(tokenIn.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	outPoolAsset, err := pool.GetPoolAsset(tokenOut.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	protocolFee := sdk.NewCoin(tokenIn.Denom, tokenIn.Amount.ToDec().Mul(swapFee).Mul(protocolFeeShare).TruncateInt())
+	inPoolAsset.Token.Amount = inPoolAsset.Token.Amount.Add(tokenIn.Amount).Sub(protocolFee.Amount)
+	outPoolAsset.Token.Amount = outPoolAsset.Token.Amount.Sub(tokenOut.Amount)
+
+	err = pool.UpdatePoolAssetBalances(sdk.NewCoins(
+		inPoolAsset.Token,
+		outPoolAsset.Token,
+	))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return protocolFee, nil
+}
+
+func (pa BalancerPool) SpotPrice(tokenInDenom, tokenOutDenom string, swapFee sdk.Dec) (sdk.Dec, error) {
+	inPoolAsset, err := pa.GetPoolAsset(tokenInDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	outPoolAsset, err := pa.GetPoolAsset(tokenOutDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// Migrated pools are left without liquidity.
+	if !inPoolAsset.Token.Amount.IsPositive() || !outPoolAsset.Token.Amount.IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrNotEnoughLiquidity, "pool %d has no liquidity", pa.Id)
+	}
+
+	return calcSpotPriceWithSwapFee(
+		inPoolAsset.Token.Amount.ToDec(),
+		inPoolAsset.Weight.ToDec(),
+		outPoolAsset.Token.Amount.ToDec(),
+		outPoolAsset.Weight.ToDec(),
+		swapFee,
+	), nil
+}
+
+func (pa BalancerPool) JoinPoolCoins(shareOutAmount sdk.Int) (sdk.Coins, error) {
+	return getProportionalCoins(pa.PoolAssets, pa.TotalShares.Amount, shareOutAmount)
+}
+
+func (pa BalancerPool) JoinPoolShares(tokenIn sdk.Coin) (shareOutAmount sdk.Int, err error) {
+	poolAsset, err := pa.GetPoolAsset(tokenIn.Denom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return calcPoolOutGivenSingleIn(
+		poolAsset.Token.Amount.ToDec(),
+		poolAsset.Weight.ToDec(),
+		pa.TotalShares.Amount.ToDec(),
+		pa.TotalWeight.ToDec(),
+		tokenIn.Amount.ToDec(),
+		pa.GetPoolSwapFee(),
+	).TruncateInt(), nil
+}
+
+func (pa BalancerPool) JoinPoolTokenIn(tokenInDenom string, shareOutAmount sdk.Int) (tokenIn sdk.Coin, err error) {
+	poolAsset, err := pa.GetPoolAsset(tokenInDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenInAmount := calcSingleInGivenPoolOut(
+		poolAsset.Token.Amount.ToDec(),
+		poolAsset.Weight.ToDec(),
+		pa.TotalShares.Amount.ToDec(),
+		pa.TotalWeight.ToDec(),
+		shareOutAmount.ToDec(),
+		pa.GetPoolSwapFee(),
+	).TruncateInt()
+
+	return sdk.Coin{Denom: tokenInDenom, Amount: tokenInAmount}, nil
+}
+
+func (pa BalancerPool) ExitPoolCoins(shareInAmount sdk.Int) (sdk.Coins, error) {
+	exitFee := pa.GetPoolExitFee().MulInt(shareInAmount).TruncateInt()
+	return getProportionalCoins(pa.PoolAssets, pa.TotalShares.Amount, shareInAmount.Sub(exitFee))
+}
+
+func (pa BalancerPool) ExitPoolTokenOut(tokenOutDenom string, shareInAmount sdk.Int) (tokenOut sdk.Coin, err error) {
+	poolAsset, err := pa.GetPoolAsset(tokenOutDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenOutAmount := calcSingleOutGivenPoolIn(
+		poolAsset.Token.Amount.ToDec(),
+		poolAsset.Weight.ToDec(),
+		pa.TotalShares.Amount.ToDec(),
+		pa.TotalWeight.ToDec(),
+		shareInAmount.ToDec(),
+		pa.GetPoolSwapFee(),
+		pa.GetPoolExitFee(),
+	).TruncateInt()
+
+	return sdk.Coin{Denom: tokenOutDenom, Amount: tokenOutAmount}, nil
+}
+
+func (pa BalancerPool) ExitPoolShares(tokenOut sdk.Coin) (shareInAmount sdk.Int, err error) {
+	poolAsset, err := pa.GetPoolAsset(tokenOut.Denom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return calcPoolInGivenSingleOut(
+		poolAsset.Token.Amount.ToDec(),
+		poolAsset.Weight.ToDec(),
+		pa.TotalShares.Amount.ToDec(),
+		pa.TotalWeight.ToDec(),
+		tokenOut.Amount.ToDec(),
+		pa.GetPoolSwapFee(),
+		pa.GetPoolExitFee(),
+	).TruncateInt(), nil
+}
+
+// getProportionalCoins returns shares / totalShares of every pool asset, rounded down.
+// It is the pool-type-agnostic way to join or exit a pool with all of its assets.
+func getProportionalCoins(poolAssets []PoolAsset, totalShares sdk.Int, shares sdk.Int) (sdk.Coins, error) {
+	// shareRatio is the number of shares, divided by the total number of
+	// shares currently in the pool. It is intended to be used in scenarios where you want
+	// (tokens per share) * number of shares = # tokens * (# shares / cur total shares)
+	shareRatio := shares.ToDec().QuoInt(totalShares)
+	if shareRatio.LTE(sdk.ZeroDec()) {
+		return nil, sdkerrors.Wrapf(ErrInvalidMathApprox, "share ratio is zero or negative")
+	}
+
+	coins := make(sdk.Coins, 0, len(poolAssets))
+	for _, asset := range poolAssets {
+		amount := shareRatio.MulInt(asset.Token.Amount).TruncateInt()
+		if amount.LTE(sdk.ZeroInt()) {
+			return nil, sdkerrors.Wrapf(ErrInvalidMathApprox, "token amount is zero or negative")
+		}
+		coins = append(coins, sdk.NewCoin(asset.Token.Denom, amount))
+	}
+
+	return coins, nil
+}
@@ -0,0 +1,708 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/v1beta1/pool_creation_fee.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PoolCreationFeeDestination is where the pool creation fees are sent.
+type PoolCreationFeeDestination int32
+
+const (
+	FeeToCommunityPool  PoolCreationFeeDestination = 0
+	FeeBurned           PoolCreationFeeDestination = 1
+	FeeToPoolIncentives PoolCreationFeeDestination = 2
+)
+
+var PoolCreationFeeDestination_name = map[int32]string{
+	0: "FeeToCommunityPool",
+	1: "FeeBurned",
+	2: "FeeToPoolIncentives",
+}
+
+var PoolCreationFeeDestination_value = map[string]int32{
+	"FeeToCommunityPool":  0,
+	"FeeBurned":           1,
+	"FeeToPoolIncentives": 2,
+}
+
+func (x PoolCreationFeeDestination) String() string {
+	return proto.EnumName(PoolCreationFeeDestination_name, int32(x))
+}
+
+func (PoolCreationFeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d1bf1b27e7ddc254, []int{0}
+}
+
+// PoolCreationFeeDenom is a denom the pool creation fee can be paid in,
+// converted at the spot price of a pool of that denom and the fee denom.
+type PoolCreationFeeDenom struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *PoolCreationFeeDenom) Reset()         { *m = PoolCreationFeeDenom{} }
+func (m *PoolCreationFeeDenom) String() string { return proto.CompactTextString(m) }
+func (*PoolCreationFeeDenom) ProtoMessage()    {}
+func (*PoolCreationFeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1bf1b27e7ddc254, []int{0}
+}
+func (m *PoolCreationFeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolCreationFeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolCreationFeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolCreationFeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolCreationFeeDenom.Merge(m, src)
+}
+func (m *PoolCreationFeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolCreationFeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolCreationFeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolCreationFeeDenom proto.InternalMessageInfo
+
+func (m *PoolCreationFeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PoolCreationFeeDenom) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// PoolCreationFeeRecord records the creation fee paid for a pool.
+type PoolCreationFeeRecord struct {
+	PoolId      uint64                                   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Payer       string                                   `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty" yaml:"payer"`
+	Fee         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee" yaml:"fee"`
+	Destination PoolCreationFeeDestination               `protobuf:"varint,4,opt,name=destination,proto3,enum=osmosis.gamm.v1beta1.PoolCreationFeeDestination" json:"destination,omitempty" yaml:"destination"`
+}
+
+func (m *PoolCreationFeeRecord) Reset()         { *m = PoolCreationFeeRecord{} }
+func (m *PoolCreationFeeRecord) String() string { return proto.CompactTextString(m) }
+func (*PoolCreationFeeRecord) ProtoMessage()    {}
+func (*PoolCreationFeeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1bf1b27e7ddc254, []int{1}
+}
+func (m *PoolCreationFeeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolCreationFeeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolCreationFeeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolCreationFeeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolCreationFeeRecord.Merge(m, src)
+}
+func (m *PoolCreationFeeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolCreationFeeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolCreationFeeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolCreationFeeRecord proto.InternalMessageInfo
+
+func (m *PoolCreationFeeRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolCreationFeeRecord) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *PoolCreationFeeRecord) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *PoolCreationFeeRecord) GetDestination() PoolCreationFeeDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return FeeToCommunityPool
+}
+
+func init() {
+	proto.RegisterEnum("osmosis.gamm.v1beta1.PoolCreationFeeDestination", PoolCreationFeeDestination_name, PoolCreationFeeDestination_value)
+	proto.RegisterType((*PoolCreationFeeDenom)(nil), "osmosis.gamm.v1beta1.PoolCreationFeeDenom")
+	proto.RegisterType((*PoolCreationFeeRecord)(nil), "osmosis.gamm.v1beta1.PoolCreationFeeRecord")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/v1beta1/pool_creation_fee.proto", fileDescriptor_d1bf1b27e7ddc254)
+}
+
+var fileDescriptor_d1bf1b27e7ddc254 = []byte{
+	// 457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xed, 0x24, 0x5f, 0x3f, 0x75, 0x0a, 0x55, 0x34, 0x84, 0x62, 0xb2, 0xb0, 0x23, 0x2f,
+	0x90, 0x05, 0xed, 0x98, 0x96, 0x1d, 0x0b, 0x16, 0x0e, 0x8a, 0xd4, 0x1d, 0xb2, 0x58, 0xb1, 0xa9,
+	0xfc, 0xe7, 0x26, 0x0c, 0x89, 0xe7, 0x46, 0x1e, 0xa7, 0x22, 0x6f, 0xc0, 0x92, 0x77, 0x60, 0xc7,
+	0x7b, 0x20, 0x75, 0xd9, 0x25, 0x2b, 0x83, 0x92, 0x37, 0xc8, 0x13, 0xa0, 0x99, 0x71, 0xa2, 0xa8,
+	0x80, 0x58, 0xe5, 0xe6, 0x9e, 0xdf, 0x9c, 0x7b, 0xe7, 0x78, 0xc8, 0x29, 0xca, 0x02, 0x25, 0x97,
+	0xe1, 0x24, 0x29, 0x8a, 0xf0, 0xfa, 0x3c, 0x85, 0x2a, 0x39, 0x0f, 0xe7, 0x88, 0xb3, 0xab, 0xac,
+	0x84, 0xa4, 0xe2, 0x28, 0xae, 0xc6, 0x00, 0x6c, 0x5e, 0x62, 0x85, 0xb4, 0xd7, 0xd0, 0x4c, 0xd1,
+	0xac, 0xa1, 0xfb, 0xbd, 0x09, 0x4e, 0x50, 0x03, 0xa1, 0xaa, 0x0c, 0xdb, 0x77, 0x33, 0x0d, 0x87,
+	0x69, 0x22, 0x61, 0x67, 0x9c, 0x21, 0x17, 0x46, 0xf7, 0xa7, 0xa4, 0xf7, 0x06, 0x71, 0x36, 0x6c,
+	0xa6, 0x8c, 0x00, 0x5e, 0x83, 0xc0, 0x82, 0x3e, 0x21, 0xff, 0xe5, 0xaa, 0x70, 0xec, 0x81, 0x1d,
+	0x1c, 0x46, 0xdd, 0x4d, 0xed, 0xdd, 0x5b, 0x26, 0xc5, 0xec, 0xa5, 0xaf, 0xdb, 0x7e, 0x6c, 0x64,
+	0xfa, 0x8c, 0xfc, 0xaf, 0xd7, 0xe4, 0xb9, 0xd3, 0x1a, 0xd8, 0x41, 0x27, 0xa2, 0x9b, 0xda, 0x3b,
+	0x36, 0x64, 0x23, 0xf8, 0xf1, 0x81, 0xaa, 0x2e, 0x73, 0xff, 0x5b, 0x8b, 0x3c, 0xbc, 0x33, 0x2d,
+	0x86, 0x0c, 0xcb, 0x7c, 0xdf, 0xc6, 0xfe, 0x97, 0x8d, 0xda, 0x6d, 0x9e, 0x2c, 0xa1, 0x74, 0x5a,
+	0x77, 0x77, 0xd3, 0x6d, 0x3f, 0x36, 0x32, 0x9d, 0x92, 0xf6, 0x18, 0xc0, 0x69, 0x0f, 0xda, 0xc1,
+	0xd1, 0xc5, 0x63, 0x66, 0x92, 0x60, 0x2a, 0x89, 0x6d, 0x68, 0x6c, 0x88, 0x5c, 0x44, 0xaf, 0x6e,
+	0x6a, 0xcf, 0xda, 0xd4, 0x1e, 0x31, 0x26, 0x63, 0x00, 0xff, 0xeb, 0x0f, 0x2f, 0x98, 0xf0, 0xea,
+	0xfd, 0x22, 0x65, 0x19, 0x16, 0x61, 0x13, 0xa2, 0xf9, 0x39, 0x93, 0xf9, 0x34, 0xac, 0x96, 0x73,
+	0x90, 0xfa, 0xb8, 0x8c, 0xd5, 0x14, 0xfa, 0x81, 0x1c, 0xe5, 0x20, 0x2b, 0x2e, 0xf4, 0xcd, 0x9c,
+	0xce, 0xc0, 0x0e, 0x8e, 0x2f, 0x9e, 0xb3, 0x3f, 0x7d, 0x2a, 0xf6, 0x5b, 0xe2, 0xbb, 0x73, 0xd1,
+	0xc9, 0xa6, 0xf6, 0xe8, 0x36, 0xe8, 0x5d, 0xdb, 0x8f, 0xf7, 0xcd, 0x9f, 0xa6, 0xa4, 0xff, 0x77,
+	0x0b, 0x7a, 0x42, 0xe8, 0x08, 0xe0, 0x2d, 0x0e, 0xb1, 0x28, 0x16, 0x82, 0x57, 0x4b, 0xc5, 0x76,
+	0x2d, 0x7a, 0x9f, 0x1c, 0x8e, 0x00, 0xa2, 0x45, 0x29, 0x20, 0xef, 0xda, 0xf4, 0x11, 0x79, 0xa0,
+	0x31, 0xa5, 0x5e, 0x8a, 0x0c, 0x44, 0xc5, 0xaf, 0x41, 0x76, 0x5b, 0xfd, 0xce, 0xa7, 0x2f, 0xae,
+	0x15, 0x8d, 0x6e, 0x56, 0xae, 0x7d, 0xbb, 0x72, 0xed, 0x9f, 0x2b, 0xd7, 0xfe, 0xbc, 0x76, 0xad,
+	0xdb, 0xb5, 0x6b, 0x7d, 0x5f, 0xbb, 0xd6, 0xbb, 0xd3, 0xbd, 0x60, 0x9a, 0xeb, 0x9d, 0xcd, 0x92,
+	0x54, 0x6e, 0xff, 0x84, 0x1f, 0xcd, 0x33, 0xd6, 0x11, 0xa5, 0x07, 0xfa, 0x9d, 0xbd, 0xf8, 0x35,
+	0x00, 0x6e, 0x2b, 0x6d, 0x2e, 0xe3, 0x02, 0x00, 0x00,
+}
+
+func (m *PoolCreationFeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolCreationFeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolCreationFeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintPoolCreationFee(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPoolCreationFee(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolCreationFeeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolCreationFeeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolCreationFeeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		i = encodeVarintPoolCreationFee(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPoolCreationFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintPoolCreationFee(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPoolCreationFee(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPoolCreationFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPoolCreationFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolCreationFeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPoolCreationFee(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovPoolCreationFee(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PoolCreationFeeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPoolCreationFee(uint64(m.PoolId))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovPoolCreationFee(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovPoolCreationFee(uint64(l))
+		}
+	}
+	if m.Destination != 0 {
+		n += 1 + sovPoolCreationFee(uint64(m.Destination))
+	}
+	return n
+}
+
+func sovPoolCreationFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPoolCreationFee(x uint64) (n int) {
+	return sovPoolCreationFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolCreationFeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPoolCreationFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolCreationFeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolCreationFeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolCreationFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoolCreationFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoolCreationFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolCreationFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPoolCreationFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPoolCreationFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolCreationFeeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPoolCreationFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolCreationFeeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolCreationFeeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolCreationFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolCreationFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPoolCreationFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoolCreationFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolCreationFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPoolCreationFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoolCreationFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolCreationFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= PoolCreationFeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPoolCreationFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPoolCreationFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPoolCreationFee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPoolCreationFee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPoolCreationFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPoolCreationFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPoolCreationFee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPoolCreationFee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPoolCreationFee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPoolCreationFee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPoolCreationFee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPoolCreationFee = fmt.Errorf("proto: unexpected end of group")
+)
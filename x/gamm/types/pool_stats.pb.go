@@ -0,0 +1,900 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/v1beta1/pool_stats.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PoolEpochStats is the activity of a pool during an epoch of the gamm stats
+// epoch identifier. It is only recorded for the epochs the pool changed in.
+type PoolEpochStats struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Epoch  int64  `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty" yaml:"epoch"`
+	// The tokens swapped into and out of the pool, swap fees included.
+	SwapVolumeIn  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=swap_volume_in,json=swapVolumeIn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_volume_in" yaml:"swap_volume_in"`
+	SwapVolumeOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=swap_volume_out,json=swapVolumeOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_volume_out" yaml:"swap_volume_out"`
+	// The swap fees paid, including the protocol's share.
+	SwapFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=swap_fees,json=swapFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_fees" yaml:"swap_fees"`
+	NumSwaps uint64                                   `protobuf:"varint,6,opt,name=num_swaps,json=numSwaps,proto3" json:"num_swaps,omitempty" yaml:"num_swaps"`
+	// The tokens added to and removed from the pool by joins and exits.
+	Joined   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=joined,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"joined" yaml:"joined"`
+	Exited   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=exited,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"exited" yaml:"exited"`
+	NumJoins uint64                                   `protobuf:"varint,9,opt,name=num_joins,json=numJoins,proto3" json:"num_joins,omitempty" yaml:"num_joins"`
+	NumExits uint64                                   `protobuf:"varint,10,opt,name=num_exits,json=numExits,proto3" json:"num_exits,omitempty" yaml:"num_exits"`
+	// The pool liquidity and total shares at the end of the last block of the
+	// epoch the pool changed in.
+	Liquidity   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=liquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquidity" yaml:"liquidity"`
+	TotalShares types.Coin                               `protobuf:"bytes,12,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+}
+
+func (m *PoolEpochStats) Reset()         { *m = PoolEpochStats{} }
+func (m *PoolEpochStats) String() string { return proto.CompactTextString(m) }
+func (*PoolEpochStats) ProtoMessage()    {}
+func (*PoolEpochStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_688b7526ac3e533f, []int{0}
+}
+func (m *PoolEpochStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolEpochStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolEpochStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolEpochStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolEpochStats.Merge(m, src)
+}
+func (m *PoolEpochStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolEpochStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolEpochStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolEpochStats proto.InternalMessageInfo
+
+func (m *PoolEpochStats) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolEpochStats) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *PoolEpochStats) GetSwapVolumeIn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SwapVolumeIn
+	}
+	return nil
+}
+
+func (m *PoolEpochStats) GetSwapVolumeOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SwapVolumeOut
+	}
+	return nil
+}
+
+func (m *PoolEpochStats) GetSwapFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SwapFees
+	}
+	return nil
+}
+
+func (m *PoolEpochStats) GetNumSwaps() uint64 {
+	if m != nil {
+		return m.NumSwaps
+	}
+	return 0
+}
+
+func (m *PoolEpochStats) GetJoined() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Joined
+	}
+	return nil
+}
+
+func (m *PoolEpochStats) GetExited() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Exited
+	}
+	return nil
+}
+
+func (m *PoolEpochStats) GetNumJoins() uint64 {
+	if m != nil {
+		return m.NumJoins
+	}
+	return 0
+}
+
+func (m *PoolEpochStats) GetNumExits() uint64 {
+	if m != nil {
+		return m.NumExits
+	}
+	return 0
+}
+
+func (m *PoolEpochStats) GetLiquidity() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Liquidity
+	}
+	return nil
+}
+
+func (m *PoolEpochStats) GetTotalShares() types.Coin {
+	if m != nil {
+		return m.TotalShares
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*PoolEpochStats)(nil), "osmosis.gamm.v1beta1.PoolEpochStats")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/v1beta1/pool_stats.proto", fileDescriptor_688b7526ac3e533f)
+}
+
+var fileDescriptor_688b7526ac3e533f = []byte{
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0xe3, 0x7f, 0xda, 0x34, 0x99, 0xa4, 0xf9, 0x57, 0x43, 0x40, 0x43, 0x91, 0xec, 0xc8,
+	0x12, 0x28, 0x12, 0xd4, 0x56, 0x60, 0xc7, 0x8e, 0x40, 0x2b, 0xa5, 0x1b, 0x90, 0x23, 0x21, 0xc1,
+	0xc6, 0xb2, 0xe3, 0x21, 0x19, 0xb0, 0x3d, 0xa6, 0x33, 0x2e, 0x8d, 0x04, 0x2f, 0x80, 0x84, 0xc4,
+	0x73, 0xf0, 0x24, 0x5d, 0x76, 0xc9, 0x2a, 0x45, 0xc9, 0x1b, 0xe4, 0x09, 0xd0, 0x7c, 0xe4, 0x03,
+	0x51, 0x11, 0x79, 0x95, 0x7b, 0x73, 0xef, 0x39, 0xe7, 0x37, 0x93, 0x68, 0xc0, 0x7d, 0xca, 0x12,
+	0xca, 0x08, 0x73, 0x47, 0x41, 0x92, 0xb8, 0xe7, 0xdd, 0x10, 0xf3, 0xa0, 0xeb, 0x66, 0x94, 0xc6,
+	0x3e, 0xe3, 0x01, 0x67, 0x4e, 0x76, 0x46, 0x39, 0x85, 0x2d, 0xbd, 0xe6, 0x88, 0x35, 0x47, 0xaf,
+	0x1d, 0xb6, 0x46, 0x74, 0x44, 0xe5, 0x82, 0x2b, 0x2a, 0xb5, 0x7b, 0x68, 0x0e, 0xe5, 0xb2, 0x1b,
+	0x06, 0x0c, 0xaf, 0x1c, 0x87, 0x94, 0xa4, 0x6a, 0x6e, 0x5f, 0x57, 0x41, 0xf3, 0x15, 0xa5, 0xf1,
+	0x71, 0x46, 0x87, 0xe3, 0x81, 0x08, 0x81, 0x0f, 0xc1, 0x9e, 0x8c, 0x24, 0x11, 0x32, 0xda, 0x46,
+	0x67, 0xa7, 0x07, 0x17, 0x53, 0xab, 0x39, 0x09, 0x92, 0xf8, 0xa9, 0xad, 0x07, 0xb6, 0x57, 0x11,
+	0x55, 0x3f, 0x82, 0x0f, 0xc0, 0x2e, 0x16, 0x52, 0xf4, 0x5f, 0xdb, 0xe8, 0x94, 0x7b, 0x07, 0x8b,
+	0xa9, 0xd5, 0x50, 0xab, 0xf2, 0x6b, 0xdb, 0x53, 0x63, 0xf8, 0xd5, 0x00, 0x4d, 0xf6, 0x29, 0xc8,
+	0xfc, 0x73, 0x1a, 0xe7, 0x09, 0xf6, 0x49, 0x8a, 0xca, 0xed, 0x72, 0xa7, 0xfe, 0xf8, 0xae, 0xa3,
+	0x08, 0x1d, 0x41, 0xb8, 0x3c, 0x8c, 0xf3, 0x9c, 0x92, 0xb4, 0xd7, 0xbf, 0x9c, 0x5a, 0xa5, 0xc5,
+	0xd4, 0xba, 0xad, 0x0c, 0xff, 0x94, 0xdb, 0x3f, 0xae, 0xad, 0xce, 0x88, 0xf0, 0x71, 0x1e, 0x3a,
+	0x43, 0x9a, 0xb8, 0xfa, 0x9c, 0xea, 0xe3, 0x88, 0x45, 0x1f, 0x5c, 0x3e, 0xc9, 0x30, 0x93, 0x4e,
+	0xcc, 0x6b, 0x08, 0xf1, 0x6b, 0xa9, 0xed, 0xa7, 0xf0, 0x9b, 0x01, 0xfe, 0xdf, 0x74, 0xa3, 0x39,
+	0x47, 0x3b, 0xdb, 0x68, 0x4e, 0x35, 0xcd, 0x9d, 0xbf, 0x69, 0x68, 0xce, 0x8b, 0xe1, 0xec, 0xaf,
+	0x71, 0x5e, 0xe6, 0x1c, 0x7e, 0x06, 0x35, 0x69, 0xf7, 0x0e, 0x63, 0x86, 0x76, 0xb7, 0x81, 0xbc,
+	0xd0, 0x20, 0x07, 0x1b, 0x20, 0x42, 0x59, 0x0c, 0xa1, 0x2a, 0x74, 0x27, 0x18, 0x33, 0xd8, 0x05,
+	0xb5, 0x34, 0x4f, 0x7c, 0xd1, 0x33, 0x54, 0x91, 0xbf, 0x78, 0x6b, 0x6d, 0xbf, 0x1a, 0xd9, 0x5e,
+	0x35, 0xcd, 0x93, 0x81, 0x28, 0x21, 0x07, 0x95, 0xf7, 0x94, 0xa4, 0x38, 0x42, 0x7b, 0xdb, 0x68,
+	0x9f, 0x69, 0xda, 0x7d, 0x65, 0xa7, 0x64, 0xc5, 0x50, 0x75, 0x96, 0x48, 0xc5, 0x17, 0x84, 0xe3,
+	0x08, 0x55, 0x0b, 0xa6, 0x2a, 0x59, 0xc1, 0x54, 0x25, 0x5a, 0x5e, 0x8f, 0x60, 0x60, 0xa8, 0x76,
+	0xd3, 0xf5, 0xc8, 0x91, 0xba, 0x9e, 0x53, 0x51, 0x2e, 0x25, 0xc2, 0x80, 0x21, 0x70, 0x93, 0x44,
+	0x8e, 0x94, 0xe4, 0x58, 0x94, 0xf0, 0x0b, 0xa8, 0xc5, 0xe4, 0x63, 0x4e, 0x22, 0xc2, 0x27, 0xa8,
+	0x5e, 0xf0, 0x2f, 0xb0, 0x52, 0x16, 0x3b, 0xe1, 0x3a, 0x11, 0xbe, 0x01, 0x0d, 0x4e, 0x79, 0x10,
+	0xfb, 0x6c, 0x1c, 0x9c, 0x61, 0x86, 0x1a, 0x6d, 0xe3, 0xdf, 0x04, 0xf7, 0x34, 0xc1, 0x2d, 0x45,
+	0xb0, 0x29, 0xb6, 0xbd, 0xba, 0x6c, 0x07, 0xb2, 0xeb, 0x9d, 0x5c, 0xce, 0x4c, 0xe3, 0x6a, 0x66,
+	0x1a, 0xbf, 0x66, 0xa6, 0xf1, 0x7d, 0x6e, 0x96, 0xae, 0xe6, 0x66, 0xe9, 0xe7, 0xdc, 0x2c, 0xbd,
+	0x7d, 0xb4, 0x41, 0xaa, 0x9f, 0xb4, 0xa3, 0x38, 0x08, 0xd9, 0xb2, 0x71, 0x2f, 0xd4, 0x43, 0x28,
+	0x99, 0xc3, 0x8a, 0x7c, 0xb0, 0x9e, 0xfc, 0x1e, 0x00, 0x24, 0x04, 0xfa, 0x7f, 0x25, 0x05, 0x00,
+	0x00,
+}
+
+func (m *PoolEpochStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolEpochStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolEpochStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPoolStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPoolStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.NumExits != 0 {
+		i = encodeVarintPoolStats(dAtA, i, uint64(m.NumExits))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.NumJoins != 0 {
+		i = encodeVarintPoolStats(dAtA, i, uint64(m.NumJoins))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Exited) > 0 {
+		for iNdEx := len(m.Exited) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Exited[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPoolStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Joined) > 0 {
+		for iNdEx := len(m.Joined) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Joined[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPoolStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NumSwaps != 0 {
+		i = encodeVarintPoolStats(dAtA, i, uint64(m.NumSwaps))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SwapFees) > 0 {
+		for iNdEx := len(m.SwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPoolStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SwapVolumeOut) > 0 {
+		for iNdEx := len(m.SwapVolumeOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapVolumeOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPoolStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SwapVolumeIn) > 0 {
+		for iNdEx := len(m.SwapVolumeIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapVolumeIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPoolStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintPoolStats(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPoolStats(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPoolStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPoolStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolEpochStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPoolStats(uint64(m.PoolId))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovPoolStats(uint64(m.Epoch))
+	}
+	if len(m.SwapVolumeIn) > 0 {
+		for _, e := range m.SwapVolumeIn {
+			l = e.Size()
+			n += 1 + l + sovPoolStats(uint64(l))
+		}
+	}
+	if len(m.SwapVolumeOut) > 0 {
+		for _, e := range m.SwapVolumeOut {
+			l = e.Size()
+			n += 1 + l + sovPoolStats(uint64(l))
+		}
+	}
+	if len(m.SwapFees) > 0 {
+		for _, e := range m.SwapFees {
+			l = e.Size()
+			n += 1 + l + sovPoolStats(uint64(l))
+		}
+	}
+	if m.NumSwaps != 0 {
+		n += 1 + sovPoolStats(uint64(m.NumSwaps))
+	}
+	if len(m.Joined) > 0 {
+		for _, e := range m.Joined {
+			l = e.Size()
+			n += 1 + l + sovPoolStats(uint64(l))
+		}
+	}
+	if len(m.Exited) > 0 {
+		for _, e := range m.Exited {
+			l = e.Size()
+			n += 1 + l + sovPoolStats(uint64(l))
+		}
+	}
+	if m.NumJoins != 0 {
+		n += 1 + sovPoolStats(uint64(m.NumJoins))
+	}
+	if m.NumExits != 0 {
+		n += 1 + sovPoolStats(uint64(m.NumExits))
+	}
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovPoolStats(uint64(l))
+		}
+	}
+	l = m.TotalShares.Size()
+	n += 1 + l + sovPoolStats(uint64(l))
+	return n
+}
+
+func sovPoolStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPoolStats(x uint64) (n int) {
+	return sovPoolStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolEpochStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPoolStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolEpochStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolEpochStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapVolumeIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPoolStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoolStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapVolumeIn = append(m.SwapVolumeIn, types.Coin{})
+			if err := m.SwapVolumeIn[len(m.SwapVolumeIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapVolumeOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPoolStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoolStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapVolumeOut = append(m.SwapVolumeOut, types.Coin{})
+			if err := m.SwapVolumeOut[len(m.SwapVolumeOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPoolStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoolStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapFees = append(m.SwapFees, types.Coin{})
+			if err := m.SwapFees[len(m.SwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSwaps", wireType)
+			}
+			m.NumSwaps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSwaps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Joined", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPoolStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoolStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Joined = append(m.Joined, types.Coin{})
+			if err := m.Joined[len(m.Joined)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exited", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPoolStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoolStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exited = append(m.Exited, types.Coin{})
+			if err := m.Exited[len(m.Exited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumJoins", wireType)
+			}
+			m.NumJoins = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumJoins |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumExits", wireType)
+			}
+			m.NumExits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumExits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPoolStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoolStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidity = append(m.Liquidity, types.Coin{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPoolStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPoolStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPoolStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPoolStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPoolStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPoolStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPoolStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPoolStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPoolStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPoolStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPoolStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPoolStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPoolStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPoolStats = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,85 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidatePoolStatus returns an error if status is not a known pool status.
+func ValidatePoolStatus(status PoolStatus) error {
+	if _, ok := PoolStatus_name[int32(status)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidPoolStatus, "unknown pool status %d", status)
+	}
+	return nil
+}
+
+// CheckOpen returns an error if the pool can't be swapped against or joined with the status.
+func (status PoolStatus) CheckOpen(poolId uint64) error {
+	switch status {
+	case PoolActive:
+		return nil
+	case PoolPaused:
+		return sdkerrors.Wrapf(ErrPoolPaused, "pool %d", poolId)
+	case PoolDeprecated:
+		return sdkerrors.Wrapf(ErrPoolDeprecated, "pool %d", poolId)
+	default:
+		return status.CheckExitable(poolId)
+	}
+}
+
+// CheckExitable returns an error if the pool can't be exited with the status.
+func (status PoolStatus) CheckExitable(poolId uint64) error {
+	if status == PoolMigrated {
+		return sdkerrors.Wrapf(ErrPoolMigrated, "pool %d", poolId)
+	}
+	return nil
+}
+
+// ValidatePoolStatusChange returns an error if governance can't change the status of a pool from status to newStatus.
+// Paused pools can be reactivated, but deprecated pools can't, and pools are only migrated by a migration.
+func ValidatePoolStatusChange(status, newStatus PoolStatus) error {
+	if err := ValidatePoolStatus(newStatus); err != nil {
+		return err
+	}
+	if newStatus == PoolMigrated {
+		return sdkerrors.Wrapf(ErrInvalidPoolStatus, "pools can only be migrated by a pool migration")
+	}
+	if status == PoolDeprecated || status == PoolMigrated {
+		return sdkerrors.Wrapf(ErrInvalidPoolStatus, "the status of a %s pool can't be changed", status)
+	}
+	if status == newStatus {
+		return sdkerrors.Wrapf(ErrInvalidPoolStatus, "the pool is already %s", status)
+	}
+	return nil
+}
+
+// CheckMigratable returns an error if the liquidity of the pool can't be migrated, because it has no shares.
+func CheckMigratable(pool PoolI) error {
+	_, err := sharePoolAssets(pool)
+	return err
+}
+
+// DrainPool sets the balance of every asset of a pool with shares to zero,
+// once its tokens were moved to another pool.
+func DrainPool(pool PoolI) error {
+	poolAssets, err := sharePoolAssets(pool)
+	if err != nil {
+		return err
+	}
+
+	for i := range poolAssets {
+		poolAssets[i].Token.Amount = sdk.ZeroInt()
+	}
+	return nil
+}
+
+func sharePoolAssets(pool PoolI) ([]PoolAsset, error) {
+	switch pool := pool.(type) {
+	case *BalancerPool:
+		return pool.PoolAssets, nil
+	case *StableswapPool:
+		return pool.PoolAssets, nil
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidPoolMigration, "pool %d has no shares", pool.GetId())
+	}
+}
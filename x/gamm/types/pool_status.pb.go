@@ -0,0 +1,418 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/v1beta1/pool_status.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PoolStatus is the lifecycle status of a pool, set by governance.
+type PoolStatus int32
+
+const (
+	PoolActive     PoolStatus = 0
+	PoolPaused     PoolStatus = 1
+	PoolDeprecated PoolStatus = 2
+	PoolMigrated   PoolStatus = 3
+)
+
+var PoolStatus_name = map[int32]string{
+	0: "PoolActive",
+	1: "PoolPaused",
+	2: "PoolDeprecated",
+	3: "PoolMigrated",
+}
+
+var PoolStatus_value = map[string]int32{
+	"PoolActive":     0,
+	"PoolPaused":     1,
+	"PoolDeprecated": 2,
+	"PoolMigrated":   3,
+}
+
+func (x PoolStatus) String() string {
+	return proto.EnumName(PoolStatus_name, int32(x))
+}
+
+func (PoolStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5d4df60d8de4eeba, []int{0}
+}
+
+// PoolStatusRecord is the status of a pool that isn't active.
+type PoolStatusRecord struct {
+	PoolId uint64     `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Status PoolStatus `protobuf:"varint,2,opt,name=status,proto3,enum=osmosis.gamm.v1beta1.PoolStatus" json:"status,omitempty" yaml:"status"`
+	// The pool the liquidity was moved to, for migrated pools. The holders of
+	// the shares of a migrated pool replace them by as many shares of this pool.
+	MigratedToPoolId uint64 `protobuf:"varint,3,opt,name=migrated_to_pool_id,json=migratedToPoolId,proto3" json:"migrated_to_pool_id,omitempty" yaml:"migrated_to_pool_id"`
+}
+
+func (m *PoolStatusRecord) Reset()         { *m = PoolStatusRecord{} }
+func (m *PoolStatusRecord) String() string { return proto.CompactTextString(m) }
+func (*PoolStatusRecord) ProtoMessage()    {}
+func (*PoolStatusRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d4df60d8de4eeba, []int{0}
+}
+func (m *PoolStatusRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolStatusRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolStatusRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolStatusRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolStatusRecord.Merge(m, src)
+}
+func (m *PoolStatusRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolStatusRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolStatusRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolStatusRecord proto.InternalMessageInfo
+
+func (m *PoolStatusRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolStatusRecord) GetStatus() PoolStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PoolActive
+}
+
+func (m *PoolStatusRecord) GetMigratedToPoolId() uint64 {
+	if m != nil {
+		return m.MigratedToPoolId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("osmosis.gamm.v1beta1.PoolStatus", PoolStatus_name, PoolStatus_value)
+	proto.RegisterType((*PoolStatusRecord)(nil), "osmosis.gamm.v1beta1.PoolStatusRecord")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/v1beta1/pool_status.proto", fileDescriptor_5d4df60d8de4eeba)
+}
+
+var fileDescriptor_5d4df60d8de4eeba = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0xc6, 0xb3, 0x6d, 0xa9, 0xb0, 0x68, 0x58, 0xd7, 0x1e, 0x4a, 0x0f, 0xdb, 0x92, 0x83, 0x14,
+	0xff, 0x24, 0x54, 0x6f, 0xde, 0x2c, 0x22, 0x88, 0x14, 0x4a, 0xf4, 0x20, 0x5e, 0xca, 0x26, 0x59,
+	0x62, 0x20, 0x61, 0x42, 0x76, 0x5b, 0xec, 0x1b, 0x78, 0xf4, 0x1d, 0x7c, 0x19, 0x8f, 0x3d, 0x0a,
+	0x42, 0x91, 0xf6, 0x0d, 0xf2, 0x04, 0x92, 0x6c, 0x42, 0x2f, 0xbd, 0xcd, 0x37, 0xfb, 0xcd, 0xef,
+	0x1b, 0x76, 0xf0, 0x29, 0xc8, 0x04, 0x64, 0x24, 0x9d, 0x90, 0x27, 0x89, 0xb3, 0x18, 0x79, 0x42,
+	0xf1, 0x91, 0x93, 0x02, 0xc4, 0x33, 0xa9, 0xb8, 0x9a, 0x4b, 0x3b, 0xcd, 0x40, 0x01, 0xed, 0x54,
+	0x3e, 0xbb, 0xf0, 0xd9, 0x95, 0xaf, 0xd7, 0x09, 0x21, 0x84, 0xd2, 0xe0, 0x14, 0x95, 0xf6, 0x5a,
+	0xbf, 0x08, 0x93, 0x29, 0x40, 0xfc, 0x54, 0x02, 0x5c, 0xe1, 0x43, 0x16, 0xd0, 0x73, 0x7c, 0x50,
+	0x52, 0xa3, 0xa0, 0x8b, 0x06, 0x68, 0xd8, 0x1a, 0xd3, 0x7c, 0xdd, 0x37, 0x97, 0x3c, 0x89, 0x6f,
+	0xac, 0xea, 0xc1, 0x72, 0xdb, 0x45, 0xf5, 0x10, 0xd0, 0x47, 0xdc, 0xd6, 0xe9, 0xdd, 0xc6, 0x00,
+	0x0d, 0xcd, 0xab, 0x81, 0xbd, 0x2f, 0xde, 0xde, 0x85, 0x8c, 0x8f, 0xf3, 0x75, 0xff, 0x48, 0xd3,
+	0xf4, 0xa4, 0xe5, 0x56, 0x08, 0x3a, 0xc1, 0x27, 0x49, 0x14, 0x66, 0x5c, 0x89, 0x60, 0xa6, 0x60,
+	0x56, 0x6f, 0xd1, 0x2c, 0xb7, 0x60, 0xf9, 0xba, 0xdf, 0xd3, 0x73, 0x7b, 0x4c, 0x96, 0x4b, 0xea,
+	0xee, 0x33, 0x4c, 0xcb, 0xdd, 0xce, 0x5e, 0x30, 0xde, 0xe5, 0x52, 0x53, 0xab, 0x5b, 0x5f, 0x45,
+	0x0b, 0x41, 0x8c, 0x5a, 0x4f, 0xf9, 0x5c, 0x8a, 0x80, 0x20, 0x4a, 0xb1, 0x59, 0xe8, 0x3b, 0x91,
+	0x66, 0xc2, 0x2f, 0x38, 0xa4, 0x41, 0x09, 0x3e, 0x2c, 0x7a, 0x93, 0x8a, 0x4c, 0x9a, 0xbd, 0xd6,
+	0xc7, 0x17, 0x33, 0xc6, 0xf7, 0xdf, 0x1b, 0x86, 0x56, 0x1b, 0x86, 0xfe, 0x36, 0x0c, 0x7d, 0x6e,
+	0x99, 0xb1, 0xda, 0x32, 0xe3, 0x67, 0xcb, 0x8c, 0xd7, 0x8b, 0x30, 0x52, 0x6f, 0x73, 0xcf, 0xf6,
+	0x21, 0x71, 0xaa, 0x9f, 0xb8, 0x8c, 0xb9, 0x27, 0x6b, 0xe1, 0xbc, 0xeb, 0xfb, 0xa9, 0x65, 0x2a,
+	0xa4, 0xd7, 0x2e, 0xcf, 0x70, 0xfd, 0x3f, 0x00, 0x96, 0x01, 0xfd, 0x08, 0xdc, 0x01, 0x00, 0x00,
+}
+
+func (m *PoolStatusRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolStatusRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolStatusRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MigratedToPoolId != 0 {
+		i = encodeVarintPoolStatus(dAtA, i, uint64(m.MigratedToPoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintPoolStatus(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPoolStatus(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPoolStatus(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPoolStatus(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolStatusRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPoolStatus(uint64(m.PoolId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovPoolStatus(uint64(m.Status))
+	}
+	if m.MigratedToPoolId != 0 {
+		n += 1 + sovPoolStatus(uint64(m.MigratedToPoolId))
+	}
+	return n
+}
+
+func sovPoolStatus(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPoolStatus(x uint64) (n int) {
+	return sovPoolStatus(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolStatusRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPoolStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolStatusRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolStatusRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PoolStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedToPoolId", wireType)
+			}
+			m.MigratedToPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPoolStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigratedToPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPoolStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPoolStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPoolStatus(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPoolStatus
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPoolStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPoolStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPoolStatus
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPoolStatus
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPoolStatus
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPoolStatus        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPoolStatus          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPoolStatus = fmt.Errorf("proto: unexpected end of group")
+)
@@ -604,3 +604,101 @@ func TestPoolParamStartTime(t *testing.T) {
 		)
 	}
 }
+
+func TestBalancerPoolSwapAndJoinExitAmounts(t *testing.T) {
+	pacc, err := NewBalancerPool(defaultPoolId, defaultBalancerPoolParams, []PoolAsset{
+		{
+			Weight: sdk.NewInt(100),
+			Token:  sdk.NewCoin("foo", sdk.NewInt(5000000)),
+		},
+		{
+			Weight: sdk.NewInt(200),
+			Token:  sdk.NewCoin("bar", sdk.NewInt(5000000)),
+		},
+	}, defaultFutureGovernor, defaultCurBlockTime)
+	require.NoError(t, err)
+	pacc.AddTotalShares(InitPoolSharesSupply)
+
+	// s = (5000000/100) / (5000000/200) = 2
+	spotPrice, err := pacc.SpotPrice("foo", "bar", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2).String(), spotPrice.String())
+
+	_, err = pacc.SpotPrice("foo", "baz", sdk.ZeroDec())
+	require.Error(t, err)
+
+	tokenOut, err := pacc.SwapOutGivenIn(sdk.NewCoin("foo", sdk.NewInt(100000)), "bar", defaultSwapFee)
+	require.NoError(t, err)
+	require.Equal(t, "bar", tokenOut.Denom)
+	require.True(t, tokenOut.Amount.IsPositive())
+
+	tokenIn, err := pacc.SwapInGivenOut(tokenOut, "foo", defaultSwapFee)
+	require.NoError(t, err)
+	require.Equal(t, "foo", tokenIn.Denom)
+	require.True(t, sdk.NewInt(100000).Sub(tokenIn.Amount).ToDec().Abs().LTE(sdk.NewDec(2)))
+
+	_, err = pacc.SwapInGivenOut(sdk.NewCoin("bar", sdk.NewInt(5000000)), "foo", defaultSwapFee)
+	require.Error(t, err)
+
+	// Joining with 10% of the shares requires 10% of every asset.
+	coins, err := pacc.JoinPoolCoins(InitPoolSharesSupply.QuoRaw(10))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("bar", sdk.NewInt(500000)), sdk.NewCoin("foo", sdk.NewInt(500000))), coins)
+
+	// Exiting with 10% of the shares returns 10% of every asset, minus the exit fee.
+	coins, err = pacc.ExitPoolCoins(InitPoolSharesSupply.QuoRaw(10))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("bar", sdk.NewInt(487500)), sdk.NewCoin("foo", sdk.NewInt(487500))), coins)
+
+	_, err = pacc.JoinPoolCoins(sdk.ZeroInt())
+	require.Error(t, err)
+
+	shareOut, err := pacc.JoinPoolShares(sdk.NewCoin("foo", sdk.NewInt(50000)))
+	require.NoError(t, err)
+	require.True(t, shareOut.IsPositive())
+
+	tokenIn, err = pacc.JoinPoolTokenIn("foo", shareOut)
+	require.NoError(t, err)
+	require.True(t, sdk.NewInt(50000).Sub(tokenIn.Amount).ToDec().Abs().LTE(sdk.NewDec(2)))
+
+	tokenOut, err = pacc.ExitPoolTokenOut("bar", shareOut)
+	require.NoError(t, err)
+	require.True(t, tokenOut.Amount.IsPositive())
+
+	shareIn, err := pacc.ExitPoolShares(tokenOut)
+	require.NoError(t, err)
+	require.True(t, shareIn.LTE(shareOut) && shareOut.Sub(shareIn).LTE(shareOut.QuoRaw(10000)))
+}
+
+func TestStableswapPoolSwapAndJoinExitAmounts(t *testing.T) {
+	pacc, err := NewStableswapPool(defaultPoolId, StableswapPoolParams{
+		SwapFee: defaultSwapFee,
+		ExitFee: defaultExitFee,
+	}, sdk.NewCoins(
+		sdk.NewCoin("foo", sdk.NewInt(5000000)),
+		sdk.NewCoin("bar", sdk.NewInt(5000000)),
+	), 100, defaultFutureGovernor)
+	require.NoError(t, err)
+	pacc.AddTotalShares(InitPoolSharesSupply)
+
+	tokenOut, err := pacc.SwapOutGivenIn(sdk.NewCoin("foo", sdk.NewInt(100000)), "bar", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.True(t, tokenOut.Amount.LT(sdk.NewInt(100000)))
+	require.True(t, tokenOut.Amount.GT(sdk.NewInt(99000)))
+
+	_, err = pacc.SwapInGivenOut(sdk.NewCoin("bar", sdk.NewInt(5000000)), "foo", sdk.ZeroDec())
+	require.Error(t, err)
+
+	coins, err := pacc.JoinPoolCoins(InitPoolSharesSupply.QuoRaw(10))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("bar", sdk.NewInt(500000)), sdk.NewCoin("foo", sdk.NewInt(500000))), coins)
+
+	_, err = pacc.JoinPoolShares(sdk.NewCoin("foo", sdk.NewInt(50000)))
+	require.ErrorIs(t, err, ErrUnsupportedPoolOperation)
+	_, err = pacc.JoinPoolTokenIn("foo", InitPoolSharesSupply)
+	require.ErrorIs(t, err, ErrUnsupportedPoolOperation)
+	_, err = pacc.ExitPoolTokenOut("foo", InitPoolSharesSupply)
+	require.ErrorIs(t, err, ErrUnsupportedPoolOperation)
+	_, err = pacc.ExitPoolShares(sdk.NewCoin("foo", sdk.NewInt(50000)))
+	require.ErrorIs(t, err, ErrUnsupportedPoolOperation)
+}
@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
 
 type SwapAmountInRoutes []SwapAmountInRoute
 
@@ -19,6 +22,55 @@ func (routes SwapAmountInRoutes) Validate() error {
 	return nil
 }
 
+// TokenOutDenom returns the denom the route ends in.
+func (route SwapAmountInSplitRoute) TokenOutDenom() string {
+	if len(route.Pools) == 0 {
+		return ""
+	}
+	return route.Pools[len(route.Pools)-1].TokenOutDenom
+}
+
+type SwapAmountInSplitRoutes []SwapAmountInSplitRoute
+
+// Validate checks that there are at most MaxRouteSplits routes,
+// each swapping a positive amount, and that all of them end in the same denom.
+func (routes SwapAmountInSplitRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	if len(routes) > MaxRouteSplits {
+		return sdkerrors.Wrapf(ErrInvalidSplitRoutes, "at most %d routes are allowed", MaxRouteSplits)
+	}
+
+	for _, route := range routes {
+		err := SwapAmountInRoutes(route.Pools).Validate()
+		if err != nil {
+			return err
+		}
+
+		if !route.TokenInAmount.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidSplitRoutes, "token in amount should be positive")
+		}
+
+		if route.TokenOutDenom() != routes[0].TokenOutDenom() {
+			return sdkerrors.Wrapf(ErrInvalidSplitRoutes, "routes end in different denoms (%s, %s)",
+				routes[0].TokenOutDenom(), route.TokenOutDenom())
+		}
+	}
+
+	return nil
+}
+
+// TokenInAmount returns the sum of the amounts swapped by the routes.
+func (routes SwapAmountInSplitRoutes) TokenInAmount() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, route := range routes {
+		total = total.Add(route.TokenInAmount)
+	}
+	return total
+}
+
 type SwapAmountOutRoutes []SwapAmountOutRoute
 
 func (routes SwapAmountOutRoutes) Validate() error {
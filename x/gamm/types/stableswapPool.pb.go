@@ -0,0 +1,864 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/v1beta1/stableswapPool.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StableswapPoolParams defined the parameters that will be managed by the pool
+// governance in the future. Like BalancerPoolParams, these are not managed by
+// the chain governance.
+type StableswapPoolParams struct {
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swapFee" yaml:"swap_fee"`
+	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exitFee" yaml:"exit_fee"`
+}
+
+func (m *StableswapPoolParams) Reset()         { *m = StableswapPoolParams{} }
+func (m *StableswapPoolParams) String() string { return proto.CompactTextString(m) }
+func (*StableswapPoolParams) ProtoMessage()    {}
+func (*StableswapPoolParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dccb25f5d4587693, []int{0}
+}
+func (m *StableswapPoolParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StableswapPoolParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StableswapPoolParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StableswapPoolParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StableswapPoolParams.Merge(m, src)
+}
+func (m *StableswapPoolParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *StableswapPoolParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_StableswapPoolParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StableswapPoolParams proto.InternalMessageInfo
+
+// StableswapPool is a pool for assets that are expected to trade near a 1:1
+// peg. Swaps follow the Curve stableswap invariant:
+//
+//	A * n^n * sum(x_i) + D = A * D * n^n + D^(n+1) / (n^n * prod(x_i))
+//
+// where A is the amplification parameter. A larger A flattens the curve
+// around the peg, giving less slippage for balanced pools.
+type StableswapPool struct {
+	Address    string               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Id         uint64               `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	PoolParams StableswapPoolParams `protobuf:"bytes,3,opt,name=poolParams,proto3" json:"poolParams" yaml:"stableswap_pool_params"`
+	// This string specifies who will govern the pool in the future.
+	// It has the same format as BalancerPool.future_pool_governor.
+	FuturePoolGovernor string `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
+	// sum of all LP tokens sent out
+	TotalShares types.Coin `protobuf:"bytes,5,opt,name=totalShares,proto3" json:"totalShares" yaml:"total_shares"`
+	// These are assumed to be sorted by denomination.
+	// Every asset carries the same weight, as the stableswap invariant
+	// treats all assets symmetrically.
+	PoolAssets []PoolAsset `protobuf:"bytes,6,rep,name=poolAssets,proto3" json:"poolAssets" yaml:"pool_assets"`
+	// sum of all non-normalized pool weights
+	TotalWeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=totalWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"totalWeight" yaml:"total_weight"`
+	// amplification parameter A of the stableswap invariant
+	AmplificationParameter uint64 `protobuf:"varint,8,opt,name=amplification_parameter,json=amplificationParameter,proto3" json:"amplification_parameter,omitempty" yaml:"amplification_parameter"`
+}
+
+func (m *StableswapPool) Reset()      { *m = StableswapPool{} }
+func (*StableswapPool) ProtoMessage() {}
+func (*StableswapPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dccb25f5d4587693, []int{1}
+}
+func (m *StableswapPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StableswapPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StableswapPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StableswapPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StableswapPool.Merge(m, src)
+}
+func (m *StableswapPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *StableswapPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_StableswapPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StableswapPool proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*StableswapPoolParams)(nil), "osmosis.gamm.v1beta1.StableswapPoolParams")
+	proto.RegisterType((*StableswapPool)(nil), "osmosis.gamm.v1beta1.StableswapPool")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/v1beta1/stableswapPool.proto", fileDescriptor_dccb25f5d4587693)
+}
+
+var fileDescriptor_dccb25f5d4587693 = []byte{
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x3d, 0x6f, 0xd3, 0x40,
+	0x1c, 0xc6, 0xed, 0xb4, 0x69, 0xc0, 0x91, 0x82, 0x38, 0x22, 0x70, 0x53, 0x61, 0x47, 0x96, 0x80,
+	0x80, 0x1a, 0x5b, 0x2d, 0x5b, 0xb7, 0x1a, 0x28, 0xea, 0x16, 0xdc, 0x01, 0x44, 0x86, 0xe8, 0xec,
+	0x5c, 0x1c, 0x0b, 0xdb, 0x67, 0x7c, 0x97, 0xbe, 0x7c, 0x03, 0x46, 0x46, 0xc6, 0x0e, 0x7c, 0x04,
+	0x3e, 0x44, 0xc7, 0x88, 0x09, 0x31, 0x58, 0x28, 0xf9, 0x06, 0xf9, 0x04, 0xe8, 0x5e, 0x12, 0x12,
+	0xe4, 0x05, 0x31, 0x25, 0xfe, 0xdf, 0x73, 0xbf, 0x7b, 0x9e, 0xc7, 0x67, 0xed, 0x29, 0x26, 0x09,
+	0x26, 0x11, 0x71, 0x42, 0x98, 0x24, 0xce, 0xf9, 0x81, 0x8f, 0x28, 0x3c, 0x70, 0x08, 0x85, 0x7e,
+	0x8c, 0xc8, 0x05, 0xcc, 0x7a, 0x18, 0xc7, 0x76, 0x96, 0x63, 0x8a, 0x41, 0x53, 0x4a, 0x6d, 0x26,
+	0xb5, 0xa5, 0xb4, 0xb5, 0x1b, 0xf0, 0xf1, 0x80, 0x6b, 0x1c, 0xf1, 0x20, 0x36, 0xb4, 0x9a, 0x21,
+	0x0e, 0xb1, 0x98, 0xb3, 0x7f, 0x72, 0x6a, 0x08, 0x8d, 0xe3, 0x43, 0x82, 0x56, 0x07, 0x06, 0x38,
+	0x4a, 0xe5, 0xfa, 0x93, 0x52, 0x47, 0x3e, 0x8c, 0x61, 0x1a, 0xa0, 0xfc, 0x8f, 0x1f, 0x6b, 0xaa,
+	0x6a, 0xcd, 0xb3, 0x0d, 0xa3, 0x3d, 0x98, 0xc3, 0x84, 0x80, 0xbe, 0x56, 0x63, 0x93, 0x13, 0x84,
+	0x74, 0xb5, 0xad, 0x76, 0x6e, 0xbb, 0xc7, 0x37, 0x85, 0xa9, 0xfc, 0x2c, 0xcc, 0xc7, 0x61, 0x44,
+	0xc7, 0x13, 0xdf, 0x0e, 0x70, 0x22, 0x9d, 0xca, 0x9f, 0x2e, 0x19, 0x7e, 0x70, 0xe8, 0x55, 0x86,
+	0x88, 0xfd, 0x12, 0x05, 0x8b, 0xc2, 0xbc, 0x73, 0x05, 0x93, 0xf8, 0xc8, 0x62, 0x98, 0xc1, 0x08,
+	0x21, 0xcb, 0x5b, 0x12, 0x19, 0x1c, 0x5d, 0x46, 0x94, 0xc1, 0x2b, 0xff, 0x07, 0x67, 0x18, 0x09,
+	0x97, 0x44, 0xeb, 0x6b, 0x55, 0x6b, 0x6c, 0x46, 0x02, 0xfb, 0x5a, 0x0d, 0x0e, 0x87, 0x39, 0x22,
+	0x44, 0x86, 0x01, 0x8b, 0xc2, 0x6c, 0x08, 0x82, 0x5c, 0xb0, 0xbc, 0xa5, 0x04, 0x34, 0xb4, 0x4a,
+	0x34, 0xe4, 0xc6, 0xb6, 0xbd, 0x4a, 0x34, 0x04, 0x1f, 0x35, 0x2d, 0x5b, 0x15, 0xa3, 0x6f, 0xb5,
+	0xd5, 0x4e, 0xfd, 0xf0, 0x99, 0x5d, 0xf6, 0x22, 0xed, 0xb2, 0x2a, 0xdd, 0x47, 0x2c, 0xdc, 0xa2,
+	0x30, 0x1f, 0xca, 0x3e, 0x56, 0x9a, 0x01, 0xc3, 0x0e, 0x32, 0xae, 0xb2, 0xbc, 0xb5, 0x43, 0xc0,
+	0x1b, 0xad, 0x39, 0x9a, 0xd0, 0x49, 0x8e, 0x84, 0x24, 0xc4, 0xe7, 0x28, 0x4f, 0x71, 0xae, 0x6f,
+	0x73, 0xf7, 0xe6, 0xa2, 0x30, 0xf7, 0x04, 0xac, 0x4c, 0x65, 0x79, 0x40, 0x8c, 0x99, 0x87, 0xd7,
+	0x72, 0x08, 0xde, 0x69, 0x75, 0x8a, 0x29, 0x8c, 0xcf, 0xc6, 0x30, 0x47, 0x44, 0xaf, 0xf2, 0x18,
+	0xbb, 0xb6, 0xbc, 0x6c, 0xec, 0x22, 0xad, 0x52, 0xbc, 0xc0, 0x51, 0xea, 0xee, 0x49, 0xd7, 0xf7,
+	0xc4, 0x41, 0x7c, 0xef, 0x80, 0xf0, 0xcd, 0x96, 0xb7, 0x8e, 0x02, 0x7d, 0xd1, 0xcf, 0x31, 0x21,
+	0x88, 0x12, 0x7d, 0xa7, 0xbd, 0xd5, 0xa9, 0x1f, 0x9a, 0xe5, 0xfd, 0xf4, 0x96, 0x3a, 0xb7, 0x25,
+	0xf1, 0x40, 0xe0, 0x79, 0x00, 0xc8, 0x09, 0xb2, 0x09, 0x81, 0x03, 0xa1, 0xb4, 0xfd, 0x16, 0x45,
+	0xe1, 0x98, 0xea, 0x35, 0x5e, 0xc0, 0xab, 0x7f, 0xb8, 0x2e, 0xa7, 0x29, 0xfd, 0x3b, 0xc5, 0x05,
+	0x67, 0x59, 0xde, 0x3a, 0x19, 0xf4, 0xb5, 0x07, 0x30, 0xc9, 0xe2, 0x68, 0x14, 0x05, 0x90, 0x46,
+	0x38, 0x15, 0xef, 0x05, 0x51, 0x94, 0xeb, 0xb7, 0xd8, 0x55, 0x70, 0xad, 0x45, 0x61, 0x1a, 0xf2,
+	0xce, 0x94, 0x0b, 0x2d, 0xef, 0xfe, 0xc6, 0x4a, 0x6f, 0xb9, 0x70, 0x74, 0xf7, 0xd3, 0xb5, 0xa9,
+	0x7c, 0xb9, 0x36, 0x95, 0xef, 0xdf, 0xba, 0x55, 0x56, 0xc2, 0xa9, 0x7b, 0x72, 0x33, 0x33, 0xd4,
+	0xe9, 0xcc, 0x50, 0x7f, 0xcd, 0x0c, 0xf5, 0xf3, 0xdc, 0x50, 0xa6, 0x73, 0x43, 0xf9, 0x31, 0x37,
+	0x94, 0xf7, 0xfb, 0x6b, 0xa9, 0x64, 0x8b, 0xdd, 0x18, 0xfa, 0x64, 0xf9, 0xe0, 0x5c, 0x8a, 0xcf,
+	0x9a, 0xe7, 0xf3, 0x77, 0xf8, 0x87, 0xfc, 0xfc, 0xf7, 0x00, 0x0c, 0xfd, 0xfb, 0x45, 0x85, 0x04,
+	0x00, 0x00,
+}
+
+func (m *StableswapPoolParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StableswapPoolParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StableswapPoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExitFee.Size()
+		i -= size
+		if _, err := m.ExitFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StableswapPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StableswapPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StableswapPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AmplificationParameter != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.AmplificationParameter))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.TotalWeight.Size()
+		i -= size
+		if _, err := m.TotalWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.PoolAssets) > 0 {
+		for iNdEx := len(m.PoolAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.FuturePoolGovernor) > 0 {
+		i -= len(m.FuturePoolGovernor)
+		copy(dAtA[i:], m.FuturePoolGovernor)
+		i = encodeVarintStableswapPool(dAtA, i, uint64(len(m.FuturePoolGovernor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.PoolParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintStableswapPool(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStableswapPool(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStableswapPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStableswapPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StableswapPoolParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapFee.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = m.ExitFee.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	return n
+}
+
+func (m *StableswapPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovStableswapPool(uint64(m.Id))
+	}
+	l = m.PoolParams.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = len(m.FuturePoolGovernor)
+	if l > 0 {
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	l = m.TotalShares.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	if len(m.PoolAssets) > 0 {
+		for _, e := range m.PoolAssets {
+			l = e.Size()
+			n += 1 + l + sovStableswapPool(uint64(l))
+		}
+	}
+	l = m.TotalWeight.Size()
+	n += 1 + l + sovStableswapPool(uint64(l))
+	if m.AmplificationParameter != 0 {
+		n += 1 + sovStableswapPool(uint64(m.AmplificationParameter))
+	}
+	return n
+}
+
+func sovStableswapPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStableswapPool(x uint64) (n int) {
+	return sovStableswapPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StableswapPoolParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StableswapPoolParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StableswapPoolParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StableswapPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StableswapPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StableswapPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuturePoolGovernor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FuturePoolGovernor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAssets = append(m.PoolAssets, PoolAsset{})
+			if err := m.PoolAssets[len(m.PoolAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmplificationParameter", wireType)
+			}
+			m.AmplificationParameter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmplificationParameter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStableswapPool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStableswapPool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStableswapPool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStableswapPool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStableswapPool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStableswapPool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStableswapPool = fmt.Errorf("proto: unexpected end of group")
+)
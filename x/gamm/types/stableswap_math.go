@@ -0,0 +1,164 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Newton's method parameters for the stableswap invariant.
+// Don't EVER change after initializing
+var stableswapPrecision = sdk.NewDecWithPrec(1, 12)
+
+const stableswapMaxIterations = 255
+
+// stableswapAnn returns A * n^n, the amplification coefficient of the invariant.
+func stableswapAnn(amp uint64, numAssets int) sdk.Dec {
+	ann := sdk.NewDecFromIntWithPrec(sdk.NewIntFromUint64(amp), 0)
+	for i := 0; i < numAssets; i++ {
+		ann = ann.MulInt64(int64(numAssets))
+	}
+	return ann
+}
+
+// stableswapDP returns D^(n+1) / (n^n * prod(x_i)).
+// It is computed iteratively to keep intermediate values at the magnitude of D.
+func stableswapDP(balances []sdk.Dec, d sdk.Dec) sdk.Dec {
+	n := int64(len(balances))
+	dP := d
+	for _, balance := range balances {
+		dP = dP.Mul(d).Quo(balance.MulInt64(n))
+	}
+	return dP
+}
+
+// calcStableswapInvariant returns the invariant D of the stableswap curve
+//
+//	A * n^n * sum(x_i) + D = A * D * n^n + D^(n+1) / (n^n * prod(x_i))
+//
+// It is solved with Newton's method:
+//
+//	D_{k+1} = (Ann * S + n * D_P) * D_k / ((Ann - 1) * D_k + (n + 1) * D_P)
+func calcStableswapInvariant(balances []sdk.Dec, amp uint64) sdk.Dec {
+	n := int64(len(balances))
+	sum := sdk.ZeroDec()
+	for _, balance := range balances {
+		sum = sum.Add(balance)
+	}
+	if sum.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	ann := stableswapAnn(amp, len(balances))
+	d := sum
+	for i := 0; i < stableswapMaxIterations; i++ {
+		dP := stableswapDP(balances, d)
+		prevD := d
+		numerator := ann.Mul(sum).Add(dP.MulInt64(n)).Mul(d)
+		denominator := ann.Sub(one).Mul(d).Add(dP.MulInt64(n + 1))
+		d = numerator.Quo(denominator)
+		if d.Sub(prevD).Abs().LTE(stableswapPrecision) {
+			break
+		}
+	}
+	return d
+}
+
+// calcStableswapY returns the balance of the asset at index j, such that the pool
+// keeps the invariant d, given the balances of every other asset.
+// The balance at index j in balances is ignored.
+// It solves y^2 + (b - D) * y = c with Newton's method, where
+//
+//	c = D^(n+1) / (n^n * prod(x_k, k != j) * Ann)
+//	b = sum(x_k, k != j) + D / Ann
+func calcStableswapY(balances []sdk.Dec, j int, d sdk.Dec, amp uint64) sdk.Dec {
+	n := int64(len(balances))
+	ann := stableswapAnn(amp, len(balances))
+
+	c := d
+	sum := sdk.ZeroDec()
+	for k, balance := range balances {
+		if k == j {
+			continue
+		}
+		sum = sum.Add(balance)
+		c = c.Mul(d).Quo(balance.MulInt64(n))
+	}
+	c = c.Mul(d).Quo(ann.MulInt64(n))
+	b := sum.Add(d.Quo(ann))
+
+	y := d
+	for i := 0; i < stableswapMaxIterations; i++ {
+		prevY := y
+		y = y.Mul(y).Add(c).Quo(y.MulInt64(2).Add(b).Sub(d))
+		if y.Sub(prevY).Abs().LTE(stableswapPrecision) {
+			break
+		}
+	}
+	return y
+}
+
+// calcStableswapOutGivenIn returns the amount of the asset at outIndex a trader
+// receives for tokenAmountIn of the asset at inIndex.
+// The swap fee is charged on the input, as in calcOutGivenIn.
+func calcStableswapOutGivenIn(
+	balances []sdk.Dec,
+	inIndex, outIndex int,
+	tokenAmountIn,
+	swapFee sdk.Dec,
+	amp uint64,
+) sdk.Dec {
+	d := calcStableswapInvariant(balances, amp)
+
+	newBalances := make([]sdk.Dec, len(balances))
+	copy(newBalances, balances)
+	adjustedIn := tokenAmountIn.Mul(sdk.OneDec().Sub(swapFee))
+	newBalances[inIndex] = balances[inIndex].Add(adjustedIn)
+
+	newBalanceOut := calcStableswapY(newBalances, outIndex, d, amp)
+	return balances[outIndex].Sub(newBalanceOut)
+}
+
+// calcStableswapInGivenOut returns the amount of the asset at inIndex a trader
+// has to provide to receive tokenAmountOut of the asset at outIndex.
+// The swap fee is charged on the input, as in calcInGivenOut.
+func calcStableswapInGivenOut(
+	balances []sdk.Dec,
+	inIndex, outIndex int,
+	tokenAmountOut,
+	swapFee sdk.Dec,
+	amp uint64,
+) sdk.Dec {
+	d := calcStableswapInvariant(balances, amp)
+
+	newBalances := make([]sdk.Dec, len(balances))
+	copy(newBalances, balances)
+	newBalances[outIndex] = balances[outIndex].Sub(tokenAmountOut)
+
+	newBalanceIn := calcStableswapY(newBalances, inIndex, d, amp)
+	tokenAmountInAfterFee := newBalanceIn.Sub(balances[inIndex])
+	return tokenAmountInAfterFee.Quo(sdk.OneDec().Sub(swapFee))
+}
+
+// calcStableswapSpotPrice returns the marginal amount of the asset at inIndex paid
+// per unit of the asset at outIndex. It is the ratio of the partial derivatives of
+// the invariant:
+//
+//	spot_price = (Ann + D_P / x_out) / (Ann + D_P / x_in)
+//
+// and spot_price_with_fee = spot_price / (1 - swapfee)
+func calcStableswapSpotPrice(
+	balances []sdk.Dec,
+	inIndex, outIndex int,
+	swapFee sdk.Dec,
+	amp uint64,
+) sdk.Dec {
+	d := calcStableswapInvariant(balances, amp)
+	dP := stableswapDP(balances, d)
+	ann := stableswapAnn(amp, len(balances))
+
+	number := ann.Add(dP.Quo(balances[outIndex]))
+	denom := ann.Add(dP.Quo(balances[inIndex]))
+	spotPrice := number.Quo(denom)
+
+	scale := sdk.OneDec().Quo(sdk.OneDec().Sub(swapFee))
+	return spotPrice.Mul(scale)
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+var stableswapTestPrecision = sdk.NewDecWithPrec(1, 8)
+
+func TestCalcStableswapInvariant(t *testing.T) {
+	// A balanced pool satisfies D = n * x for any amplification parameter.
+	balances := []sdk.Dec{sdk.NewDec(1000000), sdk.NewDec(1000000), sdk.NewDec(1000000)}
+	for _, amp := range []uint64{1, 100, 1000000} {
+		d := calcStableswapInvariant(balances, amp)
+		require.True(
+			t,
+			sdk.NewDec(3000000).Sub(d).Abs().LTE(stableswapTestPrecision),
+			"amp %d: expected D = 3000000, got %s", amp, d,
+		)
+	}
+
+	// An imbalanced pool has D lower than the sum of balances,
+	// and approaches the sum as the amplification increases.
+	imbalanced := []sdk.Dec{sdk.NewDec(1000000), sdk.NewDec(3000000)}
+	lowAmpD := calcStableswapInvariant(imbalanced, 1)
+	highAmpD := calcStableswapInvariant(imbalanced, 1000)
+	require.True(t, lowAmpD.LT(highAmpD))
+	require.True(t, highAmpD.LT(sdk.NewDec(4000000)))
+
+	require.True(t, calcStableswapInvariant([]sdk.Dec{sdk.ZeroDec(), sdk.ZeroDec()}, 100).IsZero())
+}
+
+func TestCalcStableswapY(t *testing.T) {
+	balances := []sdk.Dec{sdk.NewDec(1000000), sdk.NewDec(2500000), sdk.NewDec(1500000)}
+	amp := uint64(50)
+	d := calcStableswapInvariant(balances, amp)
+
+	// Solving for any balance with the invariant of the pool gives back that balance.
+	for j := range balances {
+		y := calcStableswapY(balances, j, d, amp)
+		require.True(
+			t,
+			balances[j].Sub(y).Abs().LTE(stableswapTestPrecision),
+			"index %d: expected %s, got %s", j, balances[j], y,
+		)
+	}
+}
+
+func TestCalcStableswapSpotPrice(t *testing.T) {
+	balanced := []sdk.Dec{sdk.NewDec(1000000), sdk.NewDec(1000000)}
+	spotPrice := calcStableswapSpotPrice(balanced, 0, 1, sdk.ZeroDec(), 100)
+	require.True(
+		t,
+		sdk.OneDec().Sub(spotPrice).Abs().LTE(stableswapTestPrecision),
+		"expected spot price 1, got %s", spotPrice,
+	)
+
+	// spot_price_with_fee = spot_price / (1 - swapfee)
+	swapFee := sdk.NewDecWithPrec(1, 2)
+	spotPriceWithFee := calcStableswapSpotPrice(balanced, 0, 1, swapFee, 100)
+	expected := spotPrice.Quo(sdk.OneDec().Sub(swapFee))
+	require.True(t, expected.Sub(spotPriceWithFee).Abs().LTE(stableswapTestPrecision))
+
+	// The scarcer asset is more expensive.
+	imbalanced := []sdk.Dec{sdk.NewDec(1000000), sdk.NewDec(3000000)}
+	require.True(t, calcStableswapSpotPrice(imbalanced, 0, 1, sdk.ZeroDec(), 100).LT(sdk.OneDec()))
+	require.True(t, calcStableswapSpotPrice(imbalanced, 1, 0, sdk.ZeroDec(), 100).GT(sdk.OneDec()))
+}
+
+func TestCalcStableswapOutGivenIn(t *testing.T) {
+	balances := []sdk.Dec{sdk.NewDec(1000000), sdk.NewDec(1000000)}
+	tokenIn := sdk.NewDec(10000)
+
+	lowAmpOut := calcStableswapOutGivenIn(balances, 0, 1, tokenIn, sdk.ZeroDec(), 1)
+	highAmpOut := calcStableswapOutGivenIn(balances, 0, 1, tokenIn, sdk.ZeroDec(), 1000)
+
+	// The output never exceeds the input on a balanced pool,
+	// and a higher amplification gives less slippage.
+	require.True(t, lowAmpOut.LT(highAmpOut))
+	require.True(t, highAmpOut.LT(tokenIn))
+	require.True(t, tokenIn.Sub(highAmpOut).LT(sdk.NewDec(10)))
+
+	// A swap fee reduces the output.
+	withFeeOut := calcStableswapOutGivenIn(balances, 0, 1, tokenIn, sdk.NewDecWithPrec(1, 2), 1000)
+	require.True(t, withFeeOut.LT(highAmpOut))
+}
+
+func TestCalcStableswapInGivenOut(t *testing.T) {
+	balances := []sdk.Dec{sdk.NewDec(1000000), sdk.NewDec(2000000), sdk.NewDec(1500000)}
+	swapFee := sdk.NewDecWithPrec(3, 3)
+	amp := uint64(200)
+
+	tokenOut := sdk.NewDec(50000)
+	tokenIn := calcStableswapInGivenOut(balances, 1, 2, tokenOut, swapFee, amp)
+	require.True(t, tokenIn.GT(tokenOut))
+
+	// Swapping the computed input back gives the requested output.
+	roundTripOut := calcStableswapOutGivenIn(balances, 1, 2, tokenIn, swapFee, amp)
+	require.True(
+		t,
+		tokenOut.Sub(roundTripOut).Abs().LTE(stableswapTestPrecision),
+		"expected %s, got %s", tokenOut, roundTripOut,
+	)
+}
@@ -0,0 +1,369 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	MinStableswapAmplification = 1
+	MaxStableswapAmplification = 1_000_000
+)
+
+// StableswapAssetWeight is the internal weight given to every asset of a stableswap pool.
+// The stableswap invariant treats all assets symmetrically, so the weight only exists to
+// satisfy the PoolI weight accessors.
+var StableswapAssetWeight = sdk.NewInt(GuaranteedWeightPrecision)
+
+// NewStableswapPool returns a stableswap pool with the provided parameters, and initial assets.
+// Invariants that are assumed to be satisfied and not checked:
+// (This is handled in ValidateBasic)
+// * 2 <= len(initialLiquidity) <= 8
+// * FutureGovernor is valid
+// * poolID doesn't already exist
+func NewStableswapPool(poolId uint64, stableswapPoolParams StableswapPoolParams, initialLiquidity sdk.Coins, amplificationParameter uint64, futureGovernor string) (PoolI, error) {
+	err := stableswapPoolParams.Validate()
+	if err != nil {
+		return &StableswapPool{}, err
+	}
+
+	err = ValidateAmplificationParameter(amplificationParameter)
+	if err != nil {
+		return &StableswapPool{}, err
+	}
+
+	pool := &StableswapPool{
+		Address:                NewPoolAddress(poolId).String(),
+		Id:                     poolId,
+		PoolParams:             stableswapPoolParams,
+		FuturePoolGovernor:     futureGovernor,
+		TotalShares:            sdk.NewCoin(GetPoolShareDenom(poolId), sdk.ZeroInt()),
+		TotalWeight:            sdk.ZeroInt(),
+		AmplificationParameter: amplificationParameter,
+	}
+
+	err = pool.setInitialPoolAssets(initialLiquidity)
+	if err != nil {
+		return &StableswapPool{}, err
+	}
+
+	return pool, nil
+}
+
+func (params StableswapPoolParams) Validate() error {
+	if err := ValidateExitFee(params.ExitFee); err != nil {
+		return err
+	}
+
+	return ValidateSwapFee(params.SwapFee)
+}
+
+// ValidateAmplificationParameter checks that the amplification parameter is within
+// [MinStableswapAmplification, MaxStableswapAmplification].
+func ValidateAmplificationParameter(amp uint64) error {
+	if amp < MinStableswapAmplification || amp > MaxStableswapAmplification {
+		return ErrInvalidAmplificationParameter
+	}
+	return nil
+}
+
+// setInitialPoolAssets sets the PoolAssets in the pool.
+// It is only designed to be called at the pool's creation.
+// Every asset receives StableswapAssetWeight.
+func (pa *StableswapPool) setInitialPoolAssets(initialLiquidity sdk.Coins) error {
+	exists := make(map[string]bool)
+	poolAssets := make([]PoolAsset, 0, len(initialLiquidity))
+	totalWeight := sdk.ZeroInt()
+
+	for _, coin := range initialLiquidity {
+		if !coin.Amount.IsPositive() {
+			return fmt.Errorf("can't add the zero or negative balance of token")
+		}
+
+		if exists[coin.Denom] {
+			return fmt.Errorf("same PoolAsset already exists")
+		}
+		exists[coin.Denom] = true
+
+		poolAssets = append(poolAssets, PoolAsset{
+			Token:  coin,
+			Weight: StableswapAssetWeight,
+		})
+		totalWeight = totalWeight.Add(StableswapAssetWeight)
+	}
+
+	SortPoolAssetsByDenom(poolAssets)
+	pa.PoolAssets = poolAssets
+	pa.TotalWeight = totalWeight
+
+	return nil
+}
+
+// GetAddress returns the address of a pool.
+// If the pool address is not bech32 valid, it returns an empty address.
+func (pa StableswapPool) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(pa.Address)
+	if err != nil {
+		panic(fmt.Sprintf("could not bech32 decode address of pool with id: %d", pa.GetId()))
+	}
+	return addr
+}
+
+func (pa StableswapPool) GetId() uint64 {
+	return pa.Id
+}
+
+func (pa StableswapPool) GetPoolSwapFee() sdk.Dec {
+	return pa.PoolParams.SwapFee
+}
+
+func (pa StableswapPool) GetPoolExitFee() sdk.Dec {
+	return pa.PoolParams.ExitFee
+}
+
+func (pa StableswapPool) GetPoolParams() StableswapPoolParams {
+	return pa.PoolParams
+}
+
+func (pa StableswapPool) GetAmplificationParameter() uint64 {
+	return pa.AmplificationParameter
+}
+
+func (pa StableswapPool) GetTotalWeight() sdk.Int {
+	return pa.TotalWeight
+}
+
+func (pa StableswapPool) GetTotalShares() sdk.Coin {
+	return pa.TotalShares
+}
+
+func (pa *StableswapPool) AddTotalShares(amt sdk.Int) {
+	pa.TotalShares.Amount = pa.TotalShares.Amount.Add(amt)
+}
+
+func (pa *StableswapPool) SubTotalShares(amt sdk.Int) {
+	pa.TotalShares.Amount = pa.TotalShares.Amount.Sub(amt)
+}
+
+func (pa StableswapPool) GetPoolAsset(denom string) (PoolAsset, error) {
+	_, asset, err := getPoolAssetAndIndex(pa.PoolAssets, denom)
+	return asset, err
+}
+
+func (pa *StableswapPool) UpdatePoolAssetBalance(coin sdk.Coin) error {
+	// Check that PoolAsset exists.
+	assetIndex, existingAsset, err := getPoolAssetAndIndex(pa.PoolAssets, coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	if coin.Amount.LTE(sdk.ZeroInt()) {
+		return fmt.Errorf("can't set the pool's balance of a token to be zero or negative")
+	}
+
+	// Update the supply of the asset
+	existingAsset.Token = coin
+	pa.PoolAssets[assetIndex] = existingAsset
+	return nil
+}
+
+func (pa *StableswapPool) UpdatePoolAssetBalances(coins sdk.Coins) error {
+	// Ensures that there are no duplicate denoms, all denom's are valid,
+	// and amount is > 0
+	err := coins.Validate()
+	if err != nil {
+		return fmt.Errorf("provided coins are invalid, %v", err)
+	}
+
+	for _, coin := range coins {
+		err = pa.UpdatePoolAssetBalance(coin)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (pa StableswapPool) GetPoolAssets(denoms ...string) ([]PoolAsset, error) {
+	result := make([]PoolAsset, 0, len(denoms))
+
+	for _, denom := range denoms {
+		PoolAsset, err := pa.GetPoolAsset(denom)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, PoolAsset)
+	}
+
+	return result, nil
+}
+
+func (pa StableswapPool) GetAllPoolAssets() []PoolAsset {
+	copyslice := make([]PoolAsset, len(pa.PoolAssets))
+	copy(copyslice, pa.PoolAssets)
+	return copyslice
+}
+
+// PokeTokenWeights is a no-op, stableswap pools have constant weights.
+func (pa *StableswapPool) PokeTokenWeights(blockTime time.Time) {}
+
+func (pa StableswapPool) GetTokenWeight(denom string) (sdk.Int, error) {
+	PoolAsset, err := pa.GetPoolAsset(denom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return PoolAsset.Weight, nil
+}
+
+func (pa StableswapPool) GetTokenBalance(denom string) (sdk.Int, error) {
+	PoolAsset, err := pa.GetPoolAsset(denom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return PoolAsset.Token.Amount, nil
+}
+
+func (pa StableswapPool) NumAssets() int {
+	return len(pa.PoolAssets)
+}
+
+func (pa StableswapPool) IsActive(curBlockTime time.Time) bool {
+	return true
+}
+
+func (pa StableswapPool) GetFuturePoolGovernor() string {
+	return pa.FuturePoolGovernor
+}
+
+func (pa *StableswapPool) SetPoolSwapFee(swapFee sdk.Dec) error {
+	if err := ValidateSwapFee(swapFee); err != nil {
+		return err
+	}
+
+	pa.PoolParams.SwapFee = swapFee
+	return nil
+}
+
+func (pa *StableswapPool) SetPoolExitFee(exitFee sdk.Dec) error {
+	if err := ValidateExitFee(exitFee); err != nil {
+		return err
+	}
+
+	pa.PoolParams.ExitFee = exitFee
+	return nil
+}
+
+// ScheduleWeightChange is not supported, as stableswap pools weigh all assets equally.
+func (pa *StableswapPool) ScheduleWeightChange(startTime time.Time, duration time.Duration, targetWeights []PoolAsset) error {
+	return sdkerrors.Wrapf(ErrUnsupportedPoolOperation, "stableswap pool %d has no weights to change", pa.Id)
+}
+
+// balancesAndIndexes returns the balances of the pool, along with the indexes of inDenom and outDenom.
+func (pa StableswapPool) balancesAndIndexes(inDenom, outDenom string) (balances []sdk.Dec, inIndex, outIndex int, err error) {
+	inIndex, _, err = getPoolAssetAndIndex(pa.PoolAssets, inDenom)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	outIndex, _, err = getPoolAssetAndIndex(pa.PoolAssets, outDenom)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	balances = make([]sdk.Dec, len(pa.PoolAssets))
+	for i, asset := range pa.PoolAssets {
+		balances[i] = asset.Token.Amount.ToDec()
+	}
+
+	return balances, inIndex, outIndex, nil
+}
+
+func (pa StableswapPool) SwapOutGivenIn(tokenIn sdk.Coin, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	balances, inIndex, outIndex, err := pa.balancesAndIndexes(tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	tokenOutAmount := calcStableswapOutGivenIn(
+		balances, inIndex, outIndex, tokenIn.Amount.ToDec(), swapFee, pa.AmplificationParameter,
+	).TruncateInt()
+
+	return sdk.Coin{Denom: tokenOutDenom, Amount: tokenOutAmount}, nil
+}
+
+func (pa StableswapPool) SwapInGivenOut(tokenOut sdk.Coin, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	balances, inIndex, outIndex, err := pa.balancesAndIndexes(tokenInDenom, tokenOut.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if tokenOut.Amount.ToDec().GTE(balances[outIndex]) {
+		return sdk.Coin{}, sdkerrors.Wrapf(ErrTooManyTokensOut,
+			"can't get more tokens out than there are tokens in the pool")
+	}
+
+	tokenInAmount := calcStableswapInGivenOut(
+		balances, inIndex, outIndex, tokenOut.Amount.ToDec(), swapFee, pa.AmplificationParameter,
+	).TruncateInt()
+
+	return sdk.Coin{Denom: tokenInDenom, Amount: tokenInAmount}, nil
+}
+
+func (pa *StableswapPool) ApplySwap(tokenIn sdk.Coin, tokenOut sdk.Coin, swapFee, protocolFeeShare sdk.Dec) (sdk.Coin, error) {
+	return applySwapToBalances(pa, tokenIn, tokenOut, swapFee, protocolFeeShare)
+}
+
+func (pa StableswapPool) SpotPrice(tokenInDenom, tokenOutDenom string, swapFee sdk.Dec) (sdk.Dec, error) {
+	balances, inIndex, outIndex, err := pa.balancesAndIndexes(tokenInDenom, tokenOutDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// Migrated pools are left without liquidity.
+	if !balances[inIndex].IsPositive() || !balances[outIndex].IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrNotEnoughLiquidity, "pool %d has no liquidity", pa.Id)
+	}
+
+	return calcStableswapSpotPrice(balances, inIndex, outIndex, swapFee, pa.AmplificationParameter), nil
+}
+
+func (pa StableswapPool) JoinPoolCoins(shareOutAmount sdk.Int) (sdk.Coins, error) {
+	return getProportionalCoins(pa.PoolAssets, pa.TotalShares.Amount, shareOutAmount)
+}
+
+// JoinPoolShares is not supported, stableswap pools can only be joined with all of their assets.
+func (pa StableswapPool) JoinPoolShares(tokenIn sdk.Coin) (shareOutAmount sdk.Int, err error) {
+	return sdk.Int{}, pa.errSingleAssetOperation()
+}
+
+// JoinPoolTokenIn is not supported, stableswap pools can only be joined with all of their assets.
+func (pa StableswapPool) JoinPoolTokenIn(tokenInDenom string, shareOutAmount sdk.Int) (tokenIn sdk.Coin, err error) {
+	return sdk.Coin{}, pa.errSingleAssetOperation()
+}
+
+func (pa StableswapPool) ExitPoolCoins(shareInAmount sdk.Int) (sdk.Coins, error) {
+	exitFee := pa.GetPoolExitFee().MulInt(shareInAmount).TruncateInt()
+	return getProportionalCoins(pa.PoolAssets, pa.TotalShares.Amount, shareInAmount.Sub(exitFee))
+}
+
+// ExitPoolTokenOut is not supported, stableswap pools can only be exited with all of their assets.
+func (pa StableswapPool) ExitPoolTokenOut(tokenOutDenom string, shareInAmount sdk.Int) (tokenOut sdk.Coin, err error) {
+	return sdk.Coin{}, pa.errSingleAssetOperation()
+}
+
+// ExitPoolShares is not supported, stableswap pools can only be exited with all of their assets.
+func (pa StableswapPool) ExitPoolShares(tokenOut sdk.Coin) (shareInAmount sdk.Int, err error) {
+	return sdk.Int{}, pa.errSingleAssetOperation()
+}
+
+func (pa StableswapPool) errSingleAssetOperation() error {
+	return sdkerrors.Wrapf(ErrUnsupportedPoolOperation,
+		"single asset join and exit are not supported by stableswap pool %d", pa.Id)
+}
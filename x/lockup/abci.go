@@ -11,7 +11,7 @@ import (
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
 }
 
-// Called every block to automatically unlock matured locks
+// Called every block to automatically unlock matured locks and delete matured synthetic lockups
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	// disable automatic withdraw before specific block height
 	// it is actually for testing with legacy
@@ -19,6 +19,8 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	if ctx.BlockHeight() < MinBlockHeightToBeginAutoWithdrawing {
 		return []abci.ValidatorUpdate{}
 	}
+	// the synthetic lockups go first, as the locks can't be unlocked before their synthetic lockups matured
+	k.DeleteAllMaturedSyntheticLocks(ctx)
 	k.WithdrawAllMaturedLocks(ctx)
 	return []abci.ValidatorUpdate{}
 }
@@ -8,6 +8,8 @@ import (
 const (
 	FlagDuration    = "duration"
 	FlagMinDuration = "min-duration"
+	FlagAmount      = "amount"
+	FlagStatus      = "status"
 )
 
 // FlagSetLockTokens returns flags for LockTokens msg builder
@@ -24,3 +26,19 @@ func FlagSetMinDuration() *flag.FlagSet {
 	fs.String(FlagMinDuration, "1d", "The minimum duration of token bonded. e.g. 1d, 7d, 14d")
 	return fs
 }
+
+// FlagSetUnlockTokens returns flags for BeginUnlocking msg builder
+func FlagSetUnlockTokens() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagAmount, "", "The amount to be unlocked out of the lock, all of it if not set. e.g. 1osmo")
+	return fs
+}
+
+// FlagSetLockStatus returns flags for the AccountLocks query
+func FlagSetLockStatus() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagStatus, "all", "The unlocking status of the locks to query. One of all, not-unlocking, unlocking")
+	return fs
+}
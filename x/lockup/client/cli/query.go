@@ -41,11 +41,16 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdAccountUnlockedBeforeTime(),
 		GetCmdAccountLockedPastTimeDenom(),
 		GetCmdLockedByID(),
+		GetCmdSyntheticLockupsByLockupID(),
+		GetCmdLocksByDenom(),
+		GetCmdAccountLocks(),
 		GetCmdAccountLockedLongerDuration(),
 		GetCmdAccountLockedLongerDurationNotUnlockingOnly(),
 		GetCmdAccountLockedLongerDurationDenom(),
 		GetCmdTotalLockedByDenom(),
 		GetCmdOutputLocksJson(),
+		GetCmdBreakLocksDryRun(),
+		GetCmdRelockDryRun(),
 	)
 
 	return cmd
@@ -261,9 +266,14 @@ $ %s query lockup account-locked-pasttime <address> <timestamp>
 			}
 			timestamp := time.Unix(i, 0)
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedPastTime(cmd.Context(), &types.AccountLockedPastTimeRequest{Owner: args[0], Timestamp: timestamp})
+			res, err := queryClient.AccountLockedPastTime(cmd.Context(), &types.AccountLockedPastTimeRequest{Owner: args[0], Timestamp: timestamp, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -273,6 +283,7 @@ $ %s query lockup account-locked-pasttime <address> <timestamp>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "locks")
 
 	return cmd
 }
@@ -304,9 +315,14 @@ $ %s query lockup account-locked-pasttime-not-unlocking <address> <timestamp>
 			}
 			timestamp := time.Unix(i, 0)
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedPastTimeNotUnlockingOnly(cmd.Context(), &types.AccountLockedPastTimeNotUnlockingOnlyRequest{Owner: args[0], Timestamp: timestamp})
+			res, err := queryClient.AccountLockedPastTimeNotUnlockingOnly(cmd.Context(), &types.AccountLockedPastTimeNotUnlockingOnlyRequest{Owner: args[0], Timestamp: timestamp, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -316,6 +332,7 @@ $ %s query lockup account-locked-pasttime-not-unlocking <address> <timestamp>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "locks")
 
 	return cmd
 }
@@ -347,9 +364,14 @@ $ %s query lockup account-locked-pasttime <address> <timestamp>
 			}
 			timestamp := time.Unix(i, 0)
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountUnlockedBeforeTime(cmd.Context(), &types.AccountUnlockedBeforeTimeRequest{Owner: args[0], Timestamp: timestamp})
+			res, err := queryClient.AccountUnlockedBeforeTime(cmd.Context(), &types.AccountUnlockedBeforeTimeRequest{Owner: args[0], Timestamp: timestamp, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -359,6 +381,7 @@ $ %s query lockup account-locked-pasttime <address> <timestamp>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "locks")
 
 	return cmd
 }
@@ -392,9 +415,14 @@ $ %s query lockup account-locked-pasttime-denom <address> <timestamp> <denom>
 
 			denom := args[2]
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedPastTimeDenom(cmd.Context(), &types.AccountLockedPastTimeDenomRequest{Owner: args[0], Timestamp: timestamp, Denom: denom})
+			res, err := queryClient.AccountLockedPastTimeDenom(cmd.Context(), &types.AccountLockedPastTimeDenomRequest{Owner: args[0], Timestamp: timestamp, Denom: denom, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -404,6 +432,7 @@ $ %s query lockup account-locked-pasttime-denom <address> <timestamp> <denom>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "locks")
 
 	return cmd
 }
@@ -450,6 +479,170 @@ $ %s query lockup lock-by-id <id>
 	return cmd
 }
 
+// GetCmdSyntheticLockupsByLockupID returns the synthetic lockups of a lock
+func GetCmdSyntheticLockupsByLockupID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "synthetic-lockups-by-lock-id <id>",
+		Short: "Query the synthetic lockups of a lock by id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the synthetic lockups of a lock by id.
+
+Example:
+$ %s query lockup synthetic-lockups-by-lock-id <id>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SyntheticLockupsByLockupID(cmd.Context(), &types.SyntheticLockupsByLockupIDRequest{LockId: id, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "synthetic lockups")
+
+	return cmd
+}
+
+// GetCmdLocksByDenom returns the locks of a denom with longer duration
+func GetCmdLocksByDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locks-by-denom <denom> <min-duration>",
+		Short: "Query the locks of a denom with duration longer than min duration",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the locks of a denom with duration longer than min duration.
+
+Example:
+$ %s query lockup locks-by-denom <denom> <min-duration>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LocksByDenom(cmd.Context(), &types.LocksByDenomRequest{Denom: args[0], MinDuration: duration, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "locks")
+
+	return cmd
+}
+
+// GetCmdAccountLocks returns the locks of an account
+func GetCmdAccountLocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-locks <address>",
+		Short: "Query the locks of an account, optionally by unlocking status",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the locks of an account, optionally by unlocking status.
+
+Example:
+$ %s query lockup account-locks <address> --status=unlocking
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			statusStr, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+
+			status, err := parseLockStatus(statusStr)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountLocks(cmd.Context(), &types.AccountLocksRequest{Owner: args[0], Status: status, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetLockStatus())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "locks")
+
+	return cmd
+}
+
+// parseLockStatus parses the lock status of the status flag
+func parseLockStatus(status string) (types.LockStatus, error) {
+	switch status {
+	case "all":
+		return types.LockStatusAll, nil
+	case "not-unlocking":
+		return types.LockStatusNotUnlocking, nil
+	case "unlocking":
+		return types.LockStatusUnlocking, nil
+	default:
+		return 0, fmt.Errorf("invalid lock status %s, expected all, not-unlocking or unlocking", status)
+	}
+}
+
 // GetCmdAccountLockedLongerDuration returns account locked records with longer duration
 func GetCmdAccountLockedLongerDuration() *cobra.Command {
 	cmd := &cobra.Command{
@@ -476,9 +669,14 @@ $ %s query lockup account-locked-longer-duration <address> <duration>
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedLongerDuration(cmd.Context(), &types.AccountLockedLongerDurationRequest{Owner: args[0], Duration: duration})
+			res, err := queryClient.AccountLockedLongerDuration(cmd.Context(), &types.AccountLockedLongerDurationRequest{Owner: args[0], Duration: duration, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -488,6 +686,7 @@ $ %s query lockup account-locked-longer-duration <address> <duration>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "locks")
 
 	return cmd
 }
@@ -518,9 +717,14 @@ $ %s query lockup account-locked-longer-duration-not-unlocking <address> <durati
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedLongerDurationNotUnlockingOnly(cmd.Context(), &types.AccountLockedLongerDurationNotUnlockingOnlyRequest{Owner: args[0], Duration: duration})
+			res, err := queryClient.AccountLockedLongerDurationNotUnlockingOnly(cmd.Context(), &types.AccountLockedLongerDurationNotUnlockingOnlyRequest{Owner: args[0], Duration: duration, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -530,6 +734,7 @@ $ %s query lockup account-locked-longer-duration-not-unlocking <address> <durati
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "locks")
 
 	return cmd
 }
@@ -562,9 +767,14 @@ $ %s query lockup account-locked-pasttime <address> <duration> <denom>
 
 			denom := args[2]
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedLongerDurationDenom(cmd.Context(), &types.AccountLockedLongerDurationDenomRequest{Owner: args[0], Duration: duration, Denom: denom})
+			res, err := queryClient.AccountLockedLongerDurationDenom(cmd.Context(), &types.AccountLockedLongerDurationDenomRequest{Owner: args[0], Duration: duration, Denom: denom, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -574,6 +784,7 @@ $ %s query lockup account-locked-pasttime <address> <duration> <denom>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "locks")
 
 	return cmd
 }
@@ -708,3 +919,87 @@ $ %s query lockup output-all-locks <max lock ID>
 
 	return cmd
 }
+
+// GetCmdBreakLocksDryRun returns the balance changes a break locks proposal would make
+func GetCmdBreakLocksDryRun() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "break-locks-dry-run <lock-ids>",
+		Short: "Query the balance changes a break locks proposal would make if it passed now",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balance changes a break locks proposal would make if it passed now.
+
+Example:
+$ %s query lockup break-locks-dry-run 1,2,3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			lockIDs, err := parseLockIDs(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BreakLocksDryRun(cmd.Context(), &types.BreakLocksDryRunRequest{LockIds: lockIDs})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRelockDryRun returns the balance changes a relock proposal would make
+func GetCmdRelockDryRun() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relock-dry-run <lock-id:new-coins>...",
+		Short: "Query the balance changes a relock proposal would make if it passed now",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balance changes a relock proposal would make if it passed now.
+
+Example:
+$ %s query lockup relock-dry-run 1:10gamm/pool/2 2:5gamm/pool/2,3uosmo
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			relocks, err := parseRelocks(args)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RelockDryRun(cmd.Context(), &types.RelockDryRunRequest{Relocks: relocks})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -11,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/osmosis-labs/osmosis/x/lockup/types"
 )
 
@@ -28,6 +31,9 @@ func GetTxCmd() *cobra.Command {
 		NewLockTokensCmd(),
 		NewBeginUnlockingCmd(),
 		NewBeginUnlockByIDCmd(),
+		NewExtendLockupCmd(),
+		NewTransferLockCmd(),
+		NewForceUnlockCmd(),
 	)
 
 	return cmd
@@ -122,15 +128,304 @@ func NewBeginUnlockByIDCmd() *cobra.Command {
 				return err
 			}
 
+			coins := sdk.Coins(nil)
+			amountStr, err := cmd.Flags().GetString(FlagAmount)
+			if err != nil {
+				return err
+			}
+			if amountStr != "" {
+				coins, err = sdk.ParseCoinsNormalized(amountStr)
+				if err != nil {
+					return err
+				}
+			}
+
 			msg := types.NewMsgBeginUnlocking(
 				clientCtx.GetFromAddress(),
 				uint64(id),
+				coins,
 			)
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().AddFlagSet(FlagSetUnlockTokens())
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewExtendLockupCmd extends the duration of individual period lock by ID
+func NewExtendLockupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "extend-lockup [id]",
+		Short: "extend the duration of individual period lock by ID, which has not started unlocking",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			durationStr, err := cmd.Flags().GetString(FlagDuration)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(durationStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExtendLockup(
+				clientCtx.GetFromAddress(),
+				uint64(id),
+				duration,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetLockTokens())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagDuration)
+	return cmd
+}
+
+// NewTransferLockCmd transfers individual period lock by ID to a new owner
+func NewTransferLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-lock [id] [new-owner]",
+		Short: "transfer individual period lock by ID to a new owner",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferLock(
+				clientCtx.GetFromAddress(),
+				uint64(id),
+				newOwner,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewForceUnlockCmd force unlocks individual period lock by ID for a penalty
+func NewForceUnlockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-unlock [id]",
+		Short: "force unlock individual period lock by ID, paying the force unlock penalty",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgForceUnlock(
+				clientCtx.GetFromAddress(),
+				uint64(id),
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdSubmitBreakLocksProposal submits a proposal to break locks, sending their coins back to their owners
+func NewCmdSubmitBreakLocksProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "break-locks-proposal [lock-ids]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to break locks right away, sending their coins back to their owners",
+		Example: "break-locks-proposal 1,2,3 --title=\"title\" --description=\"description\" --deposit=\"10000000uosmo\"",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			lockIDs, err := parseLockIDs(args[0])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewBreakLocksProposal(title, description, lockIDs)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
+
+// NewCmdSubmitRelockProposal submits a proposal to replace the coins of locks
+func NewCmdSubmitRelockProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "relock-proposal [lock-id:new-coins]...",
+		Args:    cobra.MinimumNArgs(1),
+		Short:   "Submit a proposal to replace the coins of locks, sending the replaced coins back to their owners",
+		Example: "relock-proposal 1:10gamm/pool/2 2:5gamm/pool/2,3uosmo --title=\"title\" --description=\"description\" --deposit=\"10000000uosmo\"",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			relocks, err := parseRelocks(args)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRelockProposal(title, description, relocks)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
+
+// parseLockIDs parses a comma separated list of lock ids
+func parseLockIDs(arg string) ([]uint64, error) {
+	var lockIDs []uint64
+	for _, idStr := range strings.Split(arg, ",") {
+		id, err := strconv.ParseUint(strings.TrimSpace(idStr), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		lockIDs = append(lockIDs, id)
+	}
+	return lockIDs, nil
+}
+
+// parseRelocks parses relocks given as lock-id:new-coins
+func parseRelocks(args []string) ([]types.Relock, error) {
+	var relocks []types.Relock
+	for _, arg := range args {
+		parts := strings.SplitN(arg, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid relock %s, expected lock-id:new-coins", arg)
+		}
+		id, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		coins, err := sdk.ParseCoinsNormalized(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		relocks = append(relocks, types.Relock{LockId: id, NewCoins: coins})
+	}
+	return relocks, nil
+}
@@ -0,0 +1,12 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/osmosis-labs/osmosis/x/lockup/client/cli"
+	"github.com/osmosis-labs/osmosis/x/lockup/client/rest"
+)
+
+var (
+	BreakLocksProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitBreakLocksProposal, rest.ProposalBreakLocksRESTHandler)
+	RelockProposalHandler     = govclient.NewProposalHandler(cli.NewCmdSubmitRelockProposal, rest.ProposalRelockRESTHandler)
+)
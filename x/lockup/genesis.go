@@ -9,10 +9,14 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
 	k.SetLastLockID(ctx, genState.LastLockId)
 	if err := k.ResetAllLocks(ctx, genState.Locks); err != nil {
 		return
 	}
+	if err := k.ResetAllSyntheticLocks(ctx, genState.SyntheticLocks); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -22,7 +26,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 	return &types.GenesisState{
-		LastLockId: k.GetLastLockID(ctx),
-		Locks:      locks,
+		LastLockId:     k.GetLastLockID(ctx),
+		Locks:          locks,
+		SyntheticLocks: k.GetAllSyntheticLockups(ctx),
+		Params:         k.GetParams(ctx),
 	}
 }
@@ -17,6 +17,7 @@ var now = time.Now().UTC()
 var acc1 = sdk.AccAddress([]byte("addr1---------------"))
 var acc2 = sdk.AccAddress([]byte("addr2---------------"))
 var testGenesis = types.GenesisState{
+	Params:     types.DefaultParams(),
 	LastLockId: 10,
 	Locks: []types.PeriodLock{
 		{
@@ -136,3 +137,31 @@ func TestMarshalUnmarshalGenesis(t *testing.T) {
 		am.InitGenesis(ctx, appCodec, genesisExported)
 	})
 }
+
+func TestSyntheticLocksGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	ctx = ctx.WithBlockTime(now.Add(time.Second))
+	genesis := testGenesis
+	genesis.SyntheticLocks = []types.SyntheticLock{
+		{
+			UnderlyingLockId: 1,
+			Suffix:           "superbonding",
+			Duration:         time.Hour * 2,
+		},
+	}
+	require.NoError(t, genesis.Validate())
+	lockup.InitGenesis(ctx, app.LockupKeeper, genesis)
+
+	require.Equal(t, sdk.NewInt(10000000), app.LockupKeeper.GetLockedDenom(ctx, "foo/superbonding", time.Hour*2))
+	locks := app.LockupKeeper.GetLocksLongerThanDurationDenom(ctx, "foo/superbonding", time.Hour)
+	require.Len(t, locks, 1)
+	require.Equal(t, uint64(1), locks[0].ID)
+
+	genesisExported := lockup.ExportGenesis(ctx, app.LockupKeeper)
+	require.Equal(t, genesis.SyntheticLocks, genesisExported.SyntheticLocks)
+
+	// synthetic lockups must have their lock in genesis
+	genesis.SyntheticLocks[0].UnderlyingLockId = 4
+	require.Error(t, genesis.Validate())
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/osmosis-labs/osmosis/x/lockup/keeper"
 	"github.com/osmosis-labs/osmosis/x/lockup/types"
 )
@@ -25,9 +26,32 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgBeginUnlockingAll:
 			res, err := msgServer.BeginUnlockingAll(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgExtendLockup:
+			res, err := msgServer.ExtendLockup(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTransferLock:
+			res, err := msgServer.TransferLock(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgForceUnlock:
+			res, err := msgServer.ForceUnlock(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
 		}
 	}
 }
+
+func NewLockupProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.BreakLocksProposal:
+			return k.HandleBreakLocksProposal(ctx, c)
+		case *types.RelockProposal:
+			return k.HandleRelockProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized lockup proposal content type: %T", c)
+		}
+	}
+}
@@ -2,16 +2,20 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/gogo/protobuf/proto"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/osmosis-labs/osmosis/x/lockup/types"
 )
 
-// Relock unlock previous lockID and create a new lock with newCoins with same duration and endtime
+// Relock unlock previous lockID and create a new lock with newCoins with same duration and endtime.
+// The locks with synthetic lockups can't be relocked, as the synthetic lockups are claims on their coins.
 func (ak AdminKeeper) Relock(ctx sdk.Context, lockID uint64, newCoins sdk.Coins) error {
 	lock, err := ak.GetLockByID(ctx, lockID)
 	if err != nil {
 		return err
 	}
+	if synthLocks := ak.GetAllSyntheticLockupsByLockup(ctx, lock.ID); len(synthLocks) > 0 {
+		return sdkerrors.Wrapf(types.ErrLockHasSyntheticLockups, "lock %d", lock.ID)
+	}
 
 	owner, err := sdk.AccAddressFromBech32(lock.Owner)
 	if err != nil {
@@ -28,25 +32,47 @@ func (ak AdminKeeper) Relock(ctx sdk.Context, lockID uint64, newCoins sdk.Coins)
 		return err
 	}
 
+	// the lock refs are indexed by the coins of the lock
+	err = ak.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), *lock)
+	if err != nil {
+		return err
+	}
+
+	// remove original coins from accumulation store
+	for _, coin := range lock.Coins {
+		ak.accumulationStore(ctx, coin.Denom).Decrease(accumulationKey(lock.Duration), coin.Amount)
+	}
+
 	// replace to new coins
+	oldCoins := lock.Coins
 	lock.Coins = newCoins
 
-	// reset lock record inside store
-	store := ctx.KVStore(ak.storeKey)
-	bz, err := proto.Marshal(lock)
+	// reset lock record and refs inside store
+	err = ak.setLockAndResetLockRefs(ctx, *lock)
 	if err != nil {
 		return err
 	}
-	store.Set(lockStoreKey(lockID), bz)
+
+	// add new coins to accumulation store
+	for _, coin := range lock.Coins {
+		ak.accumulationStore(ctx, coin.Denom).Increase(accumulationKey(lock.Duration), coin.Amount)
+	}
+
+	ak.hooks.OnTokenUnlocked(ctx, owner, lock.ID, oldCoins, lock.Duration, lock.EndTime)
+	ak.hooks.OnTokenLocked(ctx, owner, lock.ID, lock.Coins, lock.Duration, lock.EndTime)
 	return nil
 }
 
-// BreakLock unlock a lockID without considering time with admin priviledge
+// BreakLock unlock a lockID without considering time with admin priviledge.
+// The locks with synthetic lockups can't be broken, as the synthetic lockups are claims on their coins.
 func (ak AdminKeeper) BreakLock(ctx sdk.Context, lockID uint64) error {
 	lock, err := ak.GetLockByID(ctx, lockID)
 	if err != nil {
 		return err
 	}
+	if synthLocks := ak.GetAllSyntheticLockupsByLockup(ctx, lock.ID); len(synthLocks) > 0 {
+		return sdkerrors.Wrapf(types.ErrLockHasSyntheticLockups, "lock %d", lock.ID)
+	}
 
 	owner, err := sdk.AccAddressFromBech32(lock.Owner)
 	if err != nil {
@@ -61,16 +87,17 @@ func (ak AdminKeeper) BreakLock(ctx sdk.Context, lockID uint64) error {
 	store := ctx.KVStore(ak.storeKey)
 	store.Delete(lockStoreKey(lockID)) // remove lock from store
 
-	refKeys, err := lockRefKeys(*lock)
+	// delete lock refs from the queue the lock is in
+	err = ak.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), *lock)
 	if err != nil {
 		return err
 	}
 
-	for _, refKey := range refKeys {
-		err = ak.deleteLockRefByKey(ctx, refKey, lockID)
-		if err != nil {
-			return err
-		}
+	// remove from accumulation store
+	for _, coin := range lock.Coins {
+		ak.accumulationStore(ctx, coin.Denom).Decrease(accumulationKey(lock.Duration), coin.Amount)
 	}
+
+	ak.hooks.OnTokenUnlocked(ctx, owner, lock.ID, lock.Coins, lock.Duration, lock.EndTime)
 	return nil
 }
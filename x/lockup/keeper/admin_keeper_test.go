@@ -13,7 +13,7 @@ func (suite *KeeperTestSuite) TestRelock() {
 
 	addr1 := sdk.AccAddress([]byte("addr1---------------"))
 	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
-	lock := types.NewPeriodLock(1, addr1, time.Second, suite.ctx.BlockTime().Add(time.Second), coins)
+	lock := types.NewPeriodLock(1, addr1, time.Second, time.Time{}, coins)
 
 	// lock with balance
 	err := suite.app.BankKeeper.SetBalances(suite.ctx, addr1, coins)
@@ -32,14 +32,21 @@ func (suite *KeeperTestSuite) TestRelock() {
 	suite.Require().NoError(err)
 
 	suite.Require().Equal(storedLock.Coins, coins2)
+
+	// the lock refs and the accumulation store follow the new coins
+	suite.Require().Len(suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, "stake", time.Second), 0)
+	suite.Require().Len(suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, "stake2", time.Second), 1)
+	suite.Require().Equal(sdk.ZeroInt(), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake", time.Second))
+	suite.Require().Equal(sdk.NewInt(10), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake2", time.Second))
+	suite.Require().Equal(coins, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
 }
 
-func (suite *KeeperTestSuite) BreakLock() {
+func (suite *KeeperTestSuite) TestBreakLock() {
 	suite.SetupTest()
 
 	addr1 := sdk.AccAddress([]byte("addr1---------------"))
 	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
-	lock := types.NewPeriodLock(1, addr1, time.Second, suite.ctx.BlockTime().Add(time.Second), coins)
+	lock := types.NewPeriodLock(1, addr1, time.Second, time.Time{}, coins)
 
 	// lock with balance
 	err := suite.app.BankKeeper.SetBalances(suite.ctx, addr1, coins)
@@ -54,4 +61,65 @@ func (suite *KeeperTestSuite) BreakLock() {
 
 	_, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, lock.ID)
 	suite.Require().Error(err)
+	suite.Require().Len(suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, "stake", time.Second), 0)
+	suite.Require().Equal(sdk.ZeroInt(), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake", time.Second))
+	suite.Require().Equal(coins, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
+}
+
+func (suite *KeeperTestSuite) TestBreakUnlockingLock() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+	_, err := suite.app.LockupKeeper.BeginUnlockPeriodLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+
+	// the refs of the unlocking queue are deleted with the lock
+	err = keeper.AdminKeeper{suite.app.LockupKeeper}.BreakLock(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountUnlockedBeforeTime(suite.ctx, addr1, suite.ctx.BlockTime().Add(time.Second)), 0)
+	suite.Require().Len(suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, "stake", 0), 0)
+	suite.Require().Equal(coins, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
+}
+
+func (suite *KeeperTestSuite) TestRelockWithSyntheticLockups() {
+	suite.SetupTest()
+	adminKeeper := keeper.AdminKeeper{suite.app.LockupKeeper}
+	synthDenom := types.SyntheticDenom("stake", "superbonding")
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+	suite.LockTokens(addr1, coins, time.Second)
+	_, err := suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 1, "superbonding", time.Second)
+	suite.Require().NoError(err)
+	_, err = suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 2, "superbonding", time.Second)
+	suite.Require().NoError(err)
+
+	// the locks with synthetic lockups can't be relocked nor broken, and are left as they were
+	coins2 := sdk.Coins{sdk.NewInt64Coin("stake2", 10)}
+	err = suite.app.BankKeeper.SetBalances(suite.ctx, addr1, coins2)
+	suite.Require().NoError(err)
+	err = adminKeeper.Relock(suite.ctx, 1, coins2)
+	suite.Require().ErrorIs(err, types.ErrLockHasSyntheticLockups)
+	err = adminKeeper.BreakLock(suite.ctx, 1)
+	suite.Require().ErrorIs(err, types.ErrLockHasSyntheticLockups)
+	suite.Require().Equal(sdk.NewInt(20), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake", time.Second))
+	suite.Require().Equal(sdk.NewInt(20), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, synthDenom, time.Second))
+	suite.Require().Len(suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, synthDenom, time.Second), 2)
+
+	// once its synthetic lockup is gone, the lock is relocked without touching the synthetic lockup of the other
+	_, err = suite.app.LockupKeeper.BeginUnlockSyntheticLockup(suite.ctx, 1, "superbonding")
+	suite.Require().NoError(err)
+	suite.app.LockupKeeper.DeleteAllMaturedSyntheticLocks(suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second)))
+	err = adminKeeper.Relock(suite.ctx, 1, coins2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(10), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake", time.Second))
+	suite.Require().Equal(sdk.NewInt(10), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake2", time.Second))
+	suite.Require().Equal(sdk.NewInt(10), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, synthDenom, time.Second))
+	suite.Require().True(suite.app.LockupKeeper.GetLockedDenom(suite.ctx, types.SyntheticDenom("stake2", "superbonding"), 0).IsZero())
+	synthLocks := suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, synthDenom, time.Second)
+	suite.Require().Len(synthLocks, 1)
+	suite.Require().Equal(uint64(2), synthLocks[0].ID)
 }
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/osmosis-labs/osmosis/x/gamm/utils"
+	"github.com/osmosis-labs/osmosis/x/lockup/types"
+)
+
+// HandleBreakLocksProposal breaks the locks of the proposal, sending their coins back to their owners.
+func (k Keeper) HandleBreakLocksProposal(ctx sdk.Context, p *types.BreakLocksProposal) error {
+	_, err := k.breakLocks(ctx, p.LockIds)
+	return err
+}
+
+// HandleRelockProposal replaces the coins of the locks of the proposal.
+func (k Keeper) HandleRelockProposal(ctx sdk.Context, p *types.RelockProposal) error {
+	_, err := k.relock(ctx, p.Relocks)
+	return err
+}
+
+// breakLocksDryRun returns the balance changes a BreakLocksProposal of lockIDs would make,
+// without applying them.
+func (k Keeper) breakLocksDryRun(ctx sdk.Context, lockIDs []uint64) ([]types.LockBalanceChange, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	return k.breakLocks(cacheCtx, lockIDs)
+}
+
+// relockDryRun returns the balance changes a RelockProposal of relocks would make,
+// without applying them.
+func (k Keeper) relockDryRun(ctx sdk.Context, relocks []types.Relock) ([]types.LockBalanceChange, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	return k.relock(cacheCtx, relocks)
+}
+
+func (k Keeper) breakLocks(ctx sdk.Context, lockIDs []uint64) ([]types.LockBalanceChange, error) {
+	changes := []types.LockBalanceChange{}
+	for _, lockID := range lockIDs {
+		lock, err := k.GetLockByID(ctx, lockID)
+		if err != nil {
+			return nil, err
+		}
+		err = AdminKeeper{k}.BreakLock(ctx, lockID)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, types.LockBalanceChange{
+			LockId:         lock.ID,
+			Owner:          lock.Owner,
+			SentToOwner:    lock.Coins,
+			TakenFromOwner: sdk.Coins{},
+		})
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtBreakLock,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(lock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, lock.Coins.String()),
+		))
+	}
+	return changes, nil
+}
+
+func (k Keeper) relock(ctx sdk.Context, relocks []types.Relock) ([]types.LockBalanceChange, error) {
+	changes := []types.LockBalanceChange{}
+	for _, relock := range relocks {
+		lock, err := k.GetLockByID(ctx, relock.LockId)
+		if err != nil {
+			return nil, err
+		}
+		err = AdminKeeper{k}.Relock(ctx, relock.LockId, relock.NewCoins)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, types.LockBalanceChange{
+			LockId:         lock.ID,
+			Owner:          lock.Owner,
+			SentToOwner:    lock.Coins,
+			TakenFromOwner: relock.NewCoins,
+		})
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtRelock,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(lock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, lock.Coins.String()),
+			sdk.NewAttribute(types.AttributePeriodLockNewAmount, relock.NewCoins.String()),
+		))
+	}
+	return changes, nil
+}
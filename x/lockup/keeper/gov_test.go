@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/osmosis-labs/osmosis/x/lockup/types"
+)
+
+func (suite *KeeperTestSuite) TestBreakLocksProposal() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins1 := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	coins2 := sdk.Coins{sdk.NewInt64Coin("stake", 5)}
+	suite.LockTokens(addr1, coins1, time.Second)
+	suite.LockTokens(addr2, coins2, time.Second)
+
+	expectedChanges := []types.LockBalanceChange{
+		{LockId: 1, Owner: addr1.String(), SentToOwner: coins1, TakenFromOwner: sdk.Coins{}},
+		{LockId: 2, Owner: addr2.String(), SentToOwner: coins2, TakenFromOwner: sdk.Coins{}},
+	}
+
+	// the dry run shows the balance changes without applying them
+	_, err := suite.app.LockupKeeper.BreakLocksDryRun(sdk.WrapSDKContext(suite.ctx), &types.BreakLocksDryRunRequest{LockIds: []uint64{1, 1}})
+	suite.Require().ErrorIs(err, types.ErrDuplicateProposalLock)
+	_, err = suite.app.LockupKeeper.BreakLocksDryRun(sdk.WrapSDKContext(suite.ctx), &types.BreakLocksDryRunRequest{LockIds: []uint64{1, 3}})
+	suite.Require().Error(err)
+	res, err := suite.app.LockupKeeper.BreakLocksDryRun(sdk.WrapSDKContext(suite.ctx), &types.BreakLocksDryRunRequest{LockIds: []uint64{1, 2}})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedChanges, res.BalanceChanges)
+	suite.Require().Len(suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, "stake", time.Second), 2)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1).Empty())
+
+	// the proposal applies them
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	err = suite.app.LockupKeeper.HandleBreakLocksProposal(ctx, &types.BreakLocksProposal{
+		Title:       "title",
+		Description: "description",
+		LockIds:     []uint64{1, 2},
+	})
+	suite.Require().NoError(err)
+	breakLockEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.TypeEvtBreakLock {
+			breakLockEvents++
+		}
+	}
+	suite.Require().Equal(2, breakLockEvents)
+	suite.Require().Len(suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, "stake", time.Second), 0)
+	suite.Require().Equal(sdk.ZeroInt(), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake", time.Second))
+	suite.Require().Equal(coins1, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
+	suite.Require().Equal(coins2, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr2))
+}
+
+func (suite *KeeperTestSuite) TestRelockProposal() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	newCoins := sdk.Coins{sdk.NewInt64Coin("stake2", 20)}
+	suite.LockTokens(addr1, coins, time.Second)
+	err := suite.app.BankKeeper.SetBalances(suite.ctx, addr1, newCoins)
+	suite.Require().NoError(err)
+
+	relocks := []types.Relock{{LockId: 1, NewCoins: newCoins}}
+	expectedChanges := []types.LockBalanceChange{
+		{LockId: 1, Owner: addr1.String(), SentToOwner: coins, TakenFromOwner: newCoins},
+	}
+
+	// the dry run shows the balance changes without applying them
+	_, err = suite.app.LockupKeeper.RelockDryRun(sdk.WrapSDKContext(suite.ctx), &types.RelockDryRunRequest{})
+	suite.Require().ErrorIs(err, types.ErrEmptyProposalLocks)
+	_, err = suite.app.LockupKeeper.RelockDryRun(sdk.WrapSDKContext(suite.ctx), &types.RelockDryRunRequest{
+		Relocks: []types.Relock{{LockId: 1, NewCoins: newCoins.Add(newCoins...)}},
+	})
+	suite.Require().Error(err)
+	res, err := suite.app.LockupKeeper.RelockDryRun(sdk.WrapSDKContext(suite.ctx), &types.RelockDryRunRequest{Relocks: relocks})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedChanges, res.BalanceChanges)
+	suite.Require().Equal(sdk.NewInt(10), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake", time.Second))
+	suite.Require().Equal(newCoins, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
+
+	// the proposal applies them
+	err = suite.app.LockupKeeper.HandleRelockProposal(suite.ctx, &types.RelockProposal{
+		Title:       "title",
+		Description: "description",
+		Relocks:     relocks,
+	})
+	suite.Require().NoError(err)
+	lock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(newCoins, lock.Coins)
+	suite.Require().Equal(sdk.ZeroInt(), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake", time.Second))
+	suite.Require().Equal(sdk.NewInt(20), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake2", time.Second))
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountLockedPastTimeDenom(suite.ctx, addr1, "stake2", suite.ctx.BlockTime()), 1)
+	suite.Require().Equal(coins, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
+}
@@ -3,8 +3,13 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gogo/protobuf/proto"
 	"github.com/osmosis-labs/osmosis/x/lockup/types"
+	db "github.com/tendermint/tm-db"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -66,7 +71,11 @@ func (k Keeper) AccountLockedPastTime(goCtx context.Context, req *types.AccountL
 	} else if err != nil {
 		return nil, err
 	}
-	return &types.AccountLockedPastTimeResponse{Locks: k.GetAccountLockedPastTime(ctx, owner, req.Timestamp)}, nil
+	locks, pageRes, err := k.getLocksFromIteratorsPaginated(ctx, k.accountLockedPastTimeIterators(ctx, owner, req.Timestamp), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountLockedPastTimeResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountUnlockedBeforeTime Returns the total unlocks of an account whose unlock time is before timestamp
@@ -78,8 +87,11 @@ func (k Keeper) AccountUnlockedBeforeTime(goCtx context.Context, req *types.Acco
 	} else if err != nil {
 		return nil, err
 	}
-
-	return &types.AccountUnlockedBeforeTimeResponse{Locks: k.GetAccountUnlockedBeforeTime(ctx, owner, req.Timestamp)}, nil
+	locks, pageRes, err := k.getLocksFromIteratorsPaginated(ctx, k.accountUnlockedBeforeTimeIterators(ctx, owner, req.Timestamp), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountUnlockedBeforeTimeResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedPastTimeDenom is equal to GetAccountLockedPastTime but denom specific
@@ -91,7 +103,11 @@ func (k Keeper) AccountLockedPastTimeDenom(goCtx context.Context, req *types.Acc
 	} else if err != nil {
 		return nil, err
 	}
-	return &types.AccountLockedPastTimeDenomResponse{Locks: k.GetAccountLockedPastTimeDenom(ctx, owner, req.Denom, req.Timestamp)}, nil
+	locks, pageRes, err := k.getLocksFromIteratorsPaginated(ctx, k.accountLockedPastTimeDenomIterators(ctx, owner, req.Denom, req.Timestamp), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountLockedPastTimeDenomResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // LockedByID Returns lock by lock ID
@@ -110,8 +126,11 @@ func (k Keeper) AccountLockedLongerDuration(goCtx context.Context, req *types.Ac
 	} else if err != nil {
 		return nil, err
 	}
-	locks := k.GetAccountLockedLongerDuration(ctx, owner, req.Duration)
-	return &types.AccountLockedLongerDurationResponse{Locks: locks}, nil
+	locks, pageRes, err := k.getLocksFromIteratorsPaginated(ctx, k.accountLockedLongerDurationIterators(ctx, owner, req.Duration), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountLockedLongerDurationResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedLongerDurationDenom Returns account locked with duration longer than specified with specific denom
@@ -123,8 +142,11 @@ func (k Keeper) AccountLockedLongerDurationDenom(goCtx context.Context, req *typ
 	} else if err != nil {
 		return nil, err
 	}
-	locks := k.GetAccountLockedLongerDurationDenom(ctx, owner, req.Denom, req.Duration)
-	return &types.AccountLockedLongerDurationDenomResponse{Locks: locks}, nil
+	locks, pageRes, err := k.getLocksFromIteratorsPaginated(ctx, k.accountLockedLongerDurationDenomIterators(ctx, owner, req.Denom, req.Duration), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountLockedLongerDurationDenomResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedPastTimeNotUnlockingOnly Returns locked records of an account with unlock time beyond timestamp excluding tokens started unlocking
@@ -136,7 +158,11 @@ func (k Keeper) AccountLockedPastTimeNotUnlockingOnly(goCtx context.Context, req
 	} else if err != nil {
 		return nil, err
 	}
-	return &types.AccountLockedPastTimeNotUnlockingOnlyResponse{Locks: k.GetAccountLockedPastTimeNotUnlockingOnly(ctx, owner, req.Timestamp)}, nil
+	locks, pageRes, err := k.getLocksFromIteratorsPaginated(ctx, k.accountLockedPastTimeNotUnlockingOnlyIterators(ctx, owner, req.Timestamp), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountLockedPastTimeNotUnlockingOnlyResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedLongerDurationNotUnlockingOnly Returns account locked records with longer duration excluding tokens started unlocking
@@ -148,10 +174,90 @@ func (k Keeper) AccountLockedLongerDurationNotUnlockingOnly(goCtx context.Contex
 	} else if err != nil {
 		return nil, err
 	}
-	return &types.AccountLockedLongerDurationNotUnlockingOnlyResponse{Locks: k.GetAccountLockedLongerDurationNotUnlockingOnly(ctx, owner, req.Duration)}, nil
+	locks, pageRes, err := k.getLocksFromIteratorsPaginated(ctx, []db.Iterator{k.AccountLockIteratorLongerDuration(ctx, false, owner, req.Duration)}, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountLockedLongerDurationNotUnlockingOnlyResponse{Locks: locks, Pagination: pageRes}, nil
+}
+
+// SyntheticLockupsByLockupID returns the synthetic lockups of a lock
+func (k Keeper) SyntheticLockupsByLockupID(goCtx context.Context, req *types.SyntheticLockupsByLockupIDRequest) (*types.SyntheticLockupsByLockupIDResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	synthLocks := []types.SyntheticLock{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), combineKeys(types.KeyPrefixSyntheticLockup, sdk.Uint64ToBigEndian(req.LockId), []byte{}))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		synthLock := types.SyntheticLock{}
+		if err := proto.Unmarshal(value, &synthLock); err != nil {
+			return err
+		}
+		synthLocks = append(synthLocks, synthLock)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SyntheticLockupsByLockupIDResponse{SyntheticLocks: synthLocks, Pagination: pageRes}, nil
+}
+
+// LocksByDenom returns the locks of a denom with duration longer than min duration
+func (k Keeper) LocksByDenom(goCtx context.Context, req *types.LocksByDenomRequest) (*types.LocksByDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	locks, pageRes, err := k.getLocksFromIteratorsPaginated(ctx, k.locksLongerThanDurationDenomIterators(ctx, req.Denom, req.MinDuration), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocksByDenomResponse{Locks: locks, Pagination: pageRes}, nil
+}
+
+// AccountLocks returns the locks of an account in the given status
+func (k Keeper) AccountLocks(goCtx context.Context, req *types.AccountLocksRequest) (*types.AccountLocksResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if req.Owner == "" {
+		owner = sdk.AccAddress{}
+	} else if err != nil {
+		return nil, err
+	}
+	if _, ok := types.LockStatus_name[int32(req.Status)]; !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown lock status %d", req.Status)
+	}
+	locks, pageRes, err := k.getLocksFromIteratorsPaginated(ctx, k.accountLocksIterators(ctx, owner, req.Status), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountLocksResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 func (k Keeper) LockedDenom(goCtx context.Context, req *types.LockedDenomRequest) (*types.LockedDenomResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	return &types.LockedDenomResponse{Amount: k.GetLockedDenom(ctx, req.Denom, req.Duration)}, nil
 }
+
+// BreakLocksDryRun returns the balance changes a BreakLocksProposal of the lock ids would make
+func (k Keeper) BreakLocksDryRun(goCtx context.Context, req *types.BreakLocksDryRunRequest) (*types.BreakLocksDryRunResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := types.ValidateBreakLocks(req.LockIds); err != nil {
+		return nil, err
+	}
+	changes, err := k.breakLocksDryRun(ctx, req.LockIds)
+	if err != nil {
+		return nil, err
+	}
+	return &types.BreakLocksDryRunResponse{BalanceChanges: changes}, nil
+}
+
+// RelockDryRun returns the balance changes a RelockProposal of the relocks would make
+func (k Keeper) RelockDryRun(goCtx context.Context, req *types.RelockDryRunRequest) (*types.RelockDryRunResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := types.ValidateRelocks(req.Relocks); err != nil {
+		return nil, err
+	}
+	changes, err := k.relockDryRun(ctx, req.Relocks)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RelockDryRunResponse{BalanceChanges: changes}, nil
+}
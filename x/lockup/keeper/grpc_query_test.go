@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/osmosis-labs/osmosis/x/lockup/types"
 )
 
@@ -515,3 +516,127 @@ func (suite *KeeperTestSuite) TestLockedDenom() {
 	testTotalLockedDuration("2h", 0)
 	testTotalLockedDuration("1h", 10)
 }
+
+func (suite *KeeperTestSuite) TestLocksByDenom() {
+	suite.SetupTest()
+
+	// lock coins
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, 2*time.Second)
+	suite.LockTokens(addr2, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, 3*time.Second)
+	suite.LockTokens(addr2, sdk.Coins{sdk.NewInt64Coin("stake2", 10)}, 3*time.Second)
+	_, err := suite.app.LockupKeeper.BeginUnlockPeriodLockByID(suite.ctx, 3)
+	suite.Require().NoError(err)
+
+	// the locks of the denom longer than min duration, unlocking or not
+	res, err := suite.app.LockupKeeper.LocksByDenom(sdk.WrapSDKContext(suite.ctx), &types.LocksByDenomRequest{Denom: "stake", MinDuration: 2 * time.Second})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Locks, 2)
+	suite.Require().Equal(uint64(2), res.Locks[0].ID)
+	suite.Require().Equal(uint64(3), res.Locks[1].ID)
+	suite.Require().Nil(res.Pagination.NextKey)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	// paginated by key, from the not unlocking locks to the unlocking ones
+	res, err = suite.app.LockupKeeper.LocksByDenom(sdk.WrapSDKContext(suite.ctx), &types.LocksByDenomRequest{Denom: "stake", Pagination: &query.PageRequest{Limit: 2}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Locks, 2)
+	suite.Require().Equal(uint64(1), res.Locks[0].ID)
+	suite.Require().Equal(uint64(2), res.Locks[1].ID)
+	suite.Require().NotNil(res.Pagination.NextKey)
+	res, err = suite.app.LockupKeeper.LocksByDenom(sdk.WrapSDKContext(suite.ctx), &types.LocksByDenomRequest{Denom: "stake", Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Locks, 1)
+	suite.Require().Equal(uint64(3), res.Locks[0].ID)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	// paginated by offset
+	res, err = suite.app.LockupKeeper.LocksByDenom(sdk.WrapSDKContext(suite.ctx), &types.LocksByDenomRequest{Denom: "stake", Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Locks, 1)
+	suite.Require().Equal(uint64(2), res.Locks[0].ID)
+	suite.Require().NotNil(res.Pagination.NextKey)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	// not both
+	_, err = suite.app.LockupKeeper.LocksByDenom(sdk.WrapSDKContext(suite.ctx), &types.LocksByDenomRequest{Denom: "stake", Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Offset: 1}})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestAccountLocks() {
+	suite.SetupTest()
+
+	// lock coins
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake2", 10)}, time.Second)
+	suite.LockTokens(addr2, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	_, err := suite.app.LockupKeeper.BeginUnlockPeriodLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+
+	accountLocks := func(owner sdk.AccAddress, status types.LockStatus, pageReq *query.PageRequest) *types.AccountLocksResponse {
+		res, err := suite.app.LockupKeeper.AccountLocks(sdk.WrapSDKContext(suite.ctx), &types.AccountLocksRequest{Owner: owner.String(), Status: status, Pagination: pageReq})
+		suite.Require().NoError(err)
+		return res
+	}
+
+	// by status
+	res := accountLocks(addr1, types.LockStatusAll, nil)
+	suite.Require().Len(res.Locks, 2)
+	suite.Require().Equal(uint64(2), res.Locks[0].ID)
+	suite.Require().Equal(uint64(1), res.Locks[1].ID)
+	res = accountLocks(addr1, types.LockStatusNotUnlocking, nil)
+	suite.Require().Len(res.Locks, 1)
+	suite.Require().Equal(uint64(2), res.Locks[0].ID)
+	res = accountLocks(addr1, types.LockStatusUnlocking, nil)
+	suite.Require().Len(res.Locks, 1)
+	suite.Require().Equal(uint64(1), res.Locks[0].ID)
+	res = accountLocks(addr2, types.LockStatusUnlocking, nil)
+	suite.Require().Len(res.Locks, 0)
+
+	// page by page
+	res = accountLocks(addr1, types.LockStatusAll, &query.PageRequest{Limit: 1})
+	suite.Require().Len(res.Locks, 1)
+	suite.Require().Equal(uint64(2), res.Locks[0].ID)
+	res = accountLocks(addr1, types.LockStatusAll, &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1})
+	suite.Require().Len(res.Locks, 1)
+	suite.Require().Equal(uint64(1), res.Locks[0].ID)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	// unknown status
+	_, err = suite.app.LockupKeeper.AccountLocks(sdk.WrapSDKContext(suite.ctx), &types.AccountLocksRequest{Owner: addr1.String(), Status: 3})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestAccountLockedLongerDurationPagination() {
+	suite.SetupTest()
+
+	// lock coins
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	for i := 0; i < 5; i++ {
+		suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Duration(i+1)*time.Second)
+	}
+
+	// the pages go through all the locks once
+	ids := []uint64{}
+	var nextKey []byte
+	for {
+		res, err := suite.app.LockupKeeper.AccountLockedLongerDuration(sdk.WrapSDKContext(suite.ctx), &types.AccountLockedLongerDurationRequest{
+			Owner:      addr1.String(),
+			Duration:   2 * time.Second,
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 2},
+		})
+		suite.Require().NoError(err)
+		for _, lock := range res.Locks {
+			ids = append(ids, lock.ID)
+		}
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	suite.Require().Equal([]uint64{2, 3, 4, 5}, ids)
+}
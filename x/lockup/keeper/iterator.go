@@ -30,7 +30,8 @@ func (k Keeper) iteratorBeforeTime(ctx sdk.Context, prefix []byte, time time.Tim
 	key := combineKeys(prefix, timeKey)
 	// If it’s unlockTime, then it should count as unlocked
 	// inclusive end bytes = key + 1, next iterator
-	return store.Iterator(prefix, storetypes.PrefixEndBytes(key))
+	// the separator is kept on the start key so that a denom prefix doesn't match the longer denoms it prefixes
+	return store.Iterator(combineKeys(prefix, []byte{}), storetypes.PrefixEndBytes(key))
 }
 
 func (k Keeper) iteratorDuration(ctx sdk.Context, prefix []byte, duration time.Duration) sdk.Iterator {
@@ -54,7 +55,7 @@ func (k Keeper) iteratorShorterDuration(ctx sdk.Context, prefix []byte, duration
 	durationKey := getDurationKey(duration)
 	key := combineKeys(prefix, durationKey)
 	// inclusive on longer side, shorter means < (lower or equal)
-	return store.Iterator(prefix, key)
+	return store.Iterator(combineKeys(prefix, []byte{}), key)
 }
 
 func (k Keeper) iterator(ctx sdk.Context, prefix []byte) sdk.Iterator {
@@ -101,7 +102,7 @@ func (k Keeper) LockIteratorLongerThanDurationDenom(ctx sdk.Context, isUnlocking
 // LockIteratorDenom returns the iterator used for getting all locks by denom
 func (k Keeper) LockIteratorDenom(ctx sdk.Context, isUnlocking bool, denom string) sdk.Iterator {
 	unlockingPrefix := unlockingPrefix(isUnlocking)
-	return k.iterator(ctx, combineKeys(unlockingPrefix, types.KeyPrefixDenomLockTimestamp, []byte(denom)))
+	return k.iterator(ctx, combineKeys(unlockingPrefix, types.KeyPrefixDenomLockTimestamp, []byte(denom), []byte{}))
 }
 
 // AccountLockIteratorAfterTime returns the iterator to get locked coins by account
@@ -137,7 +138,7 @@ func (k Keeper) AccountLockIteratorBeforeTimeDenom(ctx sdk.Context, isUnlocking
 // AccountLockIteratorDenom returns the iterator used for getting all locks by account and denom
 func (k Keeper) AccountLockIteratorDenom(ctx sdk.Context, isUnlocking bool, addr sdk.AccAddress, denom string) sdk.Iterator {
 	unlockingPrefix := unlockingPrefix(isUnlocking)
-	return k.iterator(ctx, combineKeys(unlockingPrefix, types.KeyPrefixAccountDenomLockTimestamp, addr, []byte(denom)))
+	return k.iterator(ctx, combineKeys(unlockingPrefix, types.KeyPrefixAccountDenomLockTimestamp, addr, []byte(denom), []byte{}))
 }
 
 // AccountLockIteratorLongerDuration returns iterator used for getting all locks by account longer than duration
@@ -8,27 +8,36 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/osmosis-labs/osmosis/x/lockup/types"
 )
 
 // Keeper provides a way to manage module storage
 type Keeper struct {
-	cdc      codec.Marshaler
-	storeKey sdk.StoreKey
+	cdc        codec.Marshaler
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
 
 	hooks types.LockupHooks
 
 	ak authkeeper.AccountKeeper
 	bk types.BankKeeper
+	dk types.DistrKeeper
 }
 
 // NewKeeper returns an instance of Keeper
-func NewKeeper(cdc codec.Marshaler, storeKey sdk.StoreKey, ak authkeeper.AccountKeeper, bk types.BankKeeper) *Keeper {
+func NewKeeper(cdc codec.Marshaler, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, ak authkeeper.AccountKeeper, bk types.BankKeeper, dk types.DistrKeeper) *Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return &Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
-		ak:       ak,
-		bk:       bk,
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace,
+		ak:         ak,
+		bk:         bk,
+		dk:         dk,
 	}
 }
 
@@ -55,6 +64,7 @@ func (k *Keeper) SetHooks(lh types.LockupHooks) *Keeper {
 // and form a new pool with the old parameters but if they still had 2 months of lockup left,
 // their liquidity still needs to be 2 month lockup-ed, just in the new pool
 // And we need to replace their pool1 LP tokens with pool2 LP tokens with the same lock duration and end time
+// Its functions are only reached through the lockup governance proposals, no past upgrade handler calls them.
 type AdminKeeper struct {
 	Keeper
 }
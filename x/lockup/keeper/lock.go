@@ -1,12 +1,15 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/gogo/protobuf/proto"
 	"github.com/osmosis-labs/osmosis/store"
 	"github.com/osmosis-labs/osmosis/x/lockup/types"
@@ -17,14 +20,120 @@ func (k Keeper) getLocksFromIterator(ctx sdk.Context, iterator db.Iterator) []ty
 	locks := []types.PeriodLock{}
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		lockID := sdk.BigEndianToUint64(iterator.Value())
-		lock, err := k.GetLockByID(ctx, lockID)
+		locks = append(locks, k.getLockFromRefValue(ctx, iterator.Value()))
+	}
+	return locks
+}
+
+// getLocksFromIterators returns the locks of the iterators, in the order of the iterators
+func (k Keeper) getLocksFromIterators(ctx sdk.Context, iterators []db.Iterator) []types.PeriodLock {
+	locks := []types.PeriodLock{}
+	for _, iterator := range iterators {
+		locks = combineLocks(locks, k.getLocksFromIterator(ctx, iterator))
+	}
+	return locks
+}
+
+// getLocksFromIteratorsPaginated returns a page of the locks of the iterators, in the order of the iterators.
+// The locks are only read for the refs of the page. The next key of the page is the index of its iterator followed
+// by its ref key, so that a page request by key starts right there.
+func (k Keeper) getLocksFromIteratorsPaginated(ctx sdk.Context, iterators []db.Iterator, pageRequest *query.PageRequest) ([]types.PeriodLock, *query.PageResponse, error) {
+	defer func() {
+		for _, iterator := range iterators {
+			iterator.Close()
+		}
+	}()
+
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+
+	offset := pageRequest.Offset
+	key := pageRequest.Key
+	limit := pageRequest.Limit
+	countTotal := pageRequest.CountTotal
+
+	if offset > 0 && key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	if limit == 0 {
+		limit = query.DefaultLimit
+
+		// count total results when the limit is zero/not supplied
+		countTotal = true
+	}
+
+	locks := []types.PeriodLock{}
+	var nextKey []byte
+
+	if len(key) != 0 {
+		start := int(key[0])
+		if start >= len(iterators) {
+			return nil, nil, fmt.Errorf("invalid request, unknown key %X", key)
+		}
+
+		for i := start; i < len(iterators) && nextKey == nil; i++ {
+			for iterator := iterators[i]; iterator.Valid(); iterator.Next() {
+				if i == start && bytes.Compare(iterator.Key(), key[1:]) < 0 {
+					continue
+				}
+				if uint64(len(locks)) == limit {
+					nextKey = append([]byte{byte(i)}, iterator.Key()...)
+					break
+				}
+				locks = append(locks, k.getLockFromRefValue(ctx, iterator.Value()))
+			}
+		}
+
+		return locks, &query.PageResponse{NextKey: nextKey}, nil
+	}
+
+	end := offset + limit
+	var count uint64
+
+	for i := 0; i < len(iterators) && (nextKey == nil || countTotal); i++ {
+		for iterator := iterators[i]; iterator.Valid(); iterator.Next() {
+			count++
+
+			if count <= offset {
+				continue
+			}
+			if count <= end {
+				locks = append(locks, k.getLockFromRefValue(ctx, iterator.Value()))
+			} else if nextKey == nil {
+				nextKey = append([]byte{byte(i)}, iterator.Key()...)
+				if !countTotal {
+					break
+				}
+			}
+		}
+	}
+
+	res := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		res.Total = count
+	}
+
+	return locks, res, nil
+}
+
+// getLockFromRefValue returns the lock a lock ref points to
+func (k Keeper) getLockFromRefValue(ctx sdk.Context, value []byte) types.PeriodLock {
+	lockID := sdk.BigEndianToUint64(value[:8])
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		panic(err)
+	}
+	// the refs of synthetic lockups hold their suffix after the lock ID
+	if len(value) > 8 {
+		synthLock, err := k.GetSyntheticLockup(ctx, lockID, string(value[8:]))
 		if err != nil {
 			panic(err)
 		}
-		locks = append(locks, *lock)
+		*lock = synthLock.PeriodLockView(*lock)
 	}
-	return locks
+	return *lock
 }
 
 func (k Keeper) beginUnlockFromIterator(ctx sdk.Context, iterator db.Iterator) ([]types.PeriodLock, sdk.Coins, error) {
@@ -76,15 +185,19 @@ func (k Keeper) deleteLockRefs(ctx sdk.Context, lockRefPrefix []byte, lock types
 func (k Keeper) unlockFromIterator(ctx sdk.Context, iterator db.Iterator) ([]types.PeriodLock, sdk.Coins) {
 	coins := sdk.Coins{}
 	locks := k.getLocksFromIterator(ctx, iterator)
+	unlockedLocks := []types.PeriodLock{}
 	for _, lock := range locks {
 		err := k.Unlock(ctx, lock)
 		if err != nil {
-			panic(err)
+			// the lock stays in the unlocking queue, and is unlocked once its synthetic lockups have matured
+			k.Logger(ctx).Error(fmt.Sprintf("skipped unlocking lock %d: %s", lock.ID, err))
+			continue
 		}
+		unlockedLocks = append(unlockedLocks, lock)
 		// sum up all coins unlocked
 		coins = coins.Add(lock.Coins...)
 	}
-	return locks, coins
+	return unlockedLocks, coins
 }
 
 func (k Keeper) getCoinsFromLocks(locks []types.PeriodLock) sdk.Coins {
@@ -138,47 +251,54 @@ func (k Keeper) GetAccountLockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.
 
 // GetAccountLockedPastTime Returns the total locks of an account whose unlock time is beyond timestamp
 func (k Keeper) GetAccountLockedPastTime(ctx sdk.Context, addr sdk.AccAddress, timestamp time.Time) []types.PeriodLock {
-	// unlockings finish after specific time + not started locks that will finish after the time even though it start now
-	unlockings := k.getLocksFromIterator(ctx, k.AccountLockIteratorAfterTime(ctx, true, addr, timestamp))
-	duration := time.Duration(0)
-	if timestamp.After(ctx.BlockTime()) {
-		duration = timestamp.Sub(ctx.BlockTime())
+	return k.getLocksFromIterators(ctx, k.accountLockedPastTimeIterators(ctx, addr, timestamp))
+}
+
+func (k Keeper) accountLockedPastTimeIterators(ctx sdk.Context, addr sdk.AccAddress, timestamp time.Time) []db.Iterator {
+	// not started locks that will finish after the time even though it start now + unlockings finish after specific time
+	return []db.Iterator{
+		k.AccountLockIteratorLongerDuration(ctx, false, addr, durationUntil(ctx, timestamp)),
+		k.AccountLockIteratorAfterTime(ctx, true, addr, timestamp),
 	}
-	notUnlockings := k.getLocksFromIterator(ctx, k.AccountLockIteratorLongerDuration(ctx, false, addr, duration))
-	return combineLocks(notUnlockings, unlockings)
 }
 
 // GetAccountLockedPastTimeNotUnlockingOnly Returns the total locks of an account whose unlock time is beyond timestamp
 func (k Keeper) GetAccountLockedPastTimeNotUnlockingOnly(ctx sdk.Context, addr sdk.AccAddress, timestamp time.Time) []types.PeriodLock {
-	duration := time.Duration(0)
-	if timestamp.After(ctx.BlockTime()) {
-		duration = timestamp.Sub(ctx.BlockTime())
-	}
-	return k.getLocksFromIterator(ctx, k.AccountLockIteratorLongerDuration(ctx, false, addr, duration))
+	return k.getLocksFromIterators(ctx, k.accountLockedPastTimeNotUnlockingOnlyIterators(ctx, addr, timestamp))
+}
+
+func (k Keeper) accountLockedPastTimeNotUnlockingOnlyIterators(ctx sdk.Context, addr sdk.AccAddress, timestamp time.Time) []db.Iterator {
+	return []db.Iterator{k.AccountLockIteratorLongerDuration(ctx, false, addr, durationUntil(ctx, timestamp))}
 }
 
 // GetAccountUnlockedBeforeTime Returns the total unlocks of an account whose unlock time is before timestamp
 func (k Keeper) GetAccountUnlockedBeforeTime(ctx sdk.Context, addr sdk.AccAddress, timestamp time.Time) []types.PeriodLock {
-	// unlockings finish before specific time + not started locks that can finish before the time if start now
-	unlockings := k.getLocksFromIterator(ctx, k.AccountLockIteratorBeforeTime(ctx, true, addr, timestamp))
+	return k.getLocksFromIterators(ctx, k.accountUnlockedBeforeTimeIterators(ctx, addr, timestamp))
+}
+
+func (k Keeper) accountUnlockedBeforeTimeIterators(ctx sdk.Context, addr sdk.AccAddress, timestamp time.Time) []db.Iterator {
+	// not started locks that can finish before the time if start now + unlockings finish before specific time
+	unlockings := k.AccountLockIteratorBeforeTime(ctx, true, addr, timestamp)
 	if timestamp.Before(ctx.BlockTime()) {
-		return unlockings
+		return []db.Iterator{unlockings}
+	}
+	return []db.Iterator{
+		k.AccountLockIteratorShorterThanDuration(ctx, false, addr, durationUntil(ctx, timestamp)),
+		unlockings,
 	}
-	duration := timestamp.Sub(ctx.BlockTime())
-	notUnlockings := k.getLocksFromIterator(ctx, k.AccountLockIteratorShorterThanDuration(ctx, false, addr, duration))
-	return combineLocks(notUnlockings, unlockings)
 }
 
 // GetAccountLockedPastTimeDenom is equal to GetAccountLockedPastTime but denom specific
 func (k Keeper) GetAccountLockedPastTimeDenom(ctx sdk.Context, addr sdk.AccAddress, denom string, timestamp time.Time) []types.PeriodLock {
-	// unlockings finish after specific time + not started locks that will finish after the time even though it start now
-	unlockings := k.getLocksFromIterator(ctx, k.AccountLockIteratorAfterTimeDenom(ctx, true, addr, denom, timestamp))
-	duration := time.Duration(0)
-	if timestamp.After(ctx.BlockTime()) {
-		duration = timestamp.Sub(ctx.BlockTime())
+	return k.getLocksFromIterators(ctx, k.accountLockedPastTimeDenomIterators(ctx, addr, denom, timestamp))
+}
+
+func (k Keeper) accountLockedPastTimeDenomIterators(ctx sdk.Context, addr sdk.AccAddress, denom string, timestamp time.Time) []db.Iterator {
+	// not started locks that will finish after the time even though it start now + unlockings finish after specific time
+	return []db.Iterator{
+		k.AccountLockIteratorLongerDurationDenom(ctx, false, addr, denom, durationUntil(ctx, timestamp)),
+		k.AccountLockIteratorAfterTimeDenom(ctx, true, addr, denom, timestamp),
 	}
-	notUnlockings := k.getLocksFromIterator(ctx, k.AccountLockIteratorLongerDurationDenom(ctx, false, addr, denom, duration))
-	return combineLocks(notUnlockings, unlockings)
 }
 
 // GetAccountLockedDurationNotUnlockingOnly Returns account locked with specific duration within not unlockings
@@ -188,10 +308,15 @@ func (k Keeper) GetAccountLockedDurationNotUnlockingOnly(ctx sdk.Context, addr s
 
 // GetAccountLockedLongerDuration Returns account locked with duration longer than specified
 func (k Keeper) GetAccountLockedLongerDuration(ctx sdk.Context, addr sdk.AccAddress, duration time.Duration) []types.PeriodLock {
+	return k.getLocksFromIterators(ctx, k.accountLockedLongerDurationIterators(ctx, addr, duration))
+}
+
+func (k Keeper) accountLockedLongerDurationIterators(ctx sdk.Context, addr sdk.AccAddress, duration time.Duration) []db.Iterator {
 	// it does not matter started unlocking or not for duration query
-	unlockings := k.getLocksFromIterator(ctx, k.AccountLockIteratorLongerDuration(ctx, true, addr, duration))
-	notUnlockings := k.getLocksFromIterator(ctx, k.AccountLockIteratorLongerDuration(ctx, false, addr, duration))
-	return combineLocks(notUnlockings, unlockings)
+	return []db.Iterator{
+		k.AccountLockIteratorLongerDuration(ctx, false, addr, duration),
+		k.AccountLockIteratorLongerDuration(ctx, true, addr, duration),
+	}
 }
 
 // GetAccountLockedLongerDurationNotUnlockingOnly Returns account locked with duration longer than specified
@@ -201,21 +326,22 @@ func (k Keeper) GetAccountLockedLongerDurationNotUnlockingOnly(ctx sdk.Context,
 
 // GetAccountLockedLongerDurationDenom Returns account locked with duration longer than specified with specific denom
 func (k Keeper) GetAccountLockedLongerDurationDenom(ctx sdk.Context, addr sdk.AccAddress, denom string, duration time.Duration) []types.PeriodLock {
+	return k.getLocksFromIterators(ctx, k.accountLockedLongerDurationDenomIterators(ctx, addr, denom, duration))
+}
+
+func (k Keeper) accountLockedLongerDurationDenomIterators(ctx sdk.Context, addr sdk.AccAddress, denom string, duration time.Duration) []db.Iterator {
 	// it does not matter started unlocking or not for duration query
-	unlockings := k.getLocksFromIterator(ctx, k.AccountLockIteratorLongerDurationDenom(ctx, true, addr, denom, duration))
-	notUnlockings := k.getLocksFromIterator(ctx, k.AccountLockIteratorLongerDurationDenom(ctx, false, addr, denom, duration))
-	return combineLocks(notUnlockings, unlockings)
+	return []db.Iterator{
+		k.AccountLockIteratorLongerDurationDenom(ctx, false, addr, denom, duration),
+		k.AccountLockIteratorLongerDurationDenom(ctx, true, addr, denom, duration),
+	}
 }
 
 // GetLocksPastTimeDenom Returns the locks whose unlock time is beyond timestamp
 func (k Keeper) GetLocksPastTimeDenom(ctx sdk.Context, denom string, timestamp time.Time) []types.PeriodLock {
 	// returns both unlocking started and not started assuming it started unlocking current time
 	unlockings := k.getLocksFromIterator(ctx, k.LockIteratorAfterTimeDenom(ctx, true, denom, timestamp))
-	duration := time.Duration(0)
-	if timestamp.After(ctx.BlockTime()) {
-		duration = timestamp.Sub(ctx.BlockTime())
-	}
-	notUnlockings := k.getLocksFromIterator(ctx, k.LockIteratorLongerThanDurationDenom(ctx, false, denom, duration))
+	notUnlockings := k.getLocksFromIterator(ctx, k.LockIteratorLongerThanDurationDenom(ctx, false, denom, durationUntil(ctx, timestamp)))
 	return combineLocks(notUnlockings, unlockings)
 }
 
@@ -231,10 +357,15 @@ func (k Keeper) GetLockedDenom(ctx sdk.Context, denom string, duration time.Dura
 
 // GetLocksLongerThanDurationDenom Returns the locks whose unlock duration is longer than duration
 func (k Keeper) GetLocksLongerThanDurationDenom(ctx sdk.Context, denom string, duration time.Duration) []types.PeriodLock {
+	return k.getLocksFromIterators(ctx, k.locksLongerThanDurationDenomIterators(ctx, denom, duration))
+}
+
+func (k Keeper) locksLongerThanDurationDenomIterators(ctx sdk.Context, denom string, duration time.Duration) []db.Iterator {
 	// returns both unlocking started and not started
-	unlockings := k.getLocksFromIterator(ctx, k.LockIteratorLongerThanDurationDenom(ctx, true, denom, duration))
-	notUnlockings := k.getLocksFromIterator(ctx, k.LockIteratorLongerThanDurationDenom(ctx, false, denom, duration))
-	return combineLocks(notUnlockings, unlockings)
+	return []db.Iterator{
+		k.LockIteratorLongerThanDurationDenom(ctx, false, denom, duration),
+		k.LockIteratorLongerThanDurationDenom(ctx, true, denom, duration),
+	}
 }
 
 // GetLockByID Returns lock from lockID
@@ -259,9 +390,18 @@ func (k Keeper) GetPeriodLocks(ctx sdk.Context) ([]types.PeriodLock, error) {
 
 // GetAccountPeriodLocks Returns the period locks associated to an account
 func (k Keeper) GetAccountPeriodLocks(ctx sdk.Context, addr sdk.AccAddress) []types.PeriodLock {
-	unlockings := k.getLocksFromIterator(ctx, k.AccountLockIterator(ctx, true, addr))
-	notUnlockings := k.getLocksFromIterator(ctx, k.AccountLockIterator(ctx, false, addr))
-	return combineLocks(notUnlockings, unlockings)
+	return k.getLocksFromIterators(ctx, k.accountLocksIterators(ctx, addr, types.LockStatusAll))
+}
+
+func (k Keeper) accountLocksIterators(ctx sdk.Context, addr sdk.AccAddress, status types.LockStatus) []db.Iterator {
+	switch status {
+	case types.LockStatusNotUnlocking:
+		return []db.Iterator{k.AccountLockIterator(ctx, false, addr)}
+	case types.LockStatusUnlocking:
+		return []db.Iterator{k.AccountLockIterator(ctx, true, addr)}
+	default:
+		return []db.Iterator{k.AccountLockIterator(ctx, false, addr), k.AccountLockIterator(ctx, true, addr)}
+	}
 }
 
 // GetPeriodLocksByDuration returns the total amount of query.Denom tokens locked for longer than
@@ -287,6 +427,72 @@ func (k Keeper) BeginUnlockPeriodLockByID(ctx sdk.Context, LockID uint64) (*type
 	return lock, err
 }
 
+// BeginPartialUnlockPeriodLockByID begin unlock coins of the period lock with ID LockID.
+// The coins are split into a new lock which begins unlocking, while the rest of the lock stays locked.
+// It returns the unlocking lock, which is the period lock itself if coins are all of its coins.
+func (k Keeper) BeginPartialUnlockPeriodLockByID(ctx sdk.Context, LockID uint64, coins sdk.Coins) (*types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, LockID)
+	if err != nil {
+		return lock, err
+	}
+	if lock.IsUnlocking() {
+		return nil, fmt.Errorf("lock %d has already started unlocking", lock.ID)
+	}
+	if coins.Empty() || !lock.Coins.IsAllGTE(coins) {
+		return nil, fmt.Errorf("requested amount to unlock %s is not within the locked coins %s", coins, lock.Coins)
+	}
+
+	unlockingLock := *lock
+	if !lock.Coins.IsEqual(coins) {
+		// the split coins would leave the synthetic lockups of the lock, which are claims on all its coins
+		if synthLocks := k.GetAllSyntheticLockupsByLockup(ctx, lock.ID); len(synthLocks) > 0 {
+			return nil, sdkerrors.Wrapf(types.ErrLockHasSyntheticLockups, "lock %d can only be unlocked whole", lock.ID)
+		}
+		unlockingLock, err = k.splitLock(ctx, *lock, coins)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = k.BeginUnlock(ctx, unlockingLock)
+	if err != nil {
+		return nil, err
+	}
+	return k.GetLockByID(ctx, unlockingLock.ID)
+}
+
+// splitLock moves coins out of a not unlocking lock without synthetic lockups into a new lock with the same
+// owner and duration. The accumulation store is left as is since the coins keep their duration, and the hooks
+// see the coins being unlocked from the lock and locked into the new one.
+func (k Keeper) splitLock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coins) (types.PeriodLock, error) {
+	owner, err := sdk.AccAddressFromBech32(lock.Owner)
+	if err != nil {
+		return lock, err
+	}
+
+	// the lock refs are reset as the denoms of the lock might change
+	err = k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, lock)
+	if err != nil {
+		return lock, err
+	}
+	lock.Coins = lock.Coins.Sub(coins)
+	err = k.setLockAndResetLockRefs(ctx, lock)
+	if err != nil {
+		return lock, err
+	}
+
+	splitLock := types.NewPeriodLock(k.GetLastLockID(ctx)+1, owner, lock.Duration, lock.EndTime, coins)
+	err = k.setLockAndResetLockRefs(ctx, splitLock)
+	if err != nil {
+		return lock, err
+	}
+	k.SetLastLockID(ctx, splitLock.ID)
+
+	k.hooks.OnTokenUnlocked(ctx, owner, lock.ID, coins, lock.Duration, lock.EndTime)
+	k.hooks.OnTokenLocked(ctx, owner, splitLock.ID, coins, splitLock.Duration, splitLock.EndTime)
+	return splitLock, nil
+}
+
 // UnlockPeriodLockByID unlock by period lock ID
 func (k Keeper) UnlockPeriodLockByID(ctx sdk.Context, LockID uint64) (*types.PeriodLock, error) {
 	lock, err := k.GetLockByID(ctx, LockID)
@@ -298,9 +504,18 @@ func (k Keeper) UnlockPeriodLockByID(ctx sdk.Context, LockID uint64) (*types.Per
 }
 
 func (k Keeper) addTokensToLock(ctx sdk.Context, lock *types.PeriodLock, coins sdk.Coins) error {
+	err := k.deleteSyntheticLockRefsOfLock(ctx, *lock)
+	if err != nil {
+		return err
+	}
+
 	lock.Coins = lock.Coins.Add(coins...)
 
-	err := k.setLock(ctx, *lock)
+	err = k.setLock(ctx, *lock)
+	if err != nil {
+		return err
+	}
+	err = k.addSyntheticLockRefsOfLock(ctx, *lock)
 	if err != nil {
 		return err
 	}
@@ -340,6 +555,192 @@ func (k Keeper) AddTokensToLockByID(ctx sdk.Context, owner sdk.AccAddress, lockI
 	return lock, nil
 }
 
+// ExtendLockup extends the duration of a lock which is not unlocking to a longer duration
+// This also saves the lock to the store.
+func (k Keeper) ExtendLockup(ctx sdk.Context, owner sdk.AccAddress, lockID uint64, newDuration time.Duration) (*types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+	if lock.Owner != owner.String() {
+		return nil, types.ErrNotLockOwner
+	}
+	if lock.IsUnlocking() {
+		return nil, fmt.Errorf("lock %d has already started unlocking", lock.ID)
+	}
+	if newDuration <= lock.Duration {
+		return nil, fmt.Errorf("new duration %s should be longer than the lock duration %s", newDuration, lock.Duration)
+	}
+
+	// the lock refs are indexed by duration
+	err = k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, *lock)
+	if err != nil {
+		return nil, err
+	}
+
+	oldDuration := lock.Duration
+	lock.Duration = newDuration
+	err = k.setLockAndResetLockRefs(ctx, *lock)
+	if err != nil {
+		return nil, err
+	}
+
+	// move the coins to the new duration in the accumulation store
+	for _, coin := range lock.Coins {
+		k.accumulationStore(ctx, coin.Denom).Decrease(accumulationKey(oldDuration), coin.Amount)
+		k.accumulationStore(ctx, coin.Denom).Increase(accumulationKey(newDuration), coin.Amount)
+	}
+
+	k.hooks.OnLockDurationExtended(ctx, owner, lock.ID, lock.Coins, oldDuration, newDuration)
+	return lock, nil
+}
+
+// TransferLock transfers a lock to a new owner, keeping its duration and unlock time
+// Only the locks of transferable denoms can be transferred if lock transfers are restricted by the params.
+func (k Keeper) TransferLock(ctx sdk.Context, owner sdk.AccAddress, lockID uint64, newOwner sdk.AccAddress) (*types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+	if lock.Owner != owner.String() {
+		return nil, types.ErrNotLockOwner
+	}
+	if newOwner.Equals(owner) {
+		return nil, fmt.Errorf("lock %d is already owned by %s", lock.ID, newOwner)
+	}
+	if !k.GetParams(ctx).IsTransferable(lock.Coins) {
+		return nil, sdkerrors.Wrapf(types.ErrLockNotTransferable, "lock %d of %s", lock.ID, lock.Coins)
+	}
+
+	// the lock refs are indexed by owner
+	lockRefPrefix := unlockingPrefix(lock.IsUnlocking())
+	err = k.deleteLockRefs(ctx, lockRefPrefix, *lock)
+	if err != nil {
+		return nil, err
+	}
+	err = k.deleteSyntheticLockRefsOfLock(ctx, *lock)
+	if err != nil {
+		return nil, err
+	}
+
+	lock.Owner = newOwner.String()
+	err = k.setLock(ctx, *lock)
+	if err != nil {
+		return nil, err
+	}
+	err = k.addLockRefs(ctx, lockRefPrefix, *lock)
+	if err != nil {
+		return nil, err
+	}
+	err = k.addSyntheticLockRefsOfLock(ctx, *lock)
+	if err != nil {
+		return nil, err
+	}
+
+	k.hooks.OnLockTransferred(ctx, owner, newOwner, lock.ID, lock.Coins, lock.Duration, lock.EndTime)
+	return lock, nil
+}
+
+// SlashTokensFromLockByID sends coins out of a lock to the account of recipientModule, keeping its duration and unlock time
+// It is used by the modules that stake locked tokens, which dispose of the slashed coins,
+// and fires no hooks as it is called from theirs.
+func (k Keeper) SlashTokensFromLockByID(ctx sdk.Context, lockID uint64, coins sdk.Coins, recipientModule string) (*types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+	if !lock.Coins.IsAllGTE(coins) {
+		return nil, fmt.Errorf("requested amount to slash %s is not within the locked coins %s", coins, lock.Coins)
+	}
+
+	// the lock refs are reset as the denoms of the lock might change
+	err = k.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), *lock)
+	if err != nil {
+		return nil, err
+	}
+	err = k.deleteSyntheticLockRefsOfLock(ctx, *lock)
+	if err != nil {
+		return nil, err
+	}
+	lock.Coins = lock.Coins.Sub(coins)
+	err = k.setLockAndResetLockRefs(ctx, *lock)
+	if err != nil {
+		return nil, err
+	}
+	err = k.addSyntheticLockRefsOfLock(ctx, *lock)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, coin := range coins {
+		k.accumulationStore(ctx, coin.Denom).Decrease(accumulationKey(lock.Duration), coin.Amount)
+	}
+
+	if err := k.bk.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipientModule, coins); err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
+
+// ForceUnlock unlocks a lock right away, whether it started unlocking or not, for the penalty set by the params.
+// The penalty is taken from the coins of the lock and sent where the params set, and the rest goes back to its owner.
+// The locks with synthetic lockups can't be force unlocked, as the synthetic lockups are claims on their coins.
+func (k Keeper) ForceUnlock(ctx sdk.Context, owner sdk.AccAddress, lockID uint64) (*types.PeriodLock, sdk.Coins, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if lock.Owner != owner.String() {
+		return nil, nil, types.ErrNotLockOwner
+	}
+	if synthLocks := k.GetAllSyntheticLockupsByLockup(ctx, lock.ID); len(synthLocks) > 0 {
+		return nil, nil, sdkerrors.Wrapf(types.ErrLockHasSyntheticLockups, "lock %d", lock.ID)
+	}
+
+	params := k.GetParams(ctx)
+	penalty := params.ForceUnlockPenaltyOf(*lock, ctx.BlockTime())
+
+	// remove lock from store object
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(lockStoreKey(lock.ID))
+
+	// delete lock refs from the queue the lock is in
+	err = k.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), *lock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// remove from accumulation store
+	for _, coin := range lock.Coins {
+		k.accumulationStore(ctx, coin.Denom).Decrease(accumulationKey(lock.Duration), coin.Amount)
+	}
+
+	if !penalty.Empty() {
+		switch params.ForceUnlockPenaltyDestination {
+		case types.PenaltyToCommunityPool:
+			err = k.dk.FundCommunityPool(ctx, penalty, k.ak.GetModuleAddress(types.ModuleName))
+		case types.PenaltyBurned:
+			err = k.bk.BurnCoins(ctx, types.ModuleName, penalty)
+		default:
+			err = fmt.Errorf("unknown force unlock penalty destination %d", params.ForceUnlockPenaltyDestination)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// send the rest of the coins back to owner
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, lock.Coins.Sub(penalty)); err != nil {
+		return nil, nil, err
+	}
+
+	k.hooks.OnTokenUnlocked(ctx, owner, lock.ID, lock.Coins, lock.Duration, lock.EndTime)
+	if !penalty.Empty() {
+		k.hooks.OnLockPenalized(ctx, owner, lock.ID, penalty)
+	}
+	return lock, penalty, nil
+}
+
 // LockTokens lock tokens from an account for specified duration
 func (k Keeper) LockTokens(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, duration time.Duration) (types.PeriodLock, error) {
 	ID := k.GetLastLockID(ctx) + 1
@@ -495,8 +896,14 @@ func (k Keeper) Lock(ctx sdk.Context, lock types.PeriodLock) error {
 	return nil
 }
 
-// BeginUnlock is a utility to start unlocking coins from NotUnlocking queue
+// BeginUnlock is a utility to start unlocking coins from NotUnlocking queue.
+// The synthetic lockups of the lock must have started unlocking, so that the coins staked through them
+// go through their unbonding.
 func (k Keeper) BeginUnlock(ctx sdk.Context, lock types.PeriodLock) error {
+	if err := k.checkSyntheticLockupsUnlocking(ctx, lock); err != nil {
+		return err
+	}
+
 	// remove lock refs from not unlocking queue
 	err := k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, lock)
 	if err != nil {
@@ -531,6 +938,12 @@ func (k Keeper) Unlock(ctx sdk.Context, lock types.PeriodLock) error {
 	if curTime.Before(lock.EndTime) {
 		return fmt.Errorf("lock is not unlockable yet: %s >= %s", curTime.String(), lock.EndTime.String())
 	}
+	// the synthetic lockups of the lock are deleted with it, which they must have matured for
+	for _, synthLock := range k.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
+		if !synthLock.IsUnlocking() || curTime.Before(synthLock.EndTime) {
+			return sdkerrors.Wrapf(types.ErrLockHasSyntheticLockups, "lock %d has synthetic lockup %s which has not matured", lock.ID, synthLock.Suffix)
+		}
+	}
 
 	owner, err := sdk.AccAddressFromBech32(lock.Owner)
 	if err != nil {
@@ -542,6 +955,12 @@ func (k Keeper) Unlock(ctx sdk.Context, lock types.PeriodLock) error {
 		return err
 	}
 
+	// the synthetic lockups of the lock go away with it
+	err = k.deleteAllSyntheticLockupsOfLock(ctx, lock)
+	if err != nil {
+		return err
+	}
+
 	// remove lock from store object
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(lockStoreKey(lock.ID))
@@ -560,3 +979,13 @@ func (k Keeper) Unlock(ctx sdk.Context, lock types.PeriodLock) error {
 	k.hooks.OnTokenUnlocked(ctx, owner, lock.ID, lock.Coins, lock.Duration, lock.EndTime)
 	return nil
 }
+
+// checkSyntheticLockupsUnlocking returns an error if a synthetic lockup of lock has not started unlocking
+func (k Keeper) checkSyntheticLockupsUnlocking(ctx sdk.Context, lock types.PeriodLock) error {
+	for _, synthLock := range k.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
+		if !synthLock.IsUnlocking() {
+			return sdkerrors.Wrapf(types.ErrLockHasSyntheticLockups, "lock %d has synthetic lockup %s which has not started unlocking", lock.ID, synthLock.Suffix)
+		}
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/osmosis-labs/osmosis/x/lockup/types"
 )
 
@@ -75,6 +76,73 @@ func (suite *KeeperTestSuite) TestBeginUnlockPeriodLock() {
 	suite.Require().NotEqual(locks[0].IsUnlocking(), false)
 }
 
+func (suite *KeeperTestSuite) TestBeginPartialUnlockPeriodLock() {
+	suite.SetupTest()
+	now := suite.ctx.BlockTime()
+
+	// lock coins
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("foo", 5), sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+
+	// the amount must be within the lock
+	_, err := suite.app.LockupKeeper.BeginPartialUnlockPeriodLockByID(suite.ctx, 1, sdk.Coins{sdk.NewInt64Coin("stake", 11)})
+	suite.Require().Error(err)
+	_, err = suite.app.LockupKeeper.BeginPartialUnlockPeriodLockByID(suite.ctx, 1, sdk.Coins{sdk.NewInt64Coin("bar", 1)})
+	suite.Require().Error(err)
+
+	// begin unlock part of the lock
+	unlockCoins := sdk.Coins{sdk.NewInt64Coin("foo", 5), sdk.NewInt64Coin("stake", 4)}
+	unlockingLock, err := suite.app.LockupKeeper.BeginPartialUnlockPeriodLockByID(suite.ctx, 1, unlockCoins)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), unlockingLock.ID)
+	suite.Require().Equal(unlockCoins, unlockingLock.Coins)
+	suite.Require().Equal(now.Add(time.Second), unlockingLock.EndTime)
+	suite.Require().Equal(uint64(2), suite.app.LockupKeeper.GetLastLockID(suite.ctx))
+
+	// the rest stays locked
+	lock1, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 6)}, lock1.Coins)
+	suite.Require().False(lock1.IsUnlocking())
+
+	// check lock refs
+	suite.Require().Equal(unlockCoins, suite.app.LockupKeeper.GetAccountUnlockingCoins(suite.ctx, addr1))
+	suite.Require().Equal(coins, suite.app.LockupKeeper.GetAccountLockedCoins(suite.ctx, addr1))
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.ctx, addr1, "stake", time.Second), 1)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.ctx, addr1, "foo", time.Second), 0)
+
+	// the accumulation store is unchanged until the coins are withdrawn
+	suite.Require().Equal(sdk.NewInt(10), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake", time.Second))
+	suite.Require().Equal(sdk.NewInt(5), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "foo", time.Second))
+
+	// an unlocking lock can't be split
+	_, err = suite.app.LockupKeeper.BeginPartialUnlockPeriodLockByID(suite.ctx, 2, sdk.Coins{sdk.NewInt64Coin("stake", 1)})
+	suite.Require().Error(err)
+
+	// withdraw the unlocking lock
+	suite.app.LockupKeeper.WithdrawAllMaturedLocks(suite.ctx.WithBlockTime(now.Add(time.Second)))
+	suite.Require().Equal(unlockCoins, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
+	suite.Require().Equal(sdk.NewInt(6), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake", time.Second))
+	suite.Require().True(suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "foo", time.Second).IsZero())
+
+	// a lock with synthetic lockups can't be split, even once they are unlocking
+	_, err = suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 1, "superbonding", time.Second)
+	suite.Require().NoError(err)
+	_, err = suite.app.LockupKeeper.BeginUnlockSyntheticLockup(suite.ctx, 1, "superbonding")
+	suite.Require().NoError(err)
+	_, err = suite.app.LockupKeeper.BeginPartialUnlockPeriodLockByID(suite.ctx, 1, sdk.Coins{sdk.NewInt64Coin("stake", 1)})
+	suite.Require().ErrorIs(err, types.ErrLockHasSyntheticLockups)
+	suite.Require().Equal(uint64(2), suite.app.LockupKeeper.GetLastLockID(suite.ctx))
+
+	// unlocking all the coins of a lock doesn't split it
+	unlockingLock, err = suite.app.LockupKeeper.BeginPartialUnlockPeriodLockByID(suite.ctx, 1, lock1.Coins)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), unlockingLock.ID)
+	suite.Require().True(unlockingLock.IsUnlocking())
+	suite.Require().Equal(uint64(2), suite.app.LockupKeeper.GetLastLockID(suite.ctx))
+}
+
 func (suite *KeeperTestSuite) TestGetPeriodLocks() {
 	suite.SetupTest()
 
@@ -340,6 +408,52 @@ func (suite *KeeperTestSuite) TestAddTokensToLock() {
 	suite.Require().Error(err)
 }
 
+func (suite *KeeperTestSuite) TestExtendLockup() {
+	suite.SetupTest()
+
+	// lock coins
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+	suite.LockTokens(addr2, coins, time.Second)
+
+	// the duration can only be increased
+	_, err := suite.app.LockupKeeper.ExtendLockup(suite.ctx, addr1, 1, time.Second)
+	suite.Require().Error(err)
+	_, err = suite.app.LockupKeeper.ExtendLockup(suite.ctx, addr1, 1, time.Millisecond)
+	suite.Require().Error(err)
+
+	// only by the owner of the lock
+	_, err = suite.app.LockupKeeper.ExtendLockup(suite.ctx, addr2, 1, time.Second*2)
+	suite.Require().Error(err)
+
+	lock, err := suite.app.LockupKeeper.ExtendLockup(suite.ctx, addr1, 1, time.Second*2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(time.Second*2, lock.Duration)
+	suite.Require().False(lock.IsUnlocking())
+
+	// check lock refs
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.ctx, addr1, "stake", time.Second), 0)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.ctx, addr1, "stake", time.Second*2), 1)
+	suite.Require().Len(suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, "stake", time.Second*2), 1)
+
+	// check accumulation store is correctly updated
+	suite.Require().Equal(sdk.NewInt(20), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake", time.Second))
+	suite.Require().Equal(sdk.NewInt(10), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake", time.Second*2))
+
+	// the lock unlocks over the new duration
+	_, err = suite.app.LockupKeeper.BeginUnlockPeriodLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	lock, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.ctx.BlockTime().Add(time.Second*2), lock.EndTime)
+
+	// unlocking locks can't be extended
+	_, err = suite.app.LockupKeeper.ExtendLockup(suite.ctx, addr1, 1, time.Second*3)
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestEndblockerWithdrawAllMaturedLockups() {
 	suite.SetupTest()
 
@@ -465,3 +579,141 @@ func (suite *KeeperTestSuite) TestLockAccumulationStore() {
 	})
 	suite.Require().Equal(int64(0), acc.Int64())
 }
+
+func (suite *KeeperTestSuite) TestTransferLock() {
+	suite.SetupTest()
+	now := suite.ctx.BlockTime()
+
+	// lock coins
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+	suite.LockTokens(addr1, coins, time.Second*2)
+	_, err := suite.app.LockupKeeper.BeginUnlockPeriodLockByID(suite.ctx, 2)
+	suite.Require().NoError(err)
+
+	// only the owner can transfer a lock
+	_, err = suite.app.LockupKeeper.TransferLock(suite.ctx, addr2, 1, addr2)
+	suite.Require().Error(err)
+	_, err = suite.app.LockupKeeper.TransferLock(suite.ctx, addr1, 1, addr1)
+	suite.Require().Error(err)
+
+	// locks can't be transferred if their denoms are not allowed
+	params := types.DefaultParams()
+	params.RestrictLockTransfers = true
+	params.TransferableDenoms = []string{"foo"}
+	suite.app.LockupKeeper.SetParams(suite.ctx, params)
+	_, err = suite.app.LockupKeeper.TransferLock(suite.ctx, addr1, 1, addr2)
+	suite.Require().ErrorIs(err, types.ErrLockNotTransferable)
+	params.TransferableDenoms = []string{"foo", "stake"}
+	suite.app.LockupKeeper.SetParams(suite.ctx, params)
+
+	// transfer a lock and an unlocking lock
+	lock, err := suite.app.LockupKeeper.TransferLock(suite.ctx, addr1, 1, addr2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr2.String(), lock.Owner)
+	suite.Require().Equal(time.Second, lock.Duration)
+	_, err = suite.app.LockupKeeper.TransferLock(suite.ctx, addr1, 2, addr2)
+	suite.Require().NoError(err)
+
+	// check lock refs
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addr1), 0)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addr2), 2)
+	suite.Require().Equal(sdk.Coins(nil), suite.app.LockupKeeper.GetAccountLockedCoins(suite.ctx, addr1))
+	suite.Require().Equal(coins.Add(coins...), suite.app.LockupKeeper.GetAccountLockedCoins(suite.ctx, addr2))
+	suite.Require().Equal(coins, suite.app.LockupKeeper.GetAccountUnlockingCoins(suite.ctx, addr2))
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.ctx, addr2, "stake", time.Second), 1)
+
+	// the accumulation store is unchanged
+	suite.Require().Equal(sdk.NewInt(20), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake", time.Second))
+
+	// the unlocked coins go to the new owner
+	suite.app.LockupKeeper.WithdrawAllMaturedLocks(suite.ctx.WithBlockTime(now.Add(time.Second * 2)))
+	suite.Require().Equal(coins, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr2))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1).Empty())
+}
+
+func (suite *KeeperTestSuite) TestSlashTokensFromLockByID() {
+	suite.SetupTest()
+
+	// lock coins
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+
+	// slash more than the locked coins
+	_, err := suite.app.LockupKeeper.SlashTokensFromLockByID(suite.ctx, 1, sdk.Coins{sdk.NewInt64Coin("stake", 11)}, authtypes.FeeCollectorName)
+	suite.Require().Error(err)
+
+	// slash part of the lock
+	lock, err := suite.app.LockupKeeper.SlashTokensFromLockByID(suite.ctx, 1, sdk.Coins{sdk.NewInt64Coin("stake", 3)}, authtypes.FeeCollectorName)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 7)}, lock.Coins)
+	suite.Require().Equal(time.Second, lock.Duration)
+
+	// the slashed coins go to the recipient module
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 3)}, suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollector))
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 7)}, suite.app.LockupKeeper.GetModuleBalance(suite.ctx))
+
+	// check lock refs and the accumulation store
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 7)}, suite.app.LockupKeeper.GetAccountLockedCoins(suite.ctx, addr1))
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.ctx, addr1, "stake", time.Second), 1)
+	suite.Require().Equal(sdk.NewInt(7), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake", time.Second))
+}
+
+func (suite *KeeperTestSuite) TestForceUnlock() {
+	suite.SetupTest()
+	now := suite.ctx.BlockTime()
+
+	// lock coins
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 1000)}
+	suite.LockTokens(addr1, coins, time.Second*10)
+	params := types.DefaultParams()
+	params.ForceUnlockPenalty = sdk.NewDecWithPrec(2, 1)
+	suite.app.LockupKeeper.SetParams(suite.ctx, params)
+
+	// only the owner can force unlock a lock
+	_, _, err := suite.app.LockupKeeper.ForceUnlock(suite.ctx, addr2, 1)
+	suite.Require().ErrorIs(err, types.ErrNotLockOwner)
+
+	// a lock which hasn't started unlocking pays the full penalty, which goes to the community pool by default
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+	lock, penalty, err := suite.app.LockupKeeper.ForceUnlock(suite.ctx, addr1, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, lock.Coins)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 200)}, penalty)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 800)}, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
+	suite.Require().Equal(sdk.NewDec(200), suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).Sub(communityPool).AmountOf("stake"))
+
+	// the lock is gone, with its refs and accumulation store entries
+	_, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().Error(err)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addr1), 0)
+	suite.Require().Len(suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, "stake", 0), 0)
+	suite.Require().Equal(sdk.ZeroInt(), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake", 0))
+
+	// an unlocking lock pays the penalty for the time left, and it can be burned
+	params.ForceUnlockPenaltyDestination = types.PenaltyBurned
+	suite.app.LockupKeeper.SetParams(suite.ctx, params)
+	suite.LockTokens(addr1, coins, time.Second*10)
+	_, err = suite.app.LockupKeeper.BeginUnlockPeriodLockByID(suite.ctx, 2)
+	suite.Require().NoError(err)
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx).GetTotal().AmountOf("stake")
+	_, penalty, err = suite.app.LockupKeeper.ForceUnlock(suite.ctx.WithBlockTime(now.Add(time.Second*6)), addr1, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 80)}, penalty)
+	suite.Require().Equal(sdk.NewInt(80), supply.Sub(suite.app.BankKeeper.GetSupply(suite.ctx).GetTotal().AmountOf("stake")))
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 920)}, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
+	suite.Require().True(suite.app.LockupKeeper.GetAccountUnlockingCoins(suite.ctx, addr1).Empty())
+
+	// the locks with synthetic lockups can't be force unlocked
+	suite.LockTokens(addr1, coins, time.Second*10)
+	_, err = suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 3, "superbonding", time.Second)
+	suite.Require().NoError(err)
+	_, _, err = suite.app.LockupKeeper.ForceUnlock(suite.ctx, addr1, 3)
+	suite.Require().ErrorIs(err, types.ErrLockHasSyntheticLockups)
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+// MigrateLockedDenom replaces fromDenom by toDenom in every lock, unlocking ones included, once the locked
+// tokens were replaced one for one in the module account.
+// The locks keep their ids, durations and end times, and their synthetic lockups move to the synthetic denoms of toDenom.
+func (k Keeper) MigrateLockedDenom(ctx sdk.Context, fromDenom, toDenom string) error {
+	for _, isUnlocking := range []bool{false, true} {
+		locks := k.getLocksFromIterator(ctx, k.LockIteratorDenom(ctx, isUnlocking, fromDenom))
+		lockRefPrefix := unlockingPrefix(isUnlocking)
+
+		for _, lock := range locks {
+			err := k.deleteLockRefs(ctx, lockRefPrefix, lock)
+			if err != nil {
+				return err
+			}
+			err = k.deleteSyntheticLockRefsOfLock(ctx, lock)
+			if err != nil {
+				return err
+			}
+
+			amount := lock.Coins.AmountOf(fromDenom)
+			lock.Coins = lock.Coins.
+				Sub(sdk.NewCoins(sdk.NewCoin(fromDenom, amount))).
+				Add(sdk.NewCoin(toDenom, amount))
+
+			err = k.setLock(ctx, lock)
+			if err != nil {
+				return err
+			}
+			err = k.addLockRefs(ctx, lockRefPrefix, lock)
+			if err != nil {
+				return err
+			}
+			err = k.addSyntheticLockRefsOfLock(ctx, lock)
+			if err != nil {
+				return err
+			}
+
+			k.accumulationStore(ctx, fromDenom).Decrease(accumulationKey(lock.Duration), amount)
+			k.accumulationStore(ctx, toDenom).Increase(accumulationKey(lock.Duration), amount)
+		}
+	}
+	return nil
+}
+
+// GammHooks returns the gamm hooks of the lockup module, which keep the locked pool shares in sync with the pools.
+func (k Keeper) GammHooks() GammHooks { return GammHooks{k} }
+
+// GammHooks updates the locks when the shares they hold are replaced by gamm.
+type GammHooks struct {
+	k Keeper
+}
+
+var _ gammtypes.GammHooks = GammHooks{}
+
+func (h GammHooks) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {}
+
+func (h GammHooks) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+}
+
+func (h GammHooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+}
+
+func (h GammHooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+}
+
+// AfterPoolMigrated replaces the shares of the old pool by the shares of the new pool in the locks
+func (h GammHooks) AfterPoolMigrated(ctx sdk.Context, oldPoolId uint64, newPoolId uint64) {
+	err := h.k.MigrateLockedDenom(ctx, gammtypes.GetPoolShareDenom(oldPoolId), gammtypes.GetPoolShareDenom(newPoolId))
+	if err != nil {
+		panic(err)
+	}
+}
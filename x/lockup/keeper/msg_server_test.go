@@ -60,3 +60,71 @@ func (suite *KeeperTestSuite) TestMsgLockTokens() {
 	})
 	suite.Require().Equal(accum.String(), "20")
 }
+
+func (suite *KeeperTestSuite) TestMsgBeginUnlocking() {
+	suite.SetupTest()
+
+	// lock coins
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+	msgServer := keeper.NewMsgServerImpl(suite.app.LockupKeeper)
+
+	// only the owner can begin unlocking
+	_, err := msgServer.BeginUnlocking(sdk.WrapSDKContext(suite.ctx), types.NewMsgBeginUnlocking(addr2, 1, sdk.Coins{sdk.NewInt64Coin("stake", 4)}))
+	suite.Require().ErrorIs(err, types.ErrNotLockOwner)
+	suite.Require().Equal(uint64(1), suite.app.LockupKeeper.GetLastLockID(suite.ctx))
+
+	// begin unlocking part of the lock
+	res, err := msgServer.BeginUnlocking(sdk.WrapSDKContext(suite.ctx), types.NewMsgBeginUnlocking(addr1, 1, sdk.Coins{sdk.NewInt64Coin("stake", 4)}))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.UnlockingLockID)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 4)}, suite.app.LockupKeeper.GetAccountUnlockingCoins(suite.ctx, addr1))
+
+	// begin unlocking the rest of the lock
+	res, err = msgServer.BeginUnlocking(sdk.WrapSDKContext(suite.ctx), types.NewMsgBeginUnlocking(addr1, 1, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.UnlockingLockID)
+	suite.Require().Equal(coins, suite.app.LockupKeeper.GetAccountUnlockingCoins(suite.ctx, addr1))
+}
+
+func (suite *KeeperTestSuite) TestMsgTransferLock() {
+	suite.SetupTest()
+
+	// lock coins
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+	msgServer := keeper.NewMsgServerImpl(suite.app.LockupKeeper)
+
+	_, err := msgServer.TransferLock(sdk.WrapSDKContext(suite.ctx), types.NewMsgTransferLock(addr1, 1, addr2))
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, suite.app.LockupKeeper.GetAccountLockedCoins(suite.ctx, addr2))
+
+	// the lock now belongs to the new owner
+	_, err = msgServer.TransferLock(sdk.WrapSDKContext(suite.ctx), types.NewMsgTransferLock(addr1, 1, addr2))
+	suite.Require().Error(err)
+	_, err = msgServer.BeginUnlocking(sdk.WrapSDKContext(suite.ctx), types.NewMsgBeginUnlocking(addr2, 1, nil))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestMsgForceUnlock() {
+	suite.SetupTest()
+
+	// lock coins
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 100)}
+	suite.LockTokens(addr1, coins, time.Second)
+	msgServer := keeper.NewMsgServerImpl(suite.app.LockupKeeper)
+
+	res, err := msgServer.ForceUnlock(sdk.WrapSDKContext(suite.ctx), types.NewMsgForceUnlock(addr1, 1))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 25)}, res.Penalty)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 75)}, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
+
+	// the lock is gone
+	_, err = msgServer.ForceUnlock(sdk.WrapSDKContext(suite.ctx), types.NewMsgForceUnlock(addr1, 1))
+	suite.Require().Error(err)
+}
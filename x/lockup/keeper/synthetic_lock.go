@@ -0,0 +1,285 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+	"github.com/osmosis-labs/osmosis/x/lockup/types"
+)
+
+// syntheticLockStoreKey returns the store key of the synthetic lockup of a lock with suffix
+func syntheticLockStoreKey(lockID uint64, suffix string) []byte {
+	return combineKeys(types.KeyPrefixSyntheticLockup, sdk.Uint64ToBigEndian(lockID), []byte(suffix))
+}
+
+// syntheticLockTimeKey returns the key indexing an unlocking synthetic lockup by its end time
+func syntheticLockTimeKey(synthLock types.SyntheticLock) []byte {
+	return combineKeys(types.KeyPrefixSyntheticLockTimestamp, getTimeKey(synthLock.EndTime), sdk.Uint64ToBigEndian(synthLock.UnderlyingLockId), []byte(synthLock.Suffix))
+}
+
+// syntheticLockRefValue returns the value of the lock refs of a synthetic lockup.
+// The suffix follows the lock ID, which tells the synthetic lockup refs apart from the ones of the locks.
+func syntheticLockRefValue(synthLock types.SyntheticLock) []byte {
+	return append(sdk.Uint64ToBigEndian(synthLock.UnderlyingLockId), []byte(synthLock.Suffix)...)
+}
+
+// GetSyntheticLockup returns the synthetic lockup of a lock with suffix
+func (k Keeper) GetSyntheticLockup(ctx sdk.Context, lockID uint64, suffix string) (*types.SyntheticLock, error) {
+	synthLock := types.SyntheticLock{}
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(syntheticLockStoreKey(lockID, suffix))
+	if bz == nil {
+		return nil, sdkerrors.Wrapf(types.ErrSyntheticLockupNotFound, "lock %d, suffix %s", lockID, suffix)
+	}
+	err := proto.Unmarshal(bz, &synthLock)
+	return &synthLock, err
+}
+
+// GetAllSyntheticLockupsByLockup returns the synthetic lockups of a lock, ordered by suffix
+func (k Keeper) GetAllSyntheticLockupsByLockup(ctx sdk.Context, lockID uint64) []types.SyntheticLock {
+	return k.getSyntheticLockupsFromIterator(k.iterator(ctx, combineKeys(types.KeyPrefixSyntheticLockup, sdk.Uint64ToBigEndian(lockID), []byte{})))
+}
+
+// GetAllSyntheticLockups returns all the synthetic lockups, ordered by lock ID and suffix
+func (k Keeper) GetAllSyntheticLockups(ctx sdk.Context) []types.SyntheticLock {
+	return k.getSyntheticLockupsFromIterator(k.iterator(ctx, types.KeyPrefixSyntheticLockup))
+}
+
+func (k Keeper) getSyntheticLockupsFromIterator(iterator sdk.Iterator) []types.SyntheticLock {
+	synthLocks := []types.SyntheticLock{}
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		synthLock := types.SyntheticLock{}
+		if err := proto.Unmarshal(iterator.Value(), &synthLock); err != nil {
+			panic(err)
+		}
+		synthLocks = append(synthLocks, synthLock)
+	}
+	return synthLocks
+}
+
+// setSyntheticLockup stores the synthetic lockup object, and indexes it by end time if it is unlocking
+func (k Keeper) setSyntheticLockup(ctx sdk.Context, synthLock types.SyntheticLock) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := proto.Marshal(&synthLock)
+	if err != nil {
+		return err
+	}
+	store.Set(syntheticLockStoreKey(synthLock.UnderlyingLockId, synthLock.Suffix), bz)
+	if synthLock.IsUnlocking() {
+		store.Set(syntheticLockTimeKey(synthLock), syntheticLockStoreKey(synthLock.UnderlyingLockId, synthLock.Suffix))
+	}
+	return nil
+}
+
+// CreateSyntheticLockup creates a synthetic lockup of a lock with suffix and duration.
+// The coins of the lock are not moved, they are indexed under their synthetic denoms with the duration of the
+// synthetic lockup as long as the lock and the synthetic lockup both exist.
+func (k Keeper) CreateSyntheticLockup(ctx sdk.Context, lockID uint64, suffix string, duration time.Duration) (*types.SyntheticLock, error) {
+	if err := types.ValidateSyntheticSuffix(suffix); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidSyntheticSuffix, err.Error())
+	}
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := k.GetSyntheticLockup(ctx, lockID, suffix); err == nil {
+		return nil, sdkerrors.Wrapf(types.ErrSyntheticLockupAlreadyExists, "lock %d, suffix %s", lockID, suffix)
+	}
+
+	synthLock := types.SyntheticLock{
+		UnderlyingLockId: lockID,
+		Suffix:           suffix,
+		Duration:         duration,
+	}
+	if err := synthLock.SyntheticCoins(lock.Coins).Validate(); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidSyntheticSuffix, err.Error())
+	}
+
+	err = k.setSyntheticLockup(ctx, synthLock)
+	if err != nil {
+		return nil, err
+	}
+	err = k.addSyntheticLockRefs(ctx, *lock, synthLock)
+	if err != nil {
+		return nil, err
+	}
+	return &synthLock, nil
+}
+
+// BeginUnlockSyntheticLockup starts unlocking a synthetic lockup, which is deleted once its duration has passed
+func (k Keeper) BeginUnlockSyntheticLockup(ctx sdk.Context, lockID uint64, suffix string) (*types.SyntheticLock, error) {
+	synthLock, err := k.GetSyntheticLockup(ctx, lockID, suffix)
+	if err != nil {
+		return nil, err
+	}
+	if synthLock.IsUnlocking() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "synthetic lockup %d/%s has already started unlocking", lockID, suffix)
+	}
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the synthetic lockup refs move to the unlocking queue
+	err = k.deleteSyntheticLockRefs(ctx, *lock, *synthLock)
+	if err != nil {
+		return nil, err
+	}
+	synthLock.EndTime = ctx.BlockTime().Add(synthLock.Duration)
+	err = k.setSyntheticLockup(ctx, *synthLock)
+	if err != nil {
+		return nil, err
+	}
+	err = k.addSyntheticLockRefs(ctx, *lock, *synthLock)
+	if err != nil {
+		return nil, err
+	}
+	return synthLock, nil
+}
+
+// DeleteSyntheticLockup deletes a synthetic lockup along with its lock refs and accumulation store entries
+func (k Keeper) DeleteSyntheticLockup(ctx sdk.Context, lockID uint64, suffix string) error {
+	synthLock, err := k.GetSyntheticLockup(ctx, lockID, suffix)
+	if err != nil {
+		return err
+	}
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	return k.deleteSyntheticLockup(ctx, *lock, *synthLock)
+}
+
+func (k Keeper) deleteSyntheticLockup(ctx sdk.Context, lock types.PeriodLock, synthLock types.SyntheticLock) error {
+	err := k.deleteSyntheticLockRefs(ctx, lock, synthLock)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(syntheticLockStoreKey(synthLock.UnderlyingLockId, synthLock.Suffix))
+	if synthLock.IsUnlocking() {
+		store.Delete(syntheticLockTimeKey(synthLock))
+	}
+	return nil
+}
+
+// DeleteAllMaturedSyntheticLocks deletes the unlocking synthetic lockups which have finished unlocking by the
+// current block time
+func (k Keeper) DeleteAllMaturedSyntheticLocks(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	// as for the locks, a synthetic lockup counts as unlocked at its end time
+	iterator := k.iteratorBeforeTime(ctx, types.KeyPrefixSyntheticLockTimestamp, ctx.BlockTime())
+	synthLocks := []types.SyntheticLock{}
+	for ; iterator.Valid(); iterator.Next() {
+		synthLock := types.SyntheticLock{}
+		// the end time index holds the store key of the synthetic lockup
+		bz := store.Get(iterator.Value())
+		if err := proto.Unmarshal(bz, &synthLock); err != nil {
+			panic(err)
+		}
+		synthLocks = append(synthLocks, synthLock)
+	}
+	iterator.Close()
+
+	for _, synthLock := range synthLocks {
+		if err := k.DeleteSyntheticLockup(ctx, synthLock.UnderlyingLockId, synthLock.Suffix); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// addSyntheticLockRefs indexes the coins of lock under the synthetic denoms of synthLock, with its duration and
+// end time, and adds them to the accumulation store of the synthetic denoms.
+// Only the denom lock refs are used so that the synthetic lockups don't show up among the locks of an account.
+func (k Keeper) addSyntheticLockRefs(ctx sdk.Context, lock types.PeriodLock, synthLock types.SyntheticLock) error {
+	owner, err := sdk.AccAddressFromBech32(lock.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	lockRefPrefix := unlockingPrefix(synthLock.IsUnlocking())
+	synthView := synthLock.PeriodLockView(lock)
+	for _, refKey := range denomLockRefKeys(synthView, owner) {
+		store.Set(combineKeys(lockRefPrefix, refKey, sdk.Uint64ToBigEndian(lock.ID)), syntheticLockRefValue(synthLock))
+	}
+
+	for _, coin := range synthView.Coins {
+		k.accumulationStore(ctx, coin.Denom).Increase(accumulationKey(synthView.Duration), coin.Amount)
+	}
+	return nil
+}
+
+// deleteSyntheticLockRefs reverts addSyntheticLockRefs
+func (k Keeper) deleteSyntheticLockRefs(ctx sdk.Context, lock types.PeriodLock, synthLock types.SyntheticLock) error {
+	owner, err := sdk.AccAddressFromBech32(lock.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	lockRefPrefix := unlockingPrefix(synthLock.IsUnlocking())
+	synthView := synthLock.PeriodLockView(lock)
+	for _, refKey := range denomLockRefKeys(synthView, owner) {
+		store.Delete(combineKeys(lockRefPrefix, refKey, sdk.Uint64ToBigEndian(lock.ID)))
+	}
+
+	for _, coin := range synthView.Coins {
+		k.accumulationStore(ctx, coin.Denom).Decrease(accumulationKey(synthView.Duration), coin.Amount)
+	}
+	return nil
+}
+
+// addSyntheticLockRefsOfLock adds the refs of all the synthetic lockups of lock.
+// It is called along with deleteSyntheticLockRefsOfLock around the changes to the owner or the coins of a lock.
+func (k Keeper) addSyntheticLockRefsOfLock(ctx sdk.Context, lock types.PeriodLock) error {
+	for _, synthLock := range k.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
+		if err := k.addSyntheticLockRefs(ctx, lock, synthLock); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteSyntheticLockRefsOfLock deletes the refs of all the synthetic lockups of lock
+func (k Keeper) deleteSyntheticLockRefsOfLock(ctx sdk.Context, lock types.PeriodLock) error {
+	for _, synthLock := range k.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
+		if err := k.deleteSyntheticLockRefs(ctx, lock, synthLock); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteAllSyntheticLockupsOfLock deletes the synthetic lockups of lock, which can't outlive it
+func (k Keeper) deleteAllSyntheticLockupsOfLock(ctx sdk.Context, lock types.PeriodLock) error {
+	for _, synthLock := range k.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
+		if err := k.deleteSyntheticLockup(ctx, lock, synthLock); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResetAllSyntheticLocks stores the synthetic lockups and indexes them, once their locks are all set
+func (k Keeper) ResetAllSyntheticLocks(ctx sdk.Context, synthLocks []types.SyntheticLock) error {
+	for _, synthLock := range synthLocks {
+		lock, err := k.GetLockByID(ctx, synthLock.UnderlyingLockId)
+		if err != nil {
+			return err
+		}
+		err = k.setSyntheticLockup(ctx, synthLock)
+		if err != nil {
+			return err
+		}
+		err = k.addSyntheticLockRefs(ctx, *lock, synthLock)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/osmosis-labs/osmosis/x/lockup/types"
+)
+
+func (suite *KeeperTestSuite) TestSyntheticLockup() {
+	suite.SetupTest()
+	now := suite.ctx.BlockTime()
+	synthDenom := types.SyntheticDenom("stake", "superbonding")
+
+	// lock coins
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	suite.LockTokens(addr2, sdk.Coins{sdk.NewInt64Coin("stake", 5)}, time.Second)
+
+	// create a synthetic lockup
+	_, err := suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 1, "", time.Second*5)
+	suite.Require().ErrorIs(err, types.ErrInvalidSyntheticSuffix)
+	_, err = suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 1, "super/bonding", time.Second*5)
+	suite.Require().ErrorIs(err, types.ErrInvalidSyntheticSuffix)
+	_, err = suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 3, "superbonding", time.Second*5)
+	suite.Require().Error(err)
+	synthLock, err := suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 1, "superbonding", time.Second*5)
+	suite.Require().NoError(err)
+	suite.Require().False(synthLock.IsUnlocking())
+	_, err = suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 1, "superbonding", time.Second*5)
+	suite.Require().ErrorIs(err, types.ErrSyntheticLockupAlreadyExists)
+	suite.Require().Equal([]types.SyntheticLock{*synthLock}, suite.app.LockupKeeper.GetAllSyntheticLockupsByLockup(suite.ctx, 1))
+
+	// the synthetic lockup is seen as a lock of the synthetic denom by the denom queries
+	locks := suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, synthDenom, time.Second*5)
+	suite.Require().Len(locks, 1)
+	suite.Require().Equal(uint64(1), locks[0].ID)
+	suite.Require().Equal(addr1.String(), locks[0].Owner)
+	suite.Require().Equal(time.Second*5, locks[0].Duration)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(synthDenom, 10)}, locks[0].Coins)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountLockedPastTimeDenom(suite.ctx, addr1, synthDenom, now), 1)
+	suite.Require().Equal(sdk.NewInt(10), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, synthDenom, time.Second*5))
+
+	// but not by the queries of the lock denom, nor the account ones
+	suite.Require().Len(suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, "stake", time.Second), 2)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountLockedPastTimeDenom(suite.ctx, addr1, "stake", now), 1)
+	suite.Require().Equal(sdk.NewInt(15), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, "stake", time.Second))
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addr1), 1)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 10)}, suite.app.LockupKeeper.GetAccountLockedCoins(suite.ctx, addr1))
+
+	// the synthetic lockup follows the coins of the lock
+	err = suite.app.BankKeeper.SetBalances(suite.ctx, addr1, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
+	suite.Require().NoError(err)
+	_, err = suite.app.LockupKeeper.AddTokensToLockByID(suite.ctx, addr1, 1, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(15), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, synthDenom, time.Second*5))
+	locks = suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, synthDenom, time.Second*5)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(synthDenom, 15)}, locks[0].Coins)
+
+	// and its owner
+	_, err = suite.app.LockupKeeper.TransferLock(suite.ctx, addr1, 1, addr2)
+	suite.Require().NoError(err)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountLockedPastTimeDenom(suite.ctx, addr1, synthDenom, now), 0)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountLockedPastTimeDenom(suite.ctx, addr2, synthDenom, now), 1)
+
+	// begin unlocking the synthetic lockup
+	synthLock, err = suite.app.LockupKeeper.BeginUnlockSyntheticLockup(suite.ctx, 1, "superbonding")
+	suite.Require().NoError(err)
+	suite.Require().Equal(now.Add(time.Second*5), synthLock.EndTime)
+	_, err = suite.app.LockupKeeper.BeginUnlockSyntheticLockup(suite.ctx, 1, "superbonding")
+	suite.Require().Error(err)
+	locks = suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, synthDenom, time.Second*5)
+	suite.Require().Len(locks, 1)
+	suite.Require().True(locks[0].IsUnlocking())
+	suite.Require().Equal(sdk.NewInt(15), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, synthDenom, time.Second*5))
+
+	// the lock itself isn't unlocking
+	lock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().False(lock.IsUnlocking())
+
+	// the synthetic lockup is deleted once matured
+	suite.app.LockupKeeper.DeleteAllMaturedSyntheticLocks(suite.ctx.WithBlockTime(now.Add(time.Second * 4)))
+	suite.Require().Len(suite.app.LockupKeeper.GetAllSyntheticLockups(suite.ctx), 1)
+	suite.app.LockupKeeper.DeleteAllMaturedSyntheticLocks(suite.ctx.WithBlockTime(now.Add(time.Second * 5)))
+	suite.Require().Len(suite.app.LockupKeeper.GetAllSyntheticLockups(suite.ctx), 0)
+	suite.Require().Len(suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, synthDenom, 0), 0)
+	suite.Require().Equal(sdk.ZeroInt(), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, synthDenom, 0))
+
+	// the lock can't begin unlocking before its synthetic lockups
+	_, err = suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 1, "superbonding", time.Second*5)
+	suite.Require().NoError(err)
+	_, err = suite.app.LockupKeeper.BeginUnlockPeriodLockByID(suite.ctx, 1)
+	suite.Require().ErrorIs(err, types.ErrLockHasSyntheticLockups)
+	_, _, err = suite.app.LockupKeeper.BeginUnlockAllNotUnlockings(suite.ctx, addr2)
+	suite.Require().ErrorIs(err, types.ErrLockHasSyntheticLockups)
+	_, err = suite.app.LockupKeeper.BeginPartialUnlockPeriodLockByID(suite.ctx, 1, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
+	suite.Require().ErrorIs(err, types.ErrLockHasSyntheticLockups)
+
+	// nor be unlocked before they matured
+	_, err = suite.app.LockupKeeper.BeginUnlockSyntheticLockup(suite.ctx, 1, "superbonding")
+	suite.Require().NoError(err)
+	_, err = suite.app.LockupKeeper.BeginUnlockPeriodLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.app.LockupKeeper.WithdrawAllMaturedLocks(suite.ctx.WithBlockTime(now.Add(time.Second)))
+	_, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+
+	// the synthetic lockups of a lock are deleted with it
+	suite.app.LockupKeeper.WithdrawAllMaturedLocks(suite.ctx.WithBlockTime(now.Add(time.Second * 5)))
+	_, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().Error(err)
+	_, err = suite.app.LockupKeeper.GetSyntheticLockup(suite.ctx, 1, "superbonding")
+	suite.Require().ErrorIs(err, types.ErrSyntheticLockupNotFound)
+	suite.Require().Len(suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, synthDenom, 0), 0)
+	suite.Require().Equal(sdk.ZeroInt(), suite.app.LockupKeeper.GetLockedDenom(suite.ctx, synthDenom, 0))
+}
@@ -43,6 +43,14 @@ func getDurationKey(duration time.Duration) []byte {
 	return combineKeys(types.KeyPrefixDuration, key)
 }
 
+// durationUntil returns the duration a lock starting to unlock now must have to be unlocking until timestamp
+func durationUntil(ctx sdk.Context, timestamp time.Time) time.Duration {
+	if timestamp.After(ctx.BlockTime()) {
+		return timestamp.Sub(ctx.BlockTime())
+	}
+	return 0
+}
+
 func lockRefKeys(lock types.PeriodLock) ([][]byte, error) {
 	refKeys := [][]byte{}
 	timeKey := getTimeKey(lock.EndTime)
@@ -57,6 +65,14 @@ func lockRefKeys(lock types.PeriodLock) ([][]byte, error) {
 	refKeys = append(refKeys, combineKeys(types.KeyPrefixLockDuration, durationKey))
 	refKeys = append(refKeys, combineKeys(types.KeyPrefixAccountLockTimestamp, owner, timeKey))
 	refKeys = append(refKeys, combineKeys(types.KeyPrefixAccountLockDuration, owner, durationKey))
+	return append(refKeys, denomLockRefKeys(lock, owner)...), nil
+}
+
+// denomLockRefKeys returns the ref keys of lock which are specific to the denoms of its coins
+func denomLockRefKeys(lock types.PeriodLock, owner sdk.AccAddress) [][]byte {
+	refKeys := [][]byte{}
+	timeKey := getTimeKey(lock.EndTime)
+	durationKey := getDurationKey(lock.Duration)
 
 	for _, coin := range lock.Coins {
 		denomBz := []byte(coin.Denom)
@@ -65,7 +81,7 @@ func lockRefKeys(lock types.PeriodLock) ([][]byte, error) {
 		refKeys = append(refKeys, combineKeys(types.KeyPrefixAccountDenomLockTimestamp, owner, denomBz, timeKey))
 		refKeys = append(refKeys, combineKeys(types.KeyPrefixAccountDenomLockDuration, owner, denomBz, durationKey))
 	}
-	return refKeys, nil
+	return refKeys
 }
 
 func combineLocks(pl1 []types.PeriodLock, pl2 []types.PeriodLock) []types.PeriodLock {
@@ -8,5 +8,12 @@ import (
 
 // x/lockup module sentinel errors
 var (
-	ErrNotLockOwner = sdkerrors.Register(ModuleName, 1, "msg sender is not the owner of specified lock")
+	ErrNotLockOwner                 = sdkerrors.Register(ModuleName, 1, "msg sender is not the owner of specified lock")
+	ErrLockNotTransferable          = sdkerrors.Register(ModuleName, 2, "lock is not transferable")
+	ErrSyntheticLockupAlreadyExists = sdkerrors.Register(ModuleName, 3, "synthetic lockup already exists")
+	ErrSyntheticLockupNotFound      = sdkerrors.Register(ModuleName, 4, "synthetic lockup not found")
+	ErrInvalidSyntheticSuffix       = sdkerrors.Register(ModuleName, 5, "invalid synthetic lockup suffix")
+	ErrLockHasSyntheticLockups      = sdkerrors.Register(ModuleName, 6, "lock has synthetic lockups")
+	ErrEmptyProposalLocks           = sdkerrors.Register(ModuleName, 7, "proposal has no locks")
+	ErrDuplicateProposalLock        = sdkerrors.Register(ModuleName, 8, "proposal has a lock more than once")
 )
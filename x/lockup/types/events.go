@@ -6,11 +6,20 @@ const (
 	TypeEvtAddTokensToLock = "add_tokens_to_lock"
 	TypeEvtBeginUnlockAll  = "begin_unlock_all"
 	TypeEvtBeginUnlock     = "begin_unlock"
+	TypeEvtExtendLockup    = "extend_lockup"
+	TypeEvtTransferLock    = "transfer_lock"
+	TypeEvtForceUnlock     = "force_unlock"
+	TypeEvtBreakLock       = "break_lock"
+	TypeEvtRelock          = "relock"
 
-	AttributePeriodLockID         = "period_lock_id"
-	AttributePeriodLockOwner      = "owner"
-	AttributePeriodLockAmount     = "amount"
-	AttributePeriodLockDuration   = "duration"
-	AttributePeriodLockUnlockTime = "unlock_time"
-	AttributeUnlockedCoins        = "unlocked_coins"
+	AttributePeriodLockID          = "period_lock_id"
+	AttributePeriodLockOwner       = "owner"
+	AttributePeriodLockAmount      = "amount"
+	AttributePeriodLockDuration    = "duration"
+	AttributePeriodLockUnlockTime  = "unlock_time"
+	AttributeUnlockedCoins         = "unlocked_coins"
+	AttributePeriodLockOldDuration = "old_duration"
+	AttributePeriodLockNewOwner    = "new_owner"
+	AttributePenalty               = "penalty"
+	AttributePeriodLockNewAmount   = "new_amount"
 )
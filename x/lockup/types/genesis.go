@@ -1,15 +1,39 @@
 package types
 
+import "fmt"
+
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return nil
+	lockIDs := make(map[uint64]bool, len(gs.Locks))
+	for _, lock := range gs.Locks {
+		lockIDs[lock.ID] = true
+	}
+
+	synthLockKeys := make(map[string]bool, len(gs.SyntheticLocks))
+	for _, synthLock := range gs.SyntheticLocks {
+		if !lockIDs[synthLock.UnderlyingLockId] {
+			return fmt.Errorf("synthetic lockup %d/%s of a lock not in genesis", synthLock.UnderlyingLockId, synthLock.Suffix)
+		}
+		if err := ValidateSyntheticSuffix(synthLock.Suffix); err != nil {
+			return err
+		}
+		synthLockKey := fmt.Sprintf("%d/%s", synthLock.UnderlyingLockId, synthLock.Suffix)
+		if synthLockKeys[synthLockKey] {
+			return fmt.Errorf("duplicate synthetic lockup %s", synthLockKey)
+		}
+		synthLockKeys[synthLockKey] = true
+	}
+
+	return gs.Params.Validate()
 }
@@ -27,6 +27,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	LastLockId uint64       `protobuf:"varint,1,opt,name=last_lock_id,json=lastLockId,proto3" json:"last_lock_id,omitempty"`
 	Locks      []PeriodLock `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks"`
+	// params defines all the parameters of the module
+	Params         Params          `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	SyntheticLocks []SyntheticLock `protobuf:"bytes,4,rep,name=synthetic_locks,json=syntheticLocks,proto3" json:"synthetic_locks"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +79,20 @@ func (m *GenesisState) GetLocks() []PeriodLock {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetSyntheticLocks() []SyntheticLock {
+	if m != nil {
+		return m.SyntheticLocks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.lockup.GenesisState")
 }
@@ -83,21 +100,25 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/genesis.proto", fileDescriptor_648db7c6ebb608b0) }
 
 var fileDescriptor_648db7c6ebb608b0 = []byte{
-	// 224 bytes of a gzipped FileDescriptorProto
+	// 287 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x2f, 0xce, 0xcd,
 	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0xc9, 0x4f, 0xce, 0x2e, 0x2d, 0xd0, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
 	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xca, 0xea, 0x41, 0x64, 0xa5,
 	0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94, 0x24, 0x9a, 0x19,
-	0x20, 0x0a, 0x22, 0xa5, 0x94, 0xc1, 0xc5, 0xe3, 0x0e, 0x31, 0x31, 0xb8, 0x24, 0xb1, 0x24, 0x55,
-	0x48, 0x81, 0x8b, 0x27, 0x27, 0xb1, 0xb8, 0x24, 0x1e, 0xa4, 0x24, 0x3e, 0x33, 0x45, 0x82, 0x51,
-	0x81, 0x51, 0x83, 0x25, 0x88, 0x0b, 0x24, 0xe6, 0x93, 0x9f, 0x9c, 0xed, 0x99, 0x22, 0x64, 0xc6,
-	0xc5, 0x0a, 0x92, 0x2c, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x92, 0xd2, 0x43, 0x75, 0x82,
-	0x5e, 0x40, 0x6a, 0x51, 0x66, 0x7e, 0x0a, 0x48, 0xb1, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41,
-	0x10, 0xe5, 0x4e, 0x1e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c,
-	0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x97,
-	0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x35, 0x4c, 0x37, 0x27, 0x31,
-	0xa9, 0x18, 0xc6, 0xd1, 0xaf, 0x80, 0x39, 0xbc, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec,
-	0x74, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3d, 0xe8, 0x14, 0x44, 0x1b, 0x01, 0x00, 0x00,
+	0x20, 0x0a, 0x2a, 0x25, 0x8d, 0x26, 0x55, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x35, 0x5d, 0xe9, 0x0d,
+	0x23, 0x17, 0x8f, 0x3b, 0xc4, 0xbe, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x05, 0x2e, 0x9e, 0x9c,
+	0xc4, 0xe2, 0x92, 0x78, 0x90, 0xe2, 0xf8, 0xcc, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x96, 0x20,
+	0x2e, 0x90, 0x98, 0x4f, 0x7e, 0x72, 0xb6, 0x67, 0x8a, 0x90, 0x19, 0x17, 0x2b, 0x48, 0xb2, 0x58,
+	0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x4a, 0x0f, 0xd5, 0x81, 0x7a, 0x01, 0xa9, 0x45, 0x99,
+	0xf9, 0x29, 0x20, 0xc5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x94, 0x0b, 0x99, 0x70,
+	0xb1, 0x41, 0xac, 0x96, 0x60, 0x56, 0x60, 0xd4, 0xe0, 0x36, 0x12, 0xc3, 0xd0, 0x08, 0x96, 0x85,
+	0x6a, 0x82, 0xaa, 0x15, 0xf2, 0xe1, 0xe2, 0x2f, 0xae, 0xcc, 0x2b, 0xc9, 0x48, 0x2d, 0xc9, 0x4c,
+	0x8e, 0x87, 0xd8, 0xcb, 0x02, 0xb6, 0x57, 0x16, 0x5d, 0x7b, 0x30, 0x4c, 0x19, 0x92, 0xd5, 0x7c,
+	0xc5, 0xc8, 0x82, 0xc5, 0x4e, 0x1e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
+	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
+	0xa5, 0x97, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x35, 0x58, 0x37,
+	0x27, 0x31, 0xa9, 0x18, 0xc6, 0xd1, 0xaf, 0x80, 0x85, 0x5f, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12,
+	0x1b, 0x38, 0xfc, 0x8c, 0x01, 0x03, 0x00, 0x28, 0x05, 0x26, 0xd5, 0xbd, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -120,6 +141,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SyntheticLocks) > 0 {
+		for iNdEx := len(m.SyntheticLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SyntheticLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -168,6 +213,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SyntheticLocks) > 0 {
+		for _, e := range m.SyntheticLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -259,6 +312,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyntheticLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyntheticLocks = append(m.SyntheticLocks, SyntheticLock{})
+			if err := m.SyntheticLocks[len(m.SyntheticLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
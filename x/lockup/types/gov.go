@@ -0,0 +1,136 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeBreakLocks = "BreakLocks"
+	ProposalTypeRelock     = "Relock"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeBreakLocks)
+	govtypes.RegisterProposalTypeCodec(&BreakLocksProposal{}, "osmosis/BreakLocksProposal")
+	govtypes.RegisterProposalType(ProposalTypeRelock)
+	govtypes.RegisterProposalTypeCodec(&RelockProposal{}, "osmosis/RelockProposal")
+}
+
+var _ govtypes.Content = &BreakLocksProposal{}
+var _ govtypes.Content = &RelockProposal{}
+
+func NewBreakLocksProposal(title, description string, lockIDs []uint64) govtypes.Content {
+	return &BreakLocksProposal{
+		Title:       title,
+		Description: description,
+		LockIds:     lockIDs,
+	}
+}
+
+func (p *BreakLocksProposal) GetTitle() string { return p.Title }
+
+func (p *BreakLocksProposal) GetDescription() string { return p.Description }
+
+func (p *BreakLocksProposal) ProposalRoute() string { return RouterKey }
+
+func (p *BreakLocksProposal) ProposalType() string { return ProposalTypeBreakLocks }
+
+func (p *BreakLocksProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return ValidateBreakLocks(p.LockIds)
+}
+
+func (p BreakLocksProposal) String() string {
+	lockIDsStr := make([]string, len(p.LockIds))
+	for i, lockID := range p.LockIds {
+		lockIDsStr[i] = fmt.Sprint(lockID)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Break Locks Proposal:
+  Title:       %s
+  Description: %s
+  Lock IDs:    %s
+`, p.Title, p.Description, strings.Join(lockIDsStr, " ")))
+	return b.String()
+}
+
+// ValidateBreakLocks checks that lockIDs can be broken by a BreakLocksProposal.
+func ValidateBreakLocks(lockIDs []uint64) error {
+	if len(lockIDs) == 0 {
+		return ErrEmptyProposalLocks
+	}
+
+	seen := map[uint64]bool{}
+	for _, lockID := range lockIDs {
+		if seen[lockID] {
+			return sdkerrors.Wrapf(ErrDuplicateProposalLock, "lock %d", lockID)
+		}
+		seen[lockID] = true
+	}
+	return nil
+}
+
+func NewRelockProposal(title, description string, relocks []Relock) govtypes.Content {
+	return &RelockProposal{
+		Title:       title,
+		Description: description,
+		Relocks:     relocks,
+	}
+}
+
+func (p *RelockProposal) GetTitle() string { return p.Title }
+
+func (p *RelockProposal) GetDescription() string { return p.Description }
+
+func (p *RelockProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RelockProposal) ProposalType() string { return ProposalTypeRelock }
+
+func (p *RelockProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return ValidateRelocks(p.Relocks)
+}
+
+func (p RelockProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Relock Proposal:
+  Title:       %s
+  Description: %s
+  Relocks:
+`, p.Title, p.Description))
+	for _, relock := range p.Relocks {
+		b.WriteString(fmt.Sprintf("    Lock %d: %s\n", relock.LockId, relock.NewCoins))
+	}
+	return b.String()
+}
+
+// ValidateRelocks checks that relocks can be applied by a RelockProposal.
+func ValidateRelocks(relocks []Relock) error {
+	if len(relocks) == 0 {
+		return ErrEmptyProposalLocks
+	}
+
+	seen := map[uint64]bool{}
+	for _, relock := range relocks {
+		if seen[relock.LockId] {
+			return sdkerrors.Wrapf(ErrDuplicateProposalLock, "lock %d", relock.LockId)
+		}
+		seen[relock.LockId] = true
+
+		if !relock.NewCoins.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "lock %d: %s", relock.LockId, relock.NewCoins)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1396 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/lockup/gov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BreakLocksProposal is a gov Content type for unlocking locks right away,
+// without any penalty. Their coins are sent back to their owners.
+type BreakLocksProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	LockIds     []uint64 `protobuf:"varint,3,rep,packed,name=lock_ids,json=lockIds,proto3" json:"lock_ids,omitempty" yaml:"lock_ids"`
+}
+
+func (m *BreakLocksProposal) Reset()      { *m = BreakLocksProposal{} }
+func (*BreakLocksProposal) ProtoMessage() {}
+func (*BreakLocksProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1248ce07272c2aa8, []int{0}
+}
+func (m *BreakLocksProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BreakLocksProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BreakLocksProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BreakLocksProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BreakLocksProposal.Merge(m, src)
+}
+func (m *BreakLocksProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *BreakLocksProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_BreakLocksProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BreakLocksProposal proto.InternalMessageInfo
+
+// Relock replaces the coins of the lock with lock_id by new_coins, taken from
+// its owner. The lock keeps its duration and unlock time.
+type Relock struct {
+	LockId   uint64                                   `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
+	NewCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=new_coins,json=newCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"new_coins" yaml:"new_coins"`
+}
+
+func (m *Relock) Reset()         { *m = Relock{} }
+func (m *Relock) String() string { return proto.CompactTextString(m) }
+func (*Relock) ProtoMessage()    {}
+func (*Relock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1248ce07272c2aa8, []int{1}
+}
+func (m *Relock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Relock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Relock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Relock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Relock.Merge(m, src)
+}
+func (m *Relock) XXX_Size() int {
+	return m.Size()
+}
+func (m *Relock) XXX_DiscardUnknown() {
+	xxx_messageInfo_Relock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Relock proto.InternalMessageInfo
+
+func (m *Relock) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *Relock) GetNewCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NewCoins
+	}
+	return nil
+}
+
+// RelockProposal is a gov Content type for replacing the coins of locks.
+// The replaced coins are sent back to the owners of the locks.
+type RelockProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Relocks     []Relock `protobuf:"bytes,3,rep,name=relocks,proto3" json:"relocks"`
+}
+
+func (m *RelockProposal) Reset()      { *m = RelockProposal{} }
+func (*RelockProposal) ProtoMessage() {}
+func (*RelockProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1248ce07272c2aa8, []int{2}
+}
+func (m *RelockProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelockProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelockProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelockProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelockProposal.Merge(m, src)
+}
+func (m *RelockProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelockProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelockProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelockProposal proto.InternalMessageInfo
+
+// LockBalanceChange is the balance movement between the owner of a lock and
+// the lockup module account caused by an admin action on the lock.
+type LockBalanceChange struct {
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
+	Owner  string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// coins sent from the lockup module account to the owner
+	SentToOwner github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=sent_to_owner,json=sentToOwner,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"sent_to_owner" yaml:"sent_to_owner"`
+	// coins taken from the owner into the lockup module account
+	TakenFromOwner github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=taken_from_owner,json=takenFromOwner,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"taken_from_owner" yaml:"taken_from_owner"`
+}
+
+func (m *LockBalanceChange) Reset()         { *m = LockBalanceChange{} }
+func (m *LockBalanceChange) String() string { return proto.CompactTextString(m) }
+func (*LockBalanceChange) ProtoMessage()    {}
+func (*LockBalanceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1248ce07272c2aa8, []int{3}
+}
+func (m *LockBalanceChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockBalanceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockBalanceChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockBalanceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockBalanceChange.Merge(m, src)
+}
+func (m *LockBalanceChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockBalanceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockBalanceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockBalanceChange proto.InternalMessageInfo
+
+func (m *LockBalanceChange) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *LockBalanceChange) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LockBalanceChange) GetSentToOwner() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SentToOwner
+	}
+	return nil
+}
+
+func (m *LockBalanceChange) GetTakenFromOwner() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TakenFromOwner
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BreakLocksProposal)(nil), "osmosis.lockup.BreakLocksProposal")
+	proto.RegisterType((*Relock)(nil), "osmosis.lockup.Relock")
+	proto.RegisterType((*RelockProposal)(nil), "osmosis.lockup.RelockProposal")
+	proto.RegisterType((*LockBalanceChange)(nil), "osmosis.lockup.LockBalanceChange")
+}
+
+func init() { proto.RegisterFile("osmosis/lockup/gov.proto", fileDescriptor_1248ce07272c2aa8) }
+
+var fileDescriptor_1248ce07272c2aa8 = []byte{
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x4d, 0x9a, 0xb4, 0x97, 0x12, 0x82, 0x89, 0x20, 0x74, 0xb0, 0x23, 0x0f, 0x28,
+	0x12, 0xea, 0x59, 0x2d, 0x12, 0x43, 0x46, 0x17, 0xa1, 0x22, 0x90, 0x40, 0x16, 0x13, 0x4b, 0x74,
+	0x71, 0x8e, 0xd4, 0xb2, 0x7d, 0xcf, 0xf2, 0x5d, 0x1b, 0x2a, 0xb1, 0x22, 0x10, 0x03, 0xea, 0xc8,
+	0x98, 0x99, 0x6f, 0xc0, 0x37, 0xe8, 0xd8, 0x91, 0xc9, 0xa0, 0x64, 0x61, 0xce, 0x27, 0x40, 0xbe,
+	0x73, 0x50, 0xd3, 0xa5, 0x8a, 0x98, 0xfc, 0xee, 0xfe, 0xef, 0xfd, 0xfd, 0x7b, 0xef, 0xe9, 0x70,
+	0x07, 0x44, 0x02, 0x22, 0x14, 0x6e, 0x0c, 0x41, 0x74, 0x92, 0xba, 0x63, 0x38, 0x25, 0x69, 0x06,
+	0x12, 0xcc, 0x66, 0xa9, 0x10, 0xad, 0xec, 0xb6, 0xc7, 0x30, 0x06, 0x25, 0xb9, 0x45, 0xa4, 0xb3,
+	0x76, 0xad, 0x40, 0xa5, 0xb9, 0x43, 0x2a, 0x98, 0x7b, 0xba, 0x3f, 0x64, 0x92, 0xee, 0xbb, 0x01,
+	0x84, 0x5c, 0xeb, 0xce, 0x17, 0x84, 0x4d, 0x2f, 0x63, 0x34, 0x7a, 0x09, 0x41, 0x24, 0x5e, 0x67,
+	0x90, 0x82, 0xa0, 0xb1, 0xd9, 0xc6, 0x9b, 0x32, 0x94, 0x31, 0xeb, 0xa0, 0x2e, 0xea, 0x6d, 0xfb,
+	0xfa, 0x60, 0x76, 0x71, 0x63, 0xc4, 0x44, 0x90, 0x85, 0xa9, 0x0c, 0x81, 0x77, 0x36, 0x94, 0x76,
+	0xf5, 0xca, 0x24, 0x78, 0xab, 0xc0, 0x19, 0x84, 0x23, 0xd1, 0xa9, 0x74, 0x2b, 0xbd, 0xaa, 0x77,
+	0x77, 0x91, 0xdb, 0xb7, 0xcf, 0x68, 0x12, 0xf7, 0x9d, 0xa5, 0xe2, 0xf8, 0xf5, 0x22, 0x7c, 0x3e,
+	0x12, 0xfd, 0x9d, 0xcf, 0x53, 0xdb, 0xf8, 0x36, 0xb5, 0x8d, 0x3f, 0x53, 0x1b, 0x39, 0x3f, 0x10,
+	0xae, 0xf9, 0xac, 0xd0, 0xcc, 0x47, 0xb8, 0x5e, 0xa6, 0x2b, 0x84, 0xaa, 0x67, 0x2e, 0x72, 0xbb,
+	0xb9, 0xe2, 0xe3, 0xf8, 0x35, 0x6d, 0x63, 0x7e, 0xc0, 0xdb, 0x9c, 0x4d, 0x06, 0x45, 0x5b, 0xa2,
+	0xb3, 0xd1, 0xad, 0xf4, 0x1a, 0x07, 0x0f, 0x88, 0x6e, 0x9c, 0x14, 0x8d, 0x93, 0xb2, 0x71, 0x72,
+	0x08, 0x21, 0xf7, 0x9e, 0x5e, 0xe4, 0xb6, 0xb1, 0xc8, 0xed, 0x96, 0x76, 0xfb, 0x57, 0xe9, 0x7c,
+	0xff, 0x65, 0xf7, 0xc6, 0xa1, 0x3c, 0x3e, 0x19, 0x92, 0x00, 0x12, 0xb7, 0x9c, 0x9c, 0xfe, 0xec,
+	0x89, 0x51, 0xe4, 0xca, 0xb3, 0x94, 0x09, 0x65, 0x22, 0xfc, 0x2d, 0xce, 0x26, 0x2a, 0xea, 0x57,
+	0x15, 0xfb, 0x57, 0x84, 0x9b, 0x9a, 0xfd, 0xbf, 0x87, 0xf8, 0x04, 0xd7, 0x33, 0xe5, 0xa4, 0x67,
+	0xd8, 0x38, 0xb8, 0x47, 0x56, 0x77, 0x4d, 0xf4, 0x8f, 0xbc, 0x6a, 0xd1, 0x89, 0xbf, 0x4c, 0xbe,
+	0x36, 0xcc, 0x8f, 0x15, 0x7c, 0xa7, 0x58, 0xaa, 0x47, 0x63, 0xca, 0x03, 0x76, 0x78, 0x4c, 0xf9,
+	0x98, 0xad, 0x37, 0xd7, 0x87, 0x78, 0x13, 0x26, 0x9c, 0x65, 0x1a, 0xd2, 0x6b, 0x2d, 0x72, 0x7b,
+	0x47, 0xa7, 0xaa, 0x6b, 0xc7, 0xd7, 0xb2, 0xf9, 0x09, 0xe1, 0x5b, 0x82, 0x71, 0x39, 0x90, 0x30,
+	0xd0, 0x05, 0x95, 0x9b, 0x96, 0x70, 0x54, 0x2e, 0xa1, 0xad, 0xfd, 0x56, 0xaa, 0xd7, 0x5b, 0x44,
+	0xa3, 0xa8, 0x7d, 0x03, 0xaf, 0x14, 0xc9, 0x39, 0xc2, 0x2d, 0x49, 0x23, 0xc6, 0x07, 0xef, 0x32,
+	0x48, 0x4a, 0x98, 0xea, 0x4d, 0x30, 0x2f, 0x4a, 0x98, 0xfb, 0x1a, 0xe6, 0xba, 0xc1, 0x7a, 0x3c,
+	0x4d, 0x55, 0xfe, 0x2c, 0x83, 0x44, 0x21, 0x79, 0x47, 0x17, 0x33, 0x0b, 0x5d, 0xce, 0x2c, 0xf4,
+	0x7b, 0x66, 0xa1, 0xf3, 0xb9, 0x65, 0x5c, 0xce, 0x2d, 0xe3, 0xe7, 0xdc, 0x32, 0xde, 0x92, 0x2b,
+	0x9e, 0xe5, 0x82, 0xf7, 0x62, 0x3a, 0x14, 0xcb, 0x83, 0xfb, 0x7e, 0xf9, 0xea, 0x95, 0xff, 0xb0,
+	0xa6, 0x9e, 0xec, 0xe3, 0xbf, 0x03, 0x00, 0x5c, 0x86, 0xfa, 0xe8, 0x14, 0x04, 0x00, 0x00,
+}
+
+func (this *BreakLocksProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BreakLocksProposal)
+	if !ok {
+		that2, ok := that.(BreakLocksProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.LockIds) != len(that1.LockIds) {
+		return false
+	}
+	for i := range this.LockIds {
+		if this.LockIds[i] != that1.LockIds[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *Relock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Relock)
+	if !ok {
+		that2, ok := that.(Relock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LockId != that1.LockId {
+		return false
+	}
+	if len(this.NewCoins) != len(that1.NewCoins) {
+		return false
+	}
+	for i := range this.NewCoins {
+		if !this.NewCoins[i].Equal(&that1.NewCoins[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RelockProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RelockProposal)
+	if !ok {
+		that2, ok := that.(RelockProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Relocks) != len(that1.Relocks) {
+		return false
+	}
+	for i := range this.Relocks {
+		if !this.Relocks[i].Equal(&that1.Relocks[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *BreakLocksProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BreakLocksProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BreakLocksProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockIds) > 0 {
+		dAtA2 := make([]byte, len(m.LockIds)*10)
+		var j1 int
+		for _, num := range m.LockIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGov(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Relock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Relock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Relock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewCoins) > 0 {
+		for iNdEx := len(m.NewCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.LockId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelockProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelockProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelockProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relocks) > 0 {
+		for iNdEx := len(m.Relocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockBalanceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockBalanceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockBalanceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TakenFromOwner) > 0 {
+		for iNdEx := len(m.TakenFromOwner) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakenFromOwner[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SentToOwner) > 0 {
+		for iNdEx := len(m.SentToOwner) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SentToOwner[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LockId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BreakLocksProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.LockIds) > 0 {
+		l = 0
+		for _, e := range m.LockIds {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Relock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovGov(uint64(m.LockId))
+	}
+	if len(m.NewCoins) > 0 {
+		for _, e := range m.NewCoins {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RelockProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Relocks) > 0 {
+		for _, e := range m.Relocks {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockBalanceChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovGov(uint64(m.LockId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.SentToOwner) > 0 {
+		for _, e := range m.SentToOwner {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.TakenFromOwner) > 0 {
+		for _, e := range m.TakenFromOwner {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BreakLocksProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BreakLocksProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BreakLocksProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LockIds = append(m.LockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LockIds) == 0 {
+					m.LockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LockIds = append(m.LockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Relock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Relock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Relock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewCoins = append(m.NewCoins, types.Coin{})
+			if err := m.NewCoins[len(m.NewCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelockProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelockProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelockProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relocks = append(m.Relocks, Relock{})
+			if err := m.Relocks[len(m.Relocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockBalanceChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockBalanceChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockBalanceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentToOwner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentToOwner = append(m.SentToOwner, types.Coin{})
+			if err := m.SentToOwner[len(m.SentToOwner)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakenFromOwner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakenFromOwner = append(m.TakenFromOwner, types.Coin{})
+			if err := m.TakenFromOwner[len(m.TakenFromOwner)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
@@ -9,6 +9,9 @@ import (
 type LockupHooks interface {
 	OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
+	OnLockDurationExtended(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, oldDuration time.Duration, newDuration time.Duration)
+	OnLockTransferred(ctx sdk.Context, oldOwner sdk.AccAddress, newOwner sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
+	OnLockPenalized(ctx sdk.Context, address sdk.AccAddress, lockID uint64, penalty sdk.Coins)
 }
 
 var _ LockupHooks = MultiLockupHooks{}
@@ -31,3 +34,21 @@ func (h MultiLockupHooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddres
 		h[i].OnTokenUnlocked(ctx, address, lockID, amount, lockDuration, unlockTime)
 	}
 }
+
+func (h MultiLockupHooks) OnLockDurationExtended(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, oldDuration time.Duration, newDuration time.Duration) {
+	for i := range h {
+		h[i].OnLockDurationExtended(ctx, address, lockID, amount, oldDuration, newDuration)
+	}
+}
+
+func (h MultiLockupHooks) OnLockTransferred(ctx sdk.Context, oldOwner sdk.AccAddress, newOwner sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	for i := range h {
+		h[i].OnLockTransferred(ctx, oldOwner, newOwner, lockID, amount, lockDuration, unlockTime)
+	}
+}
+
+func (h MultiLockupHooks) OnLockPenalized(ctx sdk.Context, address sdk.AccAddress, lockID uint64, penalty sdk.Coins) {
+	for i := range h {
+		h[i].OnLockPenalized(ctx, address, lockID, penalty)
+	}
+}
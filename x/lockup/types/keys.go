@@ -55,6 +55,12 @@ var (
 	// KeyPrefixAccountDenomLockTimestamp defines prefix for the iteration of lock IDs by account, denomination and timestamp
 	KeyPrefixAccountDenomLockTimestamp = []byte{0x0E}
 
+	// KeyPrefixSyntheticLockup defines prefix to store synthetic lockups by underlying lock ID and suffix
+	KeyPrefixSyntheticLockup = []byte{0x10}
+
+	// KeyPrefixSyntheticLockTimestamp defines prefix for the iteration of unlocking synthetic lockups by end time
+	KeyPrefixSyntheticLockTimestamp = []byte{0x11}
+
 	// KeyPrefixLockAccumulation defines prefix for the lock accumulation store
 	KeyPrefixLockAccumulation = []byte{0x20}
 
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -34,3 +35,39 @@ func SumLocksByDenom(locks []PeriodLock, denom string) sdk.Int {
 	}
 	return sum
 }
+
+// SyntheticDenom returns the denom the synthetic lockups with suffix of the locks of denom are indexed under
+func SyntheticDenom(denom, suffix string) string {
+	return denom + "/" + suffix
+}
+
+// ValidateSyntheticSuffix checks that suffix can be appended to denoms as the last path segment of a synthetic denom
+func ValidateSyntheticSuffix(suffix string) error {
+	if suffix == "" || strings.Contains(suffix, "/") {
+		return fmt.Errorf("invalid synthetic lockup suffix: %q", suffix)
+	}
+	return sdk.ValidateDenom(SyntheticDenom("synthetic", suffix))
+}
+
+// IsUnlocking returns synthetic lockup started unlocking already
+func (s SyntheticLock) IsUnlocking() bool {
+	return !s.EndTime.Equal(time.Time{})
+}
+
+// SyntheticCoins returns coins renamed to the synthetic denoms of the synthetic lockup
+func (s SyntheticLock) SyntheticCoins(coins sdk.Coins) sdk.Coins {
+	synthCoins := make(sdk.Coins, 0, len(coins))
+	for _, coin := range coins {
+		synthCoins = append(synthCoins, sdk.Coin{Denom: SyntheticDenom(coin.Denom, s.Suffix), Amount: coin.Amount})
+	}
+	return synthCoins.Sort()
+}
+
+// PeriodLockView returns how the synthetic lockup of lock is seen by the queries of its synthetic denoms,
+// that is lock with the duration and end time of the synthetic lockup, and its coins renamed to the synthetic denoms
+func (s SyntheticLock) PeriodLockView(lock PeriodLock) PeriodLock {
+	lock.Duration = s.Duration
+	lock.EndTime = s.EndTime
+	lock.Coins = s.SyntheticCoins(lock.Coins)
+	return lock
+}
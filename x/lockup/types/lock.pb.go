@@ -204,49 +204,127 @@ func (m *QueryCondition) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
+// SyntheticLock is a secondary claim on the coins of a lock, such as their
+// staking, under a suffix of their denoms. It does not move any coins, but has
+// its own duration and end time, and is indexed and accumulated under the
+// synthetic denoms of the lock's coins, {denom}/{suffix}.
+type SyntheticLock struct {
+	UnderlyingLockId uint64        `protobuf:"varint,1,opt,name=underlying_lock_id,json=underlyingLockId,proto3" json:"underlying_lock_id,omitempty" yaml:"underlying_lock_id"`
+	Suffix           string        `protobuf:"bytes,2,opt,name=suffix,proto3" json:"suffix,omitempty" yaml:"suffix"`
+	Duration         time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+	EndTime          time.Time     `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+}
+
+func (m *SyntheticLock) Reset()         { *m = SyntheticLock{} }
+func (m *SyntheticLock) String() string { return proto.CompactTextString(m) }
+func (*SyntheticLock) ProtoMessage()    {}
+func (*SyntheticLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e9d7527a237b489, []int{2}
+}
+func (m *SyntheticLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyntheticLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyntheticLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyntheticLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyntheticLock.Merge(m, src)
+}
+func (m *SyntheticLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyntheticLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyntheticLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyntheticLock proto.InternalMessageInfo
+
+func (m *SyntheticLock) GetUnderlyingLockId() uint64 {
+	if m != nil {
+		return m.UnderlyingLockId
+	}
+	return 0
+}
+
+func (m *SyntheticLock) GetSuffix() string {
+	if m != nil {
+		return m.Suffix
+	}
+	return ""
+}
+
+func (m *SyntheticLock) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *SyntheticLock) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("osmosis.lockup.LockQueryType", LockQueryType_name, LockQueryType_value)
 	proto.RegisterType((*PeriodLock)(nil), "osmosis.lockup.PeriodLock")
 	proto.RegisterType((*QueryCondition)(nil), "osmosis.lockup.QueryCondition")
+	proto.RegisterType((*SyntheticLock)(nil), "osmosis.lockup.SyntheticLock")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/lock.proto", fileDescriptor_7e9d7527a237b489) }
 
 var fileDescriptor_7e9d7527a237b489 = []byte{
-	// 526 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xbf, 0x6f, 0xd4, 0x30,
-	0x14, 0xc7, 0x93, 0xeb, 0x5d, 0x69, 0x0d, 0x4d, 0x4f, 0x56, 0x87, 0xf4, 0x80, 0xe4, 0x94, 0x01,
-	0x9d, 0x10, 0xb5, 0xb9, 0xb2, 0x31, 0xa6, 0x87, 0x44, 0x25, 0x06, 0x88, 0x2a, 0x06, 0x96, 0x53,
-	0x7e, 0x98, 0x34, 0xba, 0x24, 0x0e, 0x71, 0x02, 0xe4, 0x3f, 0x60, 0xec, 0x08, 0x33, 0x1b, 0x7f,
-	0x49, 0xc7, 0x8e, 0x4c, 0x57, 0x74, 0x27, 0x16, 0xc6, 0xfe, 0x05, 0xc8, 0x76, 0x7c, 0xfd, 0x81,
-	0x90, 0x3a, 0x39, 0xcf, 0x5f, 0xbf, 0xef, 0x7b, 0xfe, 0x3c, 0x07, 0xec, 0x52, 0x96, 0x51, 0x96,
-	0x30, 0x9c, 0xd2, 0x70, 0x56, 0x17, 0x62, 0x41, 0x45, 0x49, 0x2b, 0x0a, 0x8d, 0x56, 0x42, 0x52,
-	0x1a, 0xec, 0xc4, 0x34, 0xa6, 0x42, 0xc2, 0xfc, 0x4b, 0x9e, 0x1a, 0x58, 0x31, 0xa5, 0x71, 0x4a,
-	0xb0, 0x88, 0x82, 0xfa, 0x3d, 0x8e, 0xea, 0xd2, 0xaf, 0x12, 0x9a, 0xb7, 0xba, 0x7d, 0x53, 0xaf,
-	0x92, 0x8c, 0xb0, 0xca, 0xcf, 0x0a, 0x65, 0x10, 0x8a, 0x3a, 0x38, 0xf0, 0x19, 0xc1, 0x1f, 0xc7,
-	0x01, 0xa9, 0xfc, 0x31, 0x0e, 0x69, 0xd2, 0x1a, 0x38, 0xbf, 0x3b, 0x00, 0xbc, 0x26, 0x65, 0x42,
-	0xa3, 0x57, 0x34, 0x9c, 0x41, 0x03, 0x74, 0x0e, 0x27, 0xa6, 0x3e, 0xd4, 0x47, 0x5d, 0xaf, 0x73,
-	0x38, 0x81, 0x8f, 0x40, 0x8f, 0x7e, 0xca, 0x49, 0x69, 0x76, 0x86, 0xfa, 0x68, 0xd3, 0xed, 0x5f,
-	0xcc, 0xed, 0x7b, 0x8d, 0x9f, 0xa5, 0xcf, 0x1d, 0xb1, 0xed, 0x78, 0x52, 0x86, 0xc7, 0x60, 0x43,
-	0x75, 0x66, 0xae, 0x0d, 0xf5, 0xd1, 0xdd, 0xfd, 0x5d, 0x24, 0x5b, 0x43, 0xaa, 0x35, 0x34, 0x69,
-	0x0f, 0xb8, 0xe3, 0xd3, 0xb9, 0xad, 0xfd, 0x99, 0xdb, 0x50, 0xa5, 0x3c, 0xa1, 0x59, 0x52, 0x91,
-	0xac, 0xa8, 0x9a, 0x8b, 0xb9, 0xbd, 0x2d, 0xfd, 0x95, 0xe6, 0x7c, 0x3d, 0xb7, 0x75, 0x6f, 0xe5,
-	0x0e, 0x3d, 0xb0, 0x41, 0xf2, 0x68, 0xca, 0xef, 0x69, 0x76, 0x45, 0xa5, 0xc1, 0x3f, 0x95, 0x8e,
-	0x14, 0x04, 0xf7, 0x3e, 0x2f, 0x75, 0x69, 0xaa, 0x32, 0x9d, 0x13, 0x6e, 0x7a, 0x87, 0xe4, 0x11,
-	0x3f, 0x0a, 0x7d, 0xd0, 0xe3, 0x48, 0x98, 0xd9, 0x1b, 0xae, 0x89, 0xd6, 0x25, 0x34, 0xc4, 0xa1,
-	0xa1, 0x16, 0x1a, 0x3a, 0xa0, 0x49, 0xee, 0x3e, 0xe5, 0x7e, 0x3f, 0xce, 0xed, 0x51, 0x9c, 0x54,
-	0xc7, 0x75, 0x80, 0x42, 0x9a, 0xe1, 0x96, 0xb0, 0x5c, 0xf6, 0x58, 0x34, 0xc3, 0x55, 0x53, 0x10,
-	0x26, 0x12, 0x98, 0x27, 0x9d, 0x9d, 0x6f, 0x1d, 0x60, 0xbc, 0xa9, 0x49, 0xd9, 0x1c, 0xd0, 0x3c,
-	0x4a, 0xc4, 0x4d, 0x5e, 0x80, 0x6d, 0x3e, 0xfb, 0xe9, 0x07, 0xbe, 0x3d, 0xe5, 0x39, 0x02, 0xbc,
-	0xb1, 0xff, 0x10, 0x5d, 0x7f, 0x1b, 0x88, 0x8f, 0x46, 0x24, 0x1f, 0x35, 0x05, 0xf1, 0xb6, 0xd2,
-	0xab, 0x21, 0xdc, 0x01, 0xbd, 0x88, 0xe4, 0x34, 0x93, 0x23, 0xf2, 0x64, 0xc0, 0x31, 0xdd, 0x7e,
-	0x20, 0x37, 0x28, 0xfd, 0x0f, 0xfd, 0x5b, 0xb0, 0xb9, 0x7a, 0x5e, 0xb7, 0x60, 0xff, 0xa0, 0x75,
-	0xed, 0x4b, 0xd7, 0x55, 0xaa, 0x84, 0x7f, 0x69, 0xf5, 0x78, 0x0c, 0xb6, 0xae, 0xdd, 0x10, 0x1a,
-	0x00, 0xb8, 0x8d, 0xea, 0xae, 0xaf, 0x41, 0x00, 0xd6, 0xdd, 0x86, 0x1b, 0xf7, 0xf5, 0x41, 0xf7,
-	0xcb, 0x77, 0x4b, 0x73, 0x5f, 0x9e, 0x2e, 0x2c, 0xfd, 0x6c, 0x61, 0xe9, 0xbf, 0x16, 0x96, 0x7e,
-	0xb2, 0xb4, 0xb4, 0xb3, 0xa5, 0xa5, 0xfd, 0x5c, 0x5a, 0xda, 0x3b, 0x74, 0x65, 0x32, 0x2d, 0xc6,
-	0xbd, 0xd4, 0x0f, 0x98, 0x0a, 0xf0, 0x67, 0xf5, 0x33, 0x8a, 0x29, 0x05, 0xeb, 0xa2, 0xf3, 0x67,
-	0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x39, 0x65, 0xdc, 0x39, 0xab, 0x03, 0x00, 0x00,
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xb6, 0xdd, 0xa6, 0xb4, 0x07, 0x49, 0xc3, 0xa9, 0x43, 0x1a, 0xa8, 0x1d, 0x79, 0x40, 0x01,
+	0xd1, 0x33, 0x29, 0x1b, 0xa3, 0x5b, 0x24, 0x2a, 0x18, 0xc0, 0x54, 0x0c, 0x2c, 0x91, 0x7f, 0x5c,
+	0x9c, 0x53, 0x6c, 0x9f, 0xf1, 0x0f, 0xa8, 0xff, 0x03, 0xc6, 0x8e, 0x30, 0xb3, 0xf1, 0x7f, 0x20,
+	0x75, 0xec, 0xc8, 0xe4, 0xa2, 0x44, 0x2c, 0x8c, 0xf9, 0x0b, 0xd0, 0xdd, 0xd9, 0x49, 0x7f, 0x08,
+	0xa9, 0x2b, 0x93, 0xf3, 0xde, 0xf7, 0xde, 0xf7, 0xde, 0x7d, 0xef, 0x53, 0xc0, 0x36, 0x4d, 0x43,
+	0x9a, 0x92, 0xd4, 0x08, 0xa8, 0x3b, 0xc9, 0x63, 0xfe, 0x41, 0x71, 0x42, 0x33, 0x0a, 0x5b, 0x15,
+	0x84, 0x04, 0xd4, 0xdd, 0xf2, 0xa9, 0x4f, 0x39, 0x64, 0xb0, 0x5f, 0xa2, 0xaa, 0xab, 0xfa, 0x94,
+	0xfa, 0x01, 0x36, 0x78, 0xe4, 0xe4, 0x23, 0xc3, 0xcb, 0x13, 0x3b, 0x23, 0x34, 0xaa, 0x70, 0xed,
+	0x2a, 0x9e, 0x91, 0x10, 0xa7, 0x99, 0x1d, 0xc6, 0x35, 0x81, 0xcb, 0xe7, 0x18, 0x8e, 0x9d, 0x62,
+	0xe3, 0xe3, 0xc0, 0xc1, 0x99, 0x3d, 0x30, 0x5c, 0x4a, 0x2a, 0x02, 0xfd, 0xb7, 0x02, 0xc0, 0x6b,
+	0x9c, 0x10, 0xea, 0xbd, 0xa2, 0xee, 0x04, 0xb6, 0x80, 0x72, 0x78, 0xd0, 0x91, 0x7b, 0x72, 0x7f,
+	0xd5, 0x52, 0x0e, 0x0f, 0xe0, 0x03, 0xd0, 0xa0, 0x9f, 0x22, 0x9c, 0x74, 0x94, 0x9e, 0xdc, 0xdf,
+	0x30, 0xdb, 0xf3, 0x52, 0xbb, 0x53, 0xd8, 0x61, 0xf0, 0x4c, 0xe7, 0x69, 0xdd, 0x12, 0x30, 0x1c,
+	0x83, 0xf5, 0x7a, 0xb3, 0xce, 0x4a, 0x4f, 0xee, 0xdf, 0xde, 0xdb, 0x46, 0x62, 0x35, 0x54, 0xaf,
+	0x86, 0x0e, 0xaa, 0x02, 0x73, 0x70, 0x5a, 0x6a, 0xd2, 0x9f, 0x52, 0x83, 0x75, 0xcb, 0x63, 0x1a,
+	0x92, 0x0c, 0x87, 0x71, 0x56, 0xcc, 0x4b, 0x6d, 0x53, 0xf0, 0xd7, 0x98, 0xfe, 0xe5, 0x5c, 0x93,
+	0xad, 0x05, 0x3b, 0xb4, 0xc0, 0x3a, 0x8e, 0xbc, 0x21, 0x7b, 0x67, 0x67, 0x95, 0x4f, 0xea, 0x5e,
+	0x9b, 0x74, 0x54, 0x8b, 0x60, 0xde, 0x63, 0xa3, 0x96, 0xa4, 0x75, 0xa7, 0x7e, 0xc2, 0x48, 0x6f,
+	0xe1, 0xc8, 0x63, 0xa5, 0xd0, 0x06, 0x0d, 0x26, 0x49, 0xda, 0x69, 0xf4, 0x56, 0xf8, 0xea, 0x42,
+	0x34, 0xc4, 0x44, 0x43, 0x95, 0x68, 0x68, 0x9f, 0x92, 0xc8, 0x7c, 0xc2, 0xf8, 0xbe, 0x9f, 0x6b,
+	0x7d, 0x9f, 0x64, 0xe3, 0xdc, 0x41, 0x2e, 0x0d, 0x8d, 0x4a, 0x61, 0xf1, 0xd9, 0x4d, 0xbd, 0x89,
+	0x91, 0x15, 0x31, 0x4e, 0x79, 0x43, 0x6a, 0x09, 0x66, 0xfd, 0xab, 0x02, 0x5a, 0x6f, 0x72, 0x9c,
+	0x14, 0xfb, 0x34, 0xf2, 0x08, 0x7f, 0xc9, 0x73, 0xb0, 0xc9, 0x6e, 0x3f, 0xfc, 0xc0, 0xd2, 0x43,
+	0xd6, 0xc3, 0x85, 0x6f, 0xed, 0xed, 0xa0, 0xcb, 0xde, 0x40, 0xec, 0x34, 0xbc, 0xf9, 0xa8, 0x88,
+	0xb1, 0xd5, 0x0c, 0x2e, 0x86, 0x70, 0x0b, 0x34, 0x3c, 0x1c, 0xd1, 0x50, 0x9c, 0xc8, 0x12, 0x01,
+	0x93, 0xe9, 0xe6, 0x07, 0xb9, 0xa2, 0xd2, 0xbf, 0xa4, 0x7f, 0x07, 0x36, 0x16, 0xf6, 0xba, 0x81,
+	0xf6, 0xf7, 0x2b, 0xd6, 0xb6, 0x60, 0x5d, 0xb4, 0x0a, 0xf1, 0x97, 0x54, 0xfa, 0x0f, 0x05, 0x34,
+	0xdf, 0x16, 0x51, 0x36, 0xc6, 0x19, 0x71, 0xb9, 0x0d, 0x5f, 0x02, 0x98, 0x47, 0x1e, 0x4e, 0x82,
+	0x82, 0x44, 0xfe, 0x90, 0xab, 0x44, 0x3c, 0x61, 0x4b, 0x73, 0x67, 0x5e, 0x6a, 0xdb, 0x82, 0xf2,
+	0x7a, 0x8d, 0x6e, 0xb5, 0x97, 0x49, 0x46, 0x75, 0xe8, 0xc1, 0x87, 0x60, 0x2d, 0xcd, 0x47, 0x23,
+	0x72, 0x5c, 0x99, 0xf8, 0xee, 0xbc, 0xd4, 0x9a, 0x82, 0x40, 0xe4, 0x75, 0xab, 0x2a, 0xf8, 0xbf,
+	0x6d, 0xfc, 0x68, 0x00, 0x9a, 0x97, 0x9c, 0x02, 0x5b, 0x00, 0x98, 0x45, 0xbd, 0x6f, 0x5b, 0x82,
+	0x00, 0xac, 0x99, 0x05, 0x2b, 0x6d, 0xcb, 0xdd, 0xd5, 0xcf, 0xdf, 0x54, 0xc9, 0x7c, 0x71, 0x3a,
+	0x55, 0xe5, 0xb3, 0xa9, 0x2a, 0xff, 0x9a, 0xaa, 0xf2, 0xc9, 0x4c, 0x95, 0xce, 0x66, 0xaa, 0xf4,
+	0x73, 0xa6, 0x4a, 0xef, 0xd1, 0x05, 0x87, 0x57, 0x76, 0xdc, 0x0d, 0x6c, 0x27, 0xad, 0x03, 0xe3,
+	0xb8, 0xfe, 0x53, 0xe3, 0x6e, 0x77, 0xd6, 0xf8, 0xda, 0x4f, 0xff, 0x0e, 0x00, 0x85, 0x16, 0x33,
+	0xd3, 0xf3, 0x04, 0x00, 0x00,
 }
 
 func (m *PeriodLock) Marshal() (dAtA []byte, err error) {
@@ -365,6 +443,57 @@ func (m *QueryCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyntheticLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyntheticLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyntheticLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintLock(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintLock(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Suffix) > 0 {
+		i -= len(m.Suffix)
+		copy(dAtA[i:], m.Suffix)
+		i = encodeVarintLock(dAtA, i, uint64(len(m.Suffix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UnderlyingLockId != 0 {
+		i = encodeVarintLock(dAtA, i, uint64(m.UnderlyingLockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLock(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLock(v)
 	base := offset
@@ -422,6 +551,26 @@ func (m *QueryCondition) Size() (n int) {
 	return n
 }
 
+func (m *SyntheticLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnderlyingLockId != 0 {
+		n += 1 + sovLock(uint64(m.UnderlyingLockId))
+	}
+	l = len(m.Suffix)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovLock(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovLock(uint64(l))
+	return n
+}
+
 func sovLock(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -796,6 +945,173 @@ func (m *QueryCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyntheticLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyntheticLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyntheticLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnderlyingLockId", wireType)
+			}
+			m.UnderlyingLockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnderlyingLockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suffix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suffix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLock(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,6 +12,9 @@ const (
 	TypeMsgLockTokens        = "lock_tokens"
 	TypeMsgBeginUnlockingAll = "begin_unlocking_all"
 	TypeMsgBeginUnlocking    = "begin_unlocking"
+	TypeMsgExtendLockup      = "extend_lockup"
+	TypeMsgTransferLock      = "transfer_lock"
+	TypeMsgForceUnlock       = "force_unlock"
 )
 
 var _ sdk.Msg = &MsgLockTokens{}
@@ -65,17 +68,22 @@ func (m MsgBeginUnlockingAll) GetSigners() []sdk.AccAddress {
 
 var _ sdk.Msg = &MsgBeginUnlocking{}
 
-// NewMsgBeginUnlocking creates a message to begin unlocking the tokens of a specific lock
-func NewMsgBeginUnlocking(owner sdk.AccAddress, id uint64) *MsgBeginUnlocking {
+// NewMsgBeginUnlocking creates a message to begin unlocking the tokens of a specific lock.
+// Only coins are unlocked out of the lock if they are set.
+func NewMsgBeginUnlocking(owner sdk.AccAddress, id uint64, coins sdk.Coins) *MsgBeginUnlocking {
 	return &MsgBeginUnlocking{
 		Owner: owner.String(),
 		ID:    id,
+		Coins: coins,
 	}
 }
 
 func (m MsgBeginUnlocking) Route() string { return RouterKey }
 func (m MsgBeginUnlocking) Type() string  { return TypeMsgBeginUnlocking }
 func (m MsgBeginUnlocking) ValidateBasic() error {
+	if !m.Coins.Empty() && !m.Coins.IsValid() {
+		return fmt.Errorf("invalid coins to unlock: %s", m.Coins)
+	}
 	return nil
 }
 func (m MsgBeginUnlocking) GetSignBytes() []byte {
@@ -85,3 +93,83 @@ func (m MsgBeginUnlocking) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgExtendLockup{}
+
+// NewMsgExtendLockup creates a message to extend the duration of a specific lock
+func NewMsgExtendLockup(owner sdk.AccAddress, id uint64, duration time.Duration) *MsgExtendLockup {
+	return &MsgExtendLockup{
+		Owner:    owner.String(),
+		ID:       id,
+		Duration: duration,
+	}
+}
+
+func (m MsgExtendLockup) Route() string { return RouterKey }
+func (m MsgExtendLockup) Type() string  { return TypeMsgExtendLockup }
+func (m MsgExtendLockup) ValidateBasic() error {
+	if m.Duration <= 0 {
+		return fmt.Errorf("duration should be positive: %d < 0", m.Duration)
+	}
+	return nil
+}
+func (m MsgExtendLockup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+func (m MsgExtendLockup) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgTransferLock{}
+
+// NewMsgTransferLock creates a message to transfer a specific lock to a new owner
+func NewMsgTransferLock(owner sdk.AccAddress, id uint64, newOwner sdk.AccAddress) *MsgTransferLock {
+	return &MsgTransferLock{
+		Owner:    owner.String(),
+		ID:       id,
+		NewOwner: newOwner.String(),
+	}
+}
+
+func (m MsgTransferLock) Route() string { return RouterKey }
+func (m MsgTransferLock) Type() string  { return TypeMsgTransferLock }
+func (m MsgTransferLock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.NewOwner); err != nil {
+		return fmt.Errorf("invalid new owner address (%s)", err)
+	}
+	if m.NewOwner == m.Owner {
+		return fmt.Errorf("new owner should be different from the owner")
+	}
+	return nil
+}
+func (m MsgTransferLock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+func (m MsgTransferLock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgForceUnlock{}
+
+// NewMsgForceUnlock creates a message to force unlock a specific lock for a penalty
+func NewMsgForceUnlock(owner sdk.AccAddress, id uint64) *MsgForceUnlock {
+	return &MsgForceUnlock{
+		Owner: owner.String(),
+		ID:    id,
+	}
+}
+
+func (m MsgForceUnlock) Route() string { return RouterKey }
+func (m MsgForceUnlock) Type() string  { return TypeMsgForceUnlock }
+func (m MsgForceUnlock) ValidateBasic() error {
+	return nil
+}
+func (m MsgForceUnlock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+func (m MsgForceUnlock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
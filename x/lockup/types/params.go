@@ -0,0 +1,174 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyRestrictLockTransfers = []byte("RestrictLockTransfers")
+	KeyTransferableDenoms    = []byte("TransferableDenoms")
+
+	KeyForceUnlockPenalty            = []byte("ForceUnlockPenalty")
+	KeyForceUnlockPenaltyDestination = []byte("ForceUnlockPenaltyDestination")
+)
+
+// ParamTable for lockup module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(restrictLockTransfers bool, transferableDenoms []string, forceUnlockPenalty sdk.Dec, forceUnlockPenaltyDestination PenaltyDestination) Params {
+	return Params{
+		RestrictLockTransfers:         restrictLockTransfers,
+		TransferableDenoms:            transferableDenoms,
+		ForceUnlockPenalty:            forceUnlockPenalty,
+		ForceUnlockPenaltyDestination: forceUnlockPenaltyDestination,
+	}
+}
+
+// default lockup module parameters
+func DefaultParams() Params {
+	return Params{
+		RestrictLockTransfers:         false,
+		TransferableDenoms:            []string{},
+		ForceUnlockPenalty:            sdk.NewDecWithPrec(25, 2), // 25%
+		ForceUnlockPenaltyDestination: PenaltyToCommunityPool,
+	}
+}
+
+// validate params
+func (p Params) Validate() error {
+	if err := validateRestrictLockTransfers(p.RestrictLockTransfers); err != nil {
+		return err
+	}
+	if err := validateTransferableDenoms(p.TransferableDenoms); err != nil {
+		return err
+	}
+	if err := validateForceUnlockPenalty(p.ForceUnlockPenalty); err != nil {
+		return err
+	}
+	if err := validateForceUnlockPenaltyDestination(p.ForceUnlockPenaltyDestination); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IsTransferable returns whether a lock of coins can be transferred
+func (p Params) IsTransferable(coins sdk.Coins) bool {
+	if !p.RestrictLockTransfers {
+		return true
+	}
+	for _, coin := range coins {
+		transferable := false
+		for _, denom := range p.TransferableDenoms {
+			if coin.Denom == denom {
+				transferable = true
+				break
+			}
+		}
+		if !transferable {
+			return false
+		}
+	}
+	return true
+}
+
+// ForceUnlockPenaltyOf returns the penalty to force unlock lock at blockTime.
+// It is the force unlock penalty rate of its coins, scaled by the time left before the lock is unlocked
+// relative to its duration, so a lock which hasn't started unlocking pays the full rate.
+func (p Params) ForceUnlockPenaltyOf(lock PeriodLock, blockTime time.Time) sdk.Coins {
+	if lock.Duration <= 0 {
+		return sdk.Coins{}
+	}
+
+	timeLeft := lock.Duration
+	if lock.IsUnlocking() {
+		timeLeft = lock.EndTime.Sub(blockTime)
+		if timeLeft <= 0 {
+			return sdk.Coins{}
+		}
+		if timeLeft > lock.Duration {
+			timeLeft = lock.Duration
+		}
+	}
+
+	rate := p.ForceUnlockPenalty.MulInt64(int64(timeLeft)).QuoInt64(int64(lock.Duration))
+	penalty := sdk.Coins{}
+	for _, coin := range lock.Coins {
+		amount := rate.MulInt(coin.Amount).TruncateInt()
+		if amount.IsPositive() {
+			penalty = penalty.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return penalty
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRestrictLockTransfers, &p.RestrictLockTransfers, validateRestrictLockTransfers),
+		paramtypes.NewParamSetPair(KeyTransferableDenoms, &p.TransferableDenoms, validateTransferableDenoms),
+		paramtypes.NewParamSetPair(KeyForceUnlockPenalty, &p.ForceUnlockPenalty, validateForceUnlockPenalty),
+		paramtypes.NewParamSetPair(KeyForceUnlockPenaltyDestination, &p.ForceUnlockPenaltyDestination, validateForceUnlockPenaltyDestination),
+	}
+}
+
+func validateRestrictLockTransfers(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateTransferableDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seenDenoms[denom] {
+			return fmt.Errorf("duplicate transferable denom %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	return nil
+}
+
+func validateForceUnlockPenalty(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("force unlock penalty must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateForceUnlockPenaltyDestination(i interface{}) error {
+	v, ok := i.(PenaltyDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := PenaltyDestination_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown force unlock penalty destination: %d", v)
+	}
+
+	return nil
+}
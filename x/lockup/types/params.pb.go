@@ -0,0 +1,493 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/lockup/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PenaltyDestination is where the force unlock penalties are sent.
+type PenaltyDestination int32
+
+const (
+	PenaltyToCommunityPool PenaltyDestination = 0
+	PenaltyBurned          PenaltyDestination = 1
+)
+
+var PenaltyDestination_name = map[int32]string{
+	0: "PenaltyToCommunityPool",
+	1: "PenaltyBurned",
+}
+
+var PenaltyDestination_value = map[string]int32{
+	"PenaltyToCommunityPool": 0,
+	"PenaltyBurned":          1,
+}
+
+func (x PenaltyDestination) String() string {
+	return proto.EnumName(PenaltyDestination_name, int32(x))
+}
+
+func (PenaltyDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4595e58f5e17053c, []int{0}
+}
+
+// Params holds parameters for the lockup module
+type Params struct {
+	// only the locks of transferable denoms can be transferred if set
+	RestrictLockTransfers bool `protobuf:"varint,1,opt,name=restrict_lock_transfers,json=restrictLockTransfers,proto3" json:"restrict_lock_transfers,omitempty" yaml:"restrict_lock_transfers"`
+	// denoms of the locks which can be transferred when lock transfers are
+	// restricted
+	TransferableDenoms []string `protobuf:"bytes,2,rep,name=transferable_denoms,json=transferableDenoms,proto3" json:"transferable_denoms,omitempty" yaml:"transferable_denoms"`
+	// fraction of the coins of a lock paid as penalty to force unlock it before
+	// it started unlocking, the penalty decreases with the time left once it
+	// started unlocking
+	ForceUnlockPenalty            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=force_unlock_penalty,json=forceUnlockPenalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"force_unlock_penalty" yaml:"force_unlock_penalty"`
+	ForceUnlockPenaltyDestination PenaltyDestination                     `protobuf:"varint,4,opt,name=force_unlock_penalty_destination,json=forceUnlockPenaltyDestination,proto3,enum=osmosis.lockup.PenaltyDestination" json:"force_unlock_penalty_destination,omitempty" yaml:"force_unlock_penalty_destination"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4595e58f5e17053c, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRestrictLockTransfers() bool {
+	if m != nil {
+		return m.RestrictLockTransfers
+	}
+	return false
+}
+
+func (m *Params) GetTransferableDenoms() []string {
+	if m != nil {
+		return m.TransferableDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetForceUnlockPenaltyDestination() PenaltyDestination {
+	if m != nil {
+		return m.ForceUnlockPenaltyDestination
+	}
+	return PenaltyToCommunityPool
+}
+
+func init() {
+	proto.RegisterEnum("osmosis.lockup.PenaltyDestination", PenaltyDestination_name, PenaltyDestination_value)
+	proto.RegisterType((*Params)(nil), "osmosis.lockup.Params")
+}
+
+func init() { proto.RegisterFile("osmosis/lockup/params.proto", fileDescriptor_4595e58f5e17053c) }
+
+var fileDescriptor_4595e58f5e17053c = []byte{
+	// 414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0xa4, 0xaa, 0xe8, 0x49, 0x54, 0xe5, 0x28, 0x10, 0xa5, 0xe2, 0x6c, 0xdd, 0x00,
+	0x11, 0xa8, 0xb6, 0x04, 0x1b, 0xa3, 0xc9, 0x00, 0x12, 0x88, 0x28, 0x2a, 0x4b, 0x17, 0xeb, 0xe2,
+	0x5c, 0x83, 0x15, 0xfb, 0x9e, 0x75, 0x77, 0x96, 0xf0, 0xc4, 0xca, 0xc8, 0xc8, 0xce, 0x97, 0xe9,
+	0x58, 0x36, 0xc4, 0x60, 0xa1, 0xe4, 0x1b, 0xf8, 0x13, 0xa0, 0x9e, 0x6d, 0xe1, 0xaa, 0xc9, 0x64,
+	0xfb, 0xf7, 0xff, 0xbf, 0xbf, 0xdf, 0xbd, 0x7b, 0xf8, 0x04, 0x74, 0x06, 0x3a, 0xd1, 0x41, 0x0a,
+	0xf1, 0xaa, 0xc8, 0x83, 0x9c, 0x2b, 0x9e, 0x69, 0x3f, 0x57, 0x60, 0x80, 0x1c, 0xb6, 0xa2, 0xdf,
+	0x88, 0xa3, 0xe3, 0x25, 0x2c, 0xc1, 0x4a, 0xc1, 0xf5, 0x5b, 0xe3, 0x62, 0xbf, 0x06, 0x78, 0x7f,
+	0x6a, 0xcb, 0xc8, 0x39, 0x7e, 0xac, 0x84, 0x36, 0x2a, 0x89, 0x4d, 0x74, 0x5d, 0x13, 0x19, 0xc5,
+	0xa5, 0xbe, 0x10, 0x4a, 0x0f, 0x91, 0x87, 0xc6, 0x77, 0x43, 0x56, 0x57, 0x2e, 0x2d, 0x79, 0x96,
+	0xbe, 0x66, 0x3b, 0x8c, 0x6c, 0xf6, 0xb0, 0x53, 0xde, 0x43, 0xbc, 0x3a, 0xeb, 0x38, 0xf9, 0x88,
+	0x1f, 0x74, 0x26, 0x3e, 0x4f, 0x45, 0xb4, 0x10, 0x12, 0x32, 0x3d, 0xbc, 0xe3, 0x0d, 0xc6, 0x07,
+	0x21, 0xad, 0x2b, 0x77, 0xd4, 0xe4, 0x6e, 0x31, 0xb1, 0x19, 0xe9, 0xd3, 0x89, 0x85, 0xe4, 0x2b,
+	0x3e, 0xbe, 0x00, 0x15, 0x8b, 0xa8, 0x90, 0xb6, 0x85, 0x5c, 0x48, 0x9e, 0x9a, 0x72, 0x38, 0xf0,
+	0xd0, 0xf8, 0x20, 0xfc, 0x70, 0x59, 0xb9, 0xce, 0x9f, 0xca, 0x7d, 0xba, 0x4c, 0xcc, 0xe7, 0x62,
+	0xee, 0xc7, 0x90, 0x05, 0xb1, 0x9d, 0x47, 0xfb, 0x38, 0xd5, 0x8b, 0x55, 0x60, 0xca, 0x5c, 0x68,
+	0x7f, 0x22, 0xe2, 0xba, 0x72, 0x4f, 0x9a, 0xff, 0x6f, 0xcb, 0x64, 0x33, 0x62, 0xf1, 0x27, 0x4b,
+	0xa7, 0x0d, 0x24, 0x3f, 0x10, 0xf6, 0xb6, 0xb9, 0xa3, 0x85, 0xd0, 0x26, 0x91, 0xdc, 0x24, 0x20,
+	0x87, 0x7b, 0x1e, 0x1a, 0x1f, 0xbe, 0x64, 0xfe, 0xcd, 0xab, 0xf0, 0xdb, 0x8c, 0xc9, 0x7f, 0x67,
+	0xf8, 0xa2, 0xae, 0xdc, 0x67, 0xbb, 0x7b, 0xe8, 0xa7, 0xb2, 0xd9, 0x93, 0xdb, 0xfd, 0xf4, 0xb2,
+	0x9e, 0xbf, 0xc3, 0xe4, 0x36, 0x25, 0x23, 0xfc, 0xa8, 0xa5, 0x67, 0xf0, 0x06, 0xb2, 0xac, 0x90,
+	0x89, 0x29, 0xa7, 0x00, 0xe9, 0x91, 0x43, 0xee, 0xe3, 0x7b, 0xad, 0x16, 0x16, 0x4a, 0x8a, 0xc5,
+	0x11, 0x1a, 0xed, 0x7d, 0xfb, 0x49, 0x9d, 0xf0, 0xed, 0xe5, 0x9a, 0xa2, 0xab, 0x35, 0x45, 0x7f,
+	0xd7, 0x14, 0x7d, 0xdf, 0x50, 0xe7, 0x6a, 0x43, 0x9d, 0xdf, 0x1b, 0xea, 0x9c, 0xfb, 0xbd, 0xd1,
+	0xb6, 0xc7, 0x3b, 0x4d, 0xf9, 0x5c, 0x77, 0x1f, 0xc1, 0x97, 0x6e, 0x2b, 0xed, 0x98, 0xe7, 0xfb,
+	0x76, 0xdf, 0x5e, 0xfd, 0x1b, 0x00, 0xd7, 0xcc, 0x66, 0xce, 0xb4, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForceUnlockPenaltyDestination != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ForceUnlockPenaltyDestination))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ForceUnlockPenalty.Size()
+		i -= size
+		if _, err := m.ForceUnlockPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TransferableDenoms) > 0 {
+		for iNdEx := len(m.TransferableDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TransferableDenoms[iNdEx])
+			copy(dAtA[i:], m.TransferableDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.TransferableDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.RestrictLockTransfers {
+		i--
+		if m.RestrictLockTransfers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RestrictLockTransfers {
+		n += 2
+	}
+	if len(m.TransferableDenoms) > 0 {
+		for _, s := range m.TransferableDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.ForceUnlockPenalty.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ForceUnlockPenaltyDestination != 0 {
+		n += 1 + sovParams(uint64(m.ForceUnlockPenaltyDestination))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictLockTransfers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestrictLockTransfers = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferableDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferableDenoms = append(m.TransferableDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceUnlockPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForceUnlockPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceUnlockPenaltyDestination", wireType)
+			}
+			m.ForceUnlockPenaltyDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForceUnlockPenaltyDestination |= PenaltyDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
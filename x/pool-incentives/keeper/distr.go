@@ -195,3 +195,34 @@ func (k Keeper) UpdateDistrRecords(ctx sdk.Context, records ...types.DistrRecord
 	})
 	return nil
 }
+
+// MigrateDistrRecords moves the distribution records of the gauges of a pool to the gauges of the pool
+// its liquidity was migrated to, for the same lockable durations.
+func (k Keeper) MigrateDistrRecords(ctx sdk.Context, oldPoolId uint64, newPoolId uint64) error {
+	gaugeIds := make(map[uint64]uint64)
+	for _, duration := range k.GetLockableDurations(ctx) {
+		oldGaugeId, err := k.GetPoolGaugeId(ctx, oldPoolId, duration)
+		if err != nil {
+			continue
+		}
+		newGaugeId, err := k.GetPoolGaugeId(ctx, newPoolId, duration)
+		if err != nil {
+			return err
+		}
+		gaugeIds[oldGaugeId] = newGaugeId
+	}
+
+	distrInfo := k.GetDistrInfo(ctx)
+	for i, record := range distrInfo.Records {
+		if newGaugeId, ok := gaugeIds[record.GaugeId]; ok {
+			distrInfo.Records[i].GaugeId = newGaugeId
+		}
+	}
+
+	sort.SliceStable(distrInfo.Records, func(i, j int) bool {
+		return distrInfo.Records[i].GaugeId < distrInfo.Records[j].GaugeId
+	})
+
+	k.SetDistrInfo(ctx, distrInfo)
+	return nil
+}
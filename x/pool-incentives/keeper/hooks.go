@@ -38,6 +38,14 @@ func (h Hooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64,
 
 }
 
+// AfterPoolMigrated moves the distribution records of the old pool gauges to the new pool gauges
+func (h Hooks) AfterPoolMigrated(ctx sdk.Context, oldPoolId uint64, newPoolId uint64) {
+	err := h.k.MigrateDistrRecords(ctx, oldPoolId, newPoolId)
+	if err != nil {
+		panic(err)
+	}
+}
+
 // Distribute coins after minter module allocate assets to pool-incentives module
 func (h Hooks) AfterDistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) {
 	// @Sunny, @Tony, @Dev, what comments should we keep after modifying own BeginBlocker to hooks?
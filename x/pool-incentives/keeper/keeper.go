@@ -89,6 +89,28 @@ func (k Keeper) CreatePoolGauges(ctx sdk.Context, poolId uint64) error {
 	return nil
 }
 
+// AddToPoolGauge adds coins from sender to the rewards of the gauge of the pool for the longest lockable duration.
+func (k Keeper) AddToPoolGauge(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, coins sdk.Coins) error {
+	lockableDurations := k.GetLockableDurations(ctx)
+	if len(lockableDurations) == 0 {
+		return sdkerrors.Wrapf(types.ErrNoGaugeIdExist, "no lockable durations to incentivize pool (%d) with", poolId)
+	}
+
+	longestDuration := lockableDurations[0]
+	for _, lockableDuration := range lockableDurations[1:] {
+		if lockableDuration > longestDuration {
+			longestDuration = lockableDuration
+		}
+	}
+
+	gaugeId, err := k.GetPoolGaugeId(ctx, poolId, longestDuration)
+	if err != nil {
+		return err
+	}
+
+	return k.incentivesKeeper.AddToGaugeRewards(ctx, sender, coins, gaugeId)
+}
+
 func (k Keeper) SetPoolGaugeId(ctx sdk.Context, poolId uint64, lockableDuration time.Duration, gaugeId uint64) {
 	key := types.GetPoolGaugeIdStoreKey(poolId, lockableDuration)
 	store := ctx.KVStore(k.storeKey)
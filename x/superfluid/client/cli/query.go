@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string) *cobra.Command {
+	// Group superfluid queries under a subcommand
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdParams(),
+		GetCmdAllAssets(),
+		GetCmdAssetMultiplier(),
+		GetCmdLockSuperfluidStake(),
+	)
+
+	return cmd
+}
+
+// GetCmdParams returns the params of the module
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query superfluid params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query superfluid params.
+
+Example:
+$ %s query superfluid params
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAllAssets returns the approved superfluid assets
+func GetCmdAllAssets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-assets",
+		Short: "Query all superfluid assets",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all superfluid assets.
+
+Example:
+$ %s query superfluid all-assets
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllAssets(cmd.Context(), &types.AllAssetsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAssetMultiplier returns the OSMO equivalent multiplier of a superfluid asset
+func GetCmdAssetMultiplier() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "asset-multiplier <denom>",
+		Short: "Query the OSMO equivalent multiplier of a superfluid asset",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the OSMO equivalent multiplier of a superfluid asset.
+
+Example:
+$ %s query superfluid asset-multiplier gamm/pool/1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AssetMultiplier(cmd.Context(), &types.AssetMultiplierRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdLockSuperfluidStake returns the synthetic stake of a superfluid staked lock
+func GetCmdLockSuperfluidStake() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-superfluid-stake <lock-id>",
+		Short: "Query the synthetic stake of a superfluid staked lock",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the synthetic stake of a superfluid staked lock.
+
+Example:
+$ %s query superfluid lock-superfluid-stake 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lockId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.LockSuperfluidStake(cmd.Context(), &types.LockSuperfluidStakeRequest{LockId: lockId})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,106 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+)
+
+type SetSuperfluidAssetsRequest struct {
+	BaseReq     rest.BaseReq            `json:"base_req" yaml:"base_req"`
+	Title       string                  `json:"title" yaml:"title"`
+	Description string                  `json:"description" yaml:"description"`
+	Deposit     sdk.Coins               `json:"deposit" yaml:"deposit"`
+	Assets      []types.SuperfluidAsset `json:"assets" yaml:"assets"`
+}
+
+func ProposalSetSuperfluidAssetsRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set-superfluid-assets",
+		Handler:  newSetSuperfluidAssetsHandler(clientCtx),
+	}
+}
+
+func newSetSuperfluidAssetsHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetSuperfluidAssetsRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewSetSuperfluidAssetsProposal(req.Title, req.Description, req.Assets)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type RemoveSuperfluidAssetsRequest struct {
+	BaseReq               rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title                 string       `json:"title" yaml:"title"`
+	Description           string       `json:"description" yaml:"description"`
+	Deposit               sdk.Coins    `json:"deposit" yaml:"deposit"`
+	SuperfluidAssetDenoms []string     `json:"superfluid_asset_denoms" yaml:"superfluid_asset_denoms"`
+}
+
+func ProposalRemoveSuperfluidAssetsRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove-superfluid-assets",
+		Handler:  newRemoveSuperfluidAssetsHandler(clientCtx),
+	}
+}
+
+func newRemoveSuperfluidAssetsHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RemoveSuperfluidAssetsRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewRemoveSuperfluidAssetsProposal(req.Title, req.Description, req.SuperfluidAssetDenoms)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -0,0 +1,38 @@
+package superfluid
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/superfluid/keeper"
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+)
+
+// InitGenesis initializes the superfluid module's state from a provided genesis state.
+// The synthetic delegations themselves live in the staking module's state, so only the records are restored.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	for _, asset := range genState.SuperfluidAssets {
+		k.SetSuperfluidAsset(ctx, asset)
+	}
+	for _, multiplier := range genState.OsmoEquivalentMultipliers {
+		k.SetOsmoEquivalentMultiplier(ctx, multiplier.EpochNumber, multiplier.Denom, multiplier.Multiplier)
+	}
+	for _, record := range genState.DelegationRecords {
+		k.SetDelegationRecord(ctx, record)
+		acc := record.GetIntermediaryAccount()
+		k.SetIntermediaryAccount(ctx, acc)
+		if !record.Unbonding {
+			k.SetIntermediaryAccountLock(ctx, acc, record.LockId)
+		}
+	}
+}
+
+// ExportGenesis returns the superfluid module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:                    k.GetParams(ctx),
+		SuperfluidAssets:          k.GetAllSuperfluidAssets(ctx),
+		OsmoEquivalentMultipliers: k.GetAllOsmoEquivalentMultipliers(ctx),
+		DelegationRecords:         k.GetAllDelegationRecords(ctx),
+	}
+}
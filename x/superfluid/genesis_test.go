@@ -0,0 +1,41 @@
+package superfluid_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simapp "github.com/osmosis-labs/osmosis/app"
+	"github.com/osmosis-labs/osmosis/x/superfluid"
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+var testGenesis = types.GenesisState{
+	Params: types.DefaultParams(),
+	SuperfluidAssets: []types.SuperfluidAsset{
+		{Denom: "gamm/pool/1"},
+	},
+	OsmoEquivalentMultipliers: []types.OsmoEquivalentMultiplierRecord{
+		{EpochNumber: 1, Denom: "gamm/pool/1", Multiplier: sdk.NewDecWithPrec(5, 1)},
+	},
+	DelegationRecords: []types.SuperfluidDelegationRecord{
+		{LockId: 1, Denom: "gamm/pool/1", ValAddr: sdk.ValAddress([]byte("addr1---------------")).String()},
+		{LockId: 2, Denom: "gamm/pool/1", ValAddr: sdk.ValAddress([]byte("addr1---------------")).String(), Unbonding: true},
+	},
+}
+
+func TestInitExportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	genesis := testGenesis
+	require.NoError(t, genesis.Validate())
+	superfluid.InitGenesis(ctx, app.SuperfluidKeeper, genesis)
+
+	// Both records share the intermediary account of their denom and validator.
+	accs := app.SuperfluidKeeper.GetAllIntermediaryAccounts(ctx)
+	require.Len(t, accs, 1)
+
+	require.Equal(t, &genesis, superfluid.ExportGenesis(ctx, app.SuperfluidKeeper))
+}
@@ -0,0 +1,46 @@
+package superfluid
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/x/superfluid/keeper"
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+)
+
+// NewHandler returns a handler for "superfluid" type messages.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		msgServer := keeper.NewMsgServerImpl(k)
+
+		switch msg := msg.(type) {
+		case *types.MsgSuperfluidDelegate:
+			res, err := msgServer.SuperfluidDelegate(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSuperfluidUndelegate:
+			res, err := msgServer.SuperfluidUndelegate(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+func NewSuperfluidProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetSuperfluidAssetsProposal:
+			return k.HandleSetSuperfluidAssetsProposal(ctx, c)
+		case *types.RemoveSuperfluidAssetsProposal:
+			return k.HandleRemoveSuperfluidAssetsProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized superfluid proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+)
+
+// SetSuperfluidAsset approves an asset for superfluid staking
+func (k Keeper) SetSuperfluidAsset(ctx sdk.Context, asset types.SuperfluidAsset) {
+	k.prefixStore(ctx, types.KeyPrefixSuperfluidAsset).Set([]byte(asset.Denom), k.cdc.MustMarshalBinaryBare(&asset))
+}
+
+// GetSuperfluidAsset returns the superfluid asset of denom
+func (k Keeper) GetSuperfluidAsset(ctx sdk.Context, denom string) (types.SuperfluidAsset, error) {
+	bz := k.prefixStore(ctx, types.KeyPrefixSuperfluidAsset).Get([]byte(denom))
+	if bz == nil {
+		return types.SuperfluidAsset{}, sdkerrors.Wrapf(types.ErrNonSuperfluidAsset, "%s", denom)
+	}
+
+	var asset types.SuperfluidAsset
+	k.cdc.MustUnmarshalBinaryBare(bz, &asset)
+	return asset, nil
+}
+
+// DeleteSuperfluidAsset removes the approval of denom for superfluid staking
+func (k Keeper) DeleteSuperfluidAsset(ctx sdk.Context, denom string) {
+	k.prefixStore(ctx, types.KeyPrefixSuperfluidAsset).Delete([]byte(denom))
+}
+
+// GetAllSuperfluidAssets returns all the superfluid assets, by denom
+func (k Keeper) GetAllSuperfluidAssets(ctx sdk.Context) []types.SuperfluidAsset {
+	iter := k.prefixStore(ctx, types.KeyPrefixSuperfluidAsset).Iterator(nil, nil)
+	defer iter.Close()
+
+	assets := []types.SuperfluidAsset{}
+	for ; iter.Valid(); iter.Next() {
+		var asset types.SuperfluidAsset
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &asset)
+		assets = append(assets, asset)
+	}
+	return assets
+}
+
+// SetOsmoEquivalentMultiplier records the multiplier of denom computed at the end of an epoch
+func (k Keeper) SetOsmoEquivalentMultiplier(ctx sdk.Context, epochNumber int64, denom string, multiplier sdk.Dec) {
+	record := types.OsmoEquivalentMultiplierRecord{
+		EpochNumber: epochNumber,
+		Denom:       denom,
+		Multiplier:  multiplier,
+	}
+	k.prefixStore(ctx, types.KeyPrefixTokenMultiplier).Set([]byte(denom), k.cdc.MustMarshalBinaryBare(&record))
+}
+
+// GetOsmoEquivalentMultiplierRecord returns the multiplier record of denom
+func (k Keeper) GetOsmoEquivalentMultiplierRecord(ctx sdk.Context, denom string) (types.OsmoEquivalentMultiplierRecord, error) {
+	bz := k.prefixStore(ctx, types.KeyPrefixTokenMultiplier).Get([]byte(denom))
+	if bz == nil {
+		return types.OsmoEquivalentMultiplierRecord{}, sdkerrors.Wrapf(types.ErrNonSuperfluidAsset, "no multiplier for %s", denom)
+	}
+
+	var record types.OsmoEquivalentMultiplierRecord
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+	return record, nil
+}
+
+// GetOsmoEquivalentMultiplier returns the multiplier of denom, which is zero if denom is not a superfluid asset
+func (k Keeper) GetOsmoEquivalentMultiplier(ctx sdk.Context, denom string) sdk.Dec {
+	record, err := k.GetOsmoEquivalentMultiplierRecord(ctx, denom)
+	if err != nil {
+		return sdk.ZeroDec()
+	}
+	return record.Multiplier
+}
+
+// DeleteOsmoEquivalentMultiplier removes the multiplier record of denom
+func (k Keeper) DeleteOsmoEquivalentMultiplier(ctx sdk.Context, denom string) {
+	k.prefixStore(ctx, types.KeyPrefixTokenMultiplier).Delete([]byte(denom))
+}
+
+// GetAllOsmoEquivalentMultipliers returns all the multiplier records, by denom
+func (k Keeper) GetAllOsmoEquivalentMultipliers(ctx sdk.Context) []types.OsmoEquivalentMultiplierRecord {
+	iter := k.prefixStore(ctx, types.KeyPrefixTokenMultiplier).Iterator(nil, nil)
+	defer iter.Close()
+
+	records := []types.OsmoEquivalentMultiplierRecord{}
+	for ; iter.Valid(); iter.Next() {
+		var record types.OsmoEquivalentMultiplierRecord
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// calculateOsmoEquivalentMultiplier returns the amount of the bond denom held by a pool per share of denom.
+// Only the bond denom side of the pool is valued, so that no price is needed.
+// That amount moves with the spot prices of the other assets of the pool though, so it is lowered by how much
+// their spot price exceeds their TWAP over the refresh epoch, for swaps right before a refresh not to raise it.
+func (k Keeper) calculateOsmoEquivalentMultiplier(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	poolId, err := gammtypes.GetPoolIdFromShareDenom(denom)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrap(types.ErrInvalidSuperfluidAsset, err.Error())
+	}
+	pool, err := k.gk.GetPool(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	bondDenom := k.sk.BondDenom(ctx)
+	osmoAmount, err := pool.GetTokenBalance(bondDenom)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidSuperfluidAsset, "pool %d: %s", poolId, err)
+	}
+
+	totalShares := pool.GetTotalShares().Amount
+	if !totalShares.IsPositive() {
+		return sdk.ZeroDec(), nil
+	}
+	discount, err := k.twapDiscount(ctx, pool, bondDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return osmoAmount.ToDec().Mul(discount).QuoInt(totalShares), nil
+}
+
+// twapDiscount returns the lowest ratio of the TWAP over the refresh epoch to the spot price, in the bond denom,
+// of the other assets of the pool, capped at one.
+// The assets without TWAP records over the whole epoch, such as those of pools created during it, are valued
+// at their spot price.
+func (k Keeper) twapDiscount(ctx sdk.Context, pool gammtypes.PoolI, bondDenom string) (sdk.Dec, error) {
+	epochInfo := k.ek.GetEpochInfo(ctx, k.GetParams(ctx).RefreshEpochIdentifier)
+	startTime := ctx.BlockTime().Add(-epochInfo.Duration)
+
+	discount := sdk.OneDec()
+	for _, asset := range pool.GetAllPoolAssets() {
+		denom := asset.Token.Denom
+		if denom == bondDenom {
+			continue
+		}
+		spotPrice, err := pool.SpotPrice(bondDenom, denom, sdk.ZeroDec())
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		if !spotPrice.IsPositive() {
+			continue
+		}
+		twap, err := k.gk.ArithmeticTwap(ctx, pool.GetId(), denom, bondDenom, startTime, ctx.BlockTime())
+		if err != nil {
+			k.Logger(ctx).Info("valuing a superfluid asset at spot price", "pool_id", pool.GetId(), "denom", denom, "error", err.Error())
+			continue
+		}
+		if ratio := twap.Quo(spotPrice); ratio.LT(discount) {
+			discount = ratio
+		}
+	}
+	return discount, nil
+}
+
+// refreshOsmoEquivalentMultipliers recomputes the multipliers of all the superfluid assets at the end of an epoch.
+// The multiplier of an asset whose pool can't be valued anymore is set to zero.
+func (k Keeper) refreshOsmoEquivalentMultipliers(ctx sdk.Context, epochNumber int64) {
+	for _, asset := range k.GetAllSuperfluidAssets(ctx) {
+		multiplier, err := k.calculateOsmoEquivalentMultiplier(ctx, asset.Denom)
+		if err != nil {
+			k.Logger(ctx).Error("failed to refresh the multiplier of a superfluid asset", "denom", asset.Denom, "error", err.Error())
+			multiplier = sdk.ZeroDec()
+		}
+		k.SetOsmoEquivalentMultiplier(ctx, epochNumber, asset.Denom, multiplier)
+	}
+}
+
+// GetRiskAdjustedOsmoValue returns the OSMO value delegated for locked tokens worth osmoValue,
+// which is reduced by the risk factor.
+func (k Keeper) GetRiskAdjustedOsmoValue(ctx sdk.Context, osmoValue sdk.Dec) sdk.Int {
+	riskFactor := k.GetParams(ctx).RiskFactor
+	return osmoValue.Mul(sdk.OneDec().Sub(riskFactor)).TruncateInt()
+}
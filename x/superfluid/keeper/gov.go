@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+)
+
+// HandleSetSuperfluidAssetsProposal approves the assets of the proposal, and sets their multipliers.
+func (k Keeper) HandleSetSuperfluidAssetsProposal(ctx sdk.Context, p *types.SetSuperfluidAssetsProposal) error {
+	epochNumber := k.ek.GetEpochInfo(ctx, k.GetParams(ctx).RefreshEpochIdentifier).CurrentEpoch
+	for _, asset := range p.Assets {
+		multiplier, err := k.calculateOsmoEquivalentMultiplier(ctx, asset.Denom)
+		if err != nil {
+			return err
+		}
+		k.SetSuperfluidAsset(ctx, asset)
+		k.SetOsmoEquivalentMultiplier(ctx, epochNumber, asset.Denom, multiplier)
+		k.refreshIntermediaryDelegationsOfDenom(ctx, asset.Denom)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtSetSuperfluidAsset,
+			sdk.NewAttribute(types.AttributeDenom, asset.Denom),
+		))
+	}
+	return nil
+}
+
+// HandleRemoveSuperfluidAssetsProposal removes the approval of the assets of the proposal.
+// The synthetic stake of their locks is undelegated.
+func (k Keeper) HandleRemoveSuperfluidAssetsProposal(ctx sdk.Context, p *types.RemoveSuperfluidAssetsProposal) error {
+	for _, denom := range p.SuperfluidAssetDenoms {
+		if _, err := k.GetSuperfluidAsset(ctx, denom); err != nil {
+			return err
+		}
+		k.DeleteSuperfluidAsset(ctx, denom)
+		k.DeleteOsmoEquivalentMultiplier(ctx, denom)
+		k.refreshIntermediaryDelegationsOfDenom(ctx, denom)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtRemoveSuperfluidAsset,
+			sdk.NewAttribute(types.AttributeDenom, denom),
+		))
+	}
+	return nil
+}
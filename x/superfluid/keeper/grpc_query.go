@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the superfluid module params
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// AllAssets returns the approved superfluid assets
+func (k Keeper) AllAssets(goCtx context.Context, req *types.AllAssetsRequest) (*types.AllAssetsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.AllAssetsResponse{Assets: k.GetAllSuperfluidAssets(ctx)}, nil
+}
+
+// AssetMultiplier returns the OSMO equivalent multiplier of a superfluid asset
+func (k Keeper) AssetMultiplier(goCtx context.Context, req *types.AssetMultiplierRequest) (*types.AssetMultiplierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	record, err := k.GetOsmoEquivalentMultiplierRecord(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.AssetMultiplierResponse{OsmoEquivalentMultiplier: record}, nil
+}
+
+// LockSuperfluidStake returns the synthetic stake of a superfluid staked lock
+func (k Keeper) LockSuperfluidStake(goCtx context.Context, req *types.LockSuperfluidStakeRequest) (*types.LockSuperfluidStakeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	record, err := k.GetDelegationRecord(ctx, req.LockId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	stake, err := k.GetLockSyntheticStake(ctx, record)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.LockSuperfluidStakeResponse{
+		Record:              record,
+		IntermediaryAddress: record.GetIntermediaryAccount().GetAccAddress().String(),
+		SyntheticStake:      stake,
+	}, nil
+}
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+)
+
+// refreshLockDelegation refreshes the delegation of the intermediary account of a lock whose tokens changed
+func (k Keeper) refreshLockDelegation(ctx sdk.Context, lockID uint64) {
+	record, err := k.GetDelegationRecord(ctx, lockID)
+	if err != nil || record.Unbonding {
+		return
+	}
+	k.tryRefreshIntermediaryDelegation(ctx, record.GetIntermediaryAccount())
+}
+
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	params := k.GetParams(ctx)
+	if epochIdentifier != params.RefreshEpochIdentifier {
+		return
+	}
+
+	k.refreshOsmoEquivalentMultipliers(ctx, epochNumber)
+	for _, acc := range k.GetAllIntermediaryAccounts(ctx) {
+		err := applyIfNoError(ctx, func(ctx sdk.Context) error {
+			if err := k.distributeIntermediaryRewards(ctx, acc); err != nil {
+				return err
+			}
+			return k.refreshIntermediaryDelegation(ctx, acc)
+		})
+		if err != nil {
+			k.Logger(ctx).Error("failed to refresh a superfluid delegation", "denom", acc.Denom, "validator", acc.ValAddr, "error", err.Error())
+		}
+	}
+}
+
+// BeforeValidatorSlashed slashes the locks superfluid staked to a validator by the fraction its tokens are slashed,
+// including the undelegated locks that are still unlocking.
+// Their intermediary accounts' delegations are slashed by the staking module, so they are left as is.
+// The slashed pool shares are exited from their pool, and the tokens they were worth are burned.
+func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
+	for _, record := range k.GetAllDelegationRecords(ctx) {
+		if record.ValAddr != valAddr.String() {
+			continue
+		}
+		lock, err := k.lk.GetLockByID(ctx, record.LockId)
+		if err != nil {
+			continue
+		}
+		amount := fraction.MulInt(lock.Coins.AmountOf(record.Denom)).TruncateInt()
+		if !amount.IsPositive() {
+			continue
+		}
+
+		err = applyIfNoError(ctx, func(ctx sdk.Context) error {
+			_, err := k.lk.SlashTokensFromLockByID(ctx, lock.ID, sdk.Coins{sdk.NewCoin(record.Denom, amount)}, types.ModuleName)
+			if err != nil {
+				return err
+			}
+			return k.burnSlashedCoins(ctx, sdk.NewCoin(record.Denom, amount))
+		})
+		if err != nil {
+			k.Logger(ctx).Error("failed to slash a superfluid staked lock", "lock_id", lock.ID, "error", err.Error())
+		}
+	}
+}
+
+// burnSlashedCoins burns the slashed coins held by the module account.
+// Pool shares are exited from their pool, so that they don't stay in its total shares, and the tokens they
+// were worth are burned. If the pool can't be exited, the shares are burned from it, which leaves their
+// tokens to the other shareholders.
+func (k Keeper) burnSlashedCoins(ctx sdk.Context, coin sdk.Coin) error {
+	moduleAddr := k.ak.GetModuleAddress(types.ModuleName)
+	poolId, err := gammtypes.GetPoolIdFromShareDenom(coin.Denom)
+	if err != nil {
+		return k.bk.BurnCoins(ctx, types.ModuleName, sdk.Coins{coin})
+	}
+
+	err = applyIfNoError(ctx, func(ctx sdk.Context) error {
+		balances := k.bk.GetAllBalances(ctx, moduleAddr)
+		if err := k.gk.ExitPool(ctx, moduleAddr, poolId, coin.Amount, sdk.Coins{}); err != nil {
+			return err
+		}
+		exited := k.bk.GetAllBalances(ctx, moduleAddr).Sub(balances.Sub(sdk.Coins{coin}))
+		return k.bk.BurnCoins(ctx, types.ModuleName, exited)
+	})
+	if err == nil {
+		return nil
+	}
+	k.Logger(ctx).Error("failed to exit slashed pool shares", "pool_id", poolId, "error", err.Error())
+
+	pool, err := k.gk.GetPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	if err := k.gk.BurnPoolShareFromAccount(ctx, pool, moduleAddr, coin.Amount); err != nil {
+		return err
+	}
+	return k.gk.SetPool(ctx, pool)
+}
+
+// ___________________________________________________________________________________________________
+
+// Hooks wrapper struct for superfluid keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ lockuptypes.LockupHooks = Hooks{}
+var _ epochstypes.EpochHooks = Hooks{}
+var _ stakingtypes.StakingHooks = Hooks{}
+var _ gammtypes.GammHooks = Hooks{}
+
+// Return the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// lockup hooks
+func (h Hooks) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	// tokens were added to the lock
+	h.k.refreshLockDelegation(ctx, lockID)
+}
+
+func (h Hooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	record, err := h.k.GetDelegationRecord(ctx, lockID)
+	if err != nil {
+		return
+	}
+
+	// the lock is gone once unlocked, or only lost tokens if it was split
+	if _, err := h.k.lk.GetLockByID(ctx, lockID); err != nil {
+		h.k.DeleteDelegationRecord(ctx, lockID)
+		h.k.deleteIntermediaryAccountLock(ctx, record.GetIntermediaryAccount(), lockID)
+	}
+	if !record.Unbonding {
+		h.k.tryRefreshIntermediaryDelegation(ctx, record.GetIntermediaryAccount())
+	}
+}
+
+func (h Hooks) OnLockDurationExtended(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, oldDuration time.Duration, newDuration time.Duration) {
+}
+
+func (h Hooks) OnLockTransferred(ctx sdk.Context, oldOwner sdk.AccAddress, newOwner sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+}
+
+func (h Hooks) OnLockPenalized(ctx sdk.Context, address sdk.AccAddress, lockID uint64, penalty sdk.Coins) {
+}
+
+// gamm hooks
+func (h Hooks) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {}
+
+func (h Hooks) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+}
+
+func (h Hooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+}
+
+func (h Hooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+}
+
+// AfterPoolMigrated moves the superfluid staking of the shares of the old pool to the shares of the new pool,
+// which replaced them in the locks.
+func (h Hooks) AfterPoolMigrated(ctx sdk.Context, oldPoolId uint64, newPoolId uint64) {
+	err := h.k.MigrateSuperfluidAsset(ctx, gammtypes.GetPoolShareDenom(oldPoolId), gammtypes.GetPoolShareDenom(newPoolId))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// epochs hooks
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+}
+
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+}
+
+// staking hooks
+func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {}
+
+func (h Hooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) {}
+
+func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+}
+
+func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+}
+
+func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+}
+
+func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+}
+
+func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+}
+
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+}
+
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+}
+
+func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
+	h.k.BeforeValidatorSlashed(ctx, valAddr, fraction)
+}
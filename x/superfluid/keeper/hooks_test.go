@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/x/superfluid/keeper"
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+)
+
+func (suite *KeeperTestSuite) TestRefreshEpoch() {
+	suite.SetupTest()
+
+	denom := suite.preparePool()
+	validator := suite.prepareValidator()
+	suite.setSuperfluidAsset(denom)
+	sfKeeper := suite.app.SuperfluidKeeper
+	bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+
+	lock := suite.lockShares(acc1, denom, gammtypes.OneShare.MulRaw(50))
+	err := sfKeeper.SuperfluidDelegate(suite.ctx, acc1, lock.ID, validator.GetOperator())
+	suite.Require().NoError(err)
+	acc := sfKeeper.GetAllIntermediaryAccounts(suite.ctx)[0]
+	suite.Require().Equal(sdk.NewInt(1250000), sfKeeper.GetDelegatedAmount(suite.ctx, acc))
+
+	// An epoch later, buying foo with the bond denom raises the OSMO held by the pool per share.
+	epochInfo := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, sfKeeper.GetParams(suite.ctx).RefreshEpochIdentifier)
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(epochInfo.Duration))
+	poolId, err := gammtypes.GetPoolIdFromShareDenom(denom)
+	suite.Require().NoError(err)
+	_, _, err = suite.app.GAMMKeeper.SwapExactAmountIn(suite.ctx, acc2, poolId, sdk.NewInt64Coin(bondDenom, 5000000), "foo", sdk.OneInt())
+	suite.Require().NoError(err)
+
+	// The rewards of the intermediary account are paid to the lock owners at the end of the refresh epoch.
+	err = suite.app.BankKeeper.AddCoins(suite.ctx, acc.GetAccAddress(), sdk.Coins{sdk.NewInt64Coin("foo", 100)})
+	suite.Require().NoError(err)
+	fooBalance := suite.app.BankKeeper.GetBalance(suite.ctx, acc1, "foo").Amount
+
+	// Other epochs don't refresh the delegations.
+	sfKeeper.Hooks().AfterEpochEnd(suite.ctx, "week", 2)
+	suite.Require().Equal(sdk.NewInt(1250000), sfKeeper.GetDelegatedAmount(suite.ctx, acc))
+
+	sfKeeper.Hooks().AfterEpochEnd(suite.ctx, sfKeeper.GetParams(suite.ctx).RefreshEpochIdentifier, 2)
+	pool, err := suite.app.GAMMKeeper.GetPool(suite.ctx, poolId)
+	suite.Require().NoError(err)
+	poolAsset, err := pool.GetPoolAsset(bondDenom)
+	suite.Require().NoError(err)
+	// But the multiplier is lowered by how much the spot price of foo exceeds its TWAP over the epoch, which is 1.
+	spotPrice, err := pool.SpotPrice(bondDenom, "foo", sdk.ZeroDec())
+	suite.Require().NoError(err)
+	suite.Require().True(spotPrice.GT(sdk.OneDec()))
+	multiplier := poolAsset.Token.Amount.ToDec().Mul(sdk.OneDec().Quo(spotPrice)).QuoInt(pool.GetTotalShares().Amount)
+	suite.Require().True(multiplier.LT(sdk.NewDec(50000).QuoInt(gammtypes.OneShare)))
+	record, err := sfKeeper.GetOsmoEquivalentMultiplierRecord(suite.ctx, denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(2), record.EpochNumber)
+	suite.Require().Equal(multiplier, record.Multiplier)
+	suite.Require().Equal(multiplier.MulInt64(25).MulInt(gammtypes.OneShare).TruncateInt(), sfKeeper.GetDelegatedAmount(suite.ctx, acc))
+	suite.Require().Equal(sdk.NewInt(100), suite.app.BankKeeper.GetBalance(suite.ctx, acc1, "foo").Amount.Sub(fooBalance))
+
+	_, broken := keeper.SyntheticStakeBackingInvariant(sfKeeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestBeforeValidatorSlashed() {
+	suite.SetupTest()
+
+	denom := suite.preparePool()
+	validator := suite.prepareValidator()
+	suite.setSuperfluidAsset(denom)
+	sfKeeper := suite.app.SuperfluidKeeper
+
+	lock := suite.lockShares(acc1, denom, gammtypes.OneShare.MulRaw(50))
+	err := sfKeeper.SuperfluidDelegate(suite.ctx, acc1, lock.ID, validator.GetOperator())
+	suite.Require().NoError(err)
+	_, err = suite.app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(suite.ctx)
+	suite.Require().NoError(err)
+
+	// Slashing a tenth of a power of 1 burns 100000 of the 1250000 tokens of the validator, 8% of them.
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	shareSupply := suite.app.BankKeeper.GetSupply(suite.ctx).GetTotal().AmountOf(denom)
+	fooSupply := suite.app.BankKeeper.GetSupply(suite.ctx).GetTotal().AmountOf("foo")
+	suite.app.StakingKeeper.Slash(suite.ctx, consAddr, suite.ctx.BlockHeight(), 1, sdk.NewDecWithPrec(1, 1))
+
+	slashed, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(gammtypes.OneShare.MulRaw(46), slashed.Coins.AmountOf(denom))
+	suite.Require().Equal(gammtypes.OneShare.MulRaw(4), shareSupply.Sub(suite.app.BankKeeper.GetSupply(suite.ctx).GetTotal().AmountOf(denom)))
+
+	// The slashed shares are exited from the pool, and the 4% of its tokens they were worth are burned.
+	pool, err := suite.app.GAMMKeeper.GetPool(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(gammtypes.OneShare.MulRaw(96), pool.GetTotalShares().Amount)
+	suite.Require().Equal(sdk.NewInt(200000), fooSupply.Sub(suite.app.BankKeeper.GetSupply(suite.ctx).GetTotal().AmountOf("foo")))
+	suite.Require().Equal(sdk.NewInt(4800000), suite.app.BankKeeper.GetBalance(suite.ctx, pool.GetAddress(), "foo").Amount)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)).Empty())
+
+	// The synthetic stake left is still backed by the slashed lock.
+	_, broken := keeper.SyntheticStakeBackingInvariant(sfKeeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestAfterPoolMigrated() {
+	suite.SetupTest()
+
+	denom := suite.preparePool()
+	validator := suite.prepareValidator()
+	suite.setSuperfluidAsset(denom)
+	sfKeeper := suite.app.SuperfluidKeeper
+	bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+
+	lock := suite.lockShares(acc1, denom, gammtypes.OneShare.MulRaw(50))
+	err := sfKeeper.SuperfluidDelegate(suite.ctx, acc1, lock.ID, validator.GetOperator())
+	suite.Require().NoError(err)
+	oldAcc := sfKeeper.GetAllIntermediaryAccounts(suite.ctx)[0]
+	multiplier := sfKeeper.GetOsmoEquivalentMultiplier(suite.ctx, denom)
+
+	poolId, err := gammtypes.GetPoolIdFromShareDenom(denom)
+	suite.Require().NoError(err)
+	newPoolId, err := suite.app.GAMMKeeper.MigratePool(suite.ctx, poolId, gammtypes.BalancerPoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}, []gammtypes.PoolAsset{
+		{Weight: sdk.NewInt(100), Token: sdk.NewCoin(bondDenom, sdk.ZeroInt())},
+		{Weight: sdk.NewInt(100), Token: sdk.NewCoin("foo", sdk.ZeroInt())},
+	}, "")
+	suite.Require().NoError(err)
+	newDenom := gammtypes.GetPoolShareDenom(newPoolId)
+
+	// The asset and its multiplier move to the shares of the new pool.
+	_, err = sfKeeper.GetSuperfluidAsset(suite.ctx, denom)
+	suite.Require().ErrorIs(err, types.ErrNonSuperfluidAsset)
+	_, err = sfKeeper.GetSuperfluidAsset(suite.ctx, newDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(multiplier, sfKeeper.GetOsmoEquivalentMultiplier(suite.ctx, newDenom))
+
+	// So do the record of the lock and its synthetic stake.
+	record, err := sfKeeper.GetDelegationRecord(suite.ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(newDenom, record.Denom)
+	newAcc := record.GetIntermediaryAccount()
+	suite.Require().Equal(sdk.ZeroInt(), sfKeeper.GetDelegatedAmount(suite.ctx, oldAcc))
+	suite.Require().Equal(sdk.NewInt(1250000), sfKeeper.GetDelegatedAmount(suite.ctx, newAcc))
+	suite.Require().Len(sfKeeper.GetIntermediaryAccountLocks(suite.ctx, oldAcc), 0)
+	suite.Require().Len(sfKeeper.GetIntermediaryAccountLocks(suite.ctx, newAcc), 1)
+
+	_, broken := keeper.SyntheticStakeBackingInvariant(sfKeeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	// The lock still can't unlock before undelegating.
+	_, err = suite.app.LockupKeeper.BeginUnlockPeriodLockByID(suite.ctx, lock.ID)
+	suite.Require().ErrorIs(err, lockuptypes.ErrLockHasSyntheticLockups)
+	err = sfKeeper.SuperfluidUndelegate(suite.ctx, acc1, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.ZeroInt(), sfKeeper.GetDelegatedAmount(suite.ctx, newAcc))
+}
@@ -0,0 +1,253 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+)
+
+// SetDelegationRecord stores the superfluid delegation record of a lock
+func (k Keeper) SetDelegationRecord(ctx sdk.Context, record types.SuperfluidDelegationRecord) {
+	k.prefixStore(ctx, types.KeyPrefixDelegationRecord).Set(sdk.Uint64ToBigEndian(record.LockId), k.cdc.MustMarshalBinaryBare(&record))
+}
+
+// GetDelegationRecord returns the superfluid delegation record of a lock
+func (k Keeper) GetDelegationRecord(ctx sdk.Context, lockID uint64) (types.SuperfluidDelegationRecord, error) {
+	bz := k.prefixStore(ctx, types.KeyPrefixDelegationRecord).Get(sdk.Uint64ToBigEndian(lockID))
+	if bz == nil {
+		return types.SuperfluidDelegationRecord{}, sdkerrors.Wrapf(types.ErrNotSuperfluidDelegated, "lock %d", lockID)
+	}
+
+	var record types.SuperfluidDelegationRecord
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+	return record, nil
+}
+
+// DeleteDelegationRecord removes the superfluid delegation record of a lock
+func (k Keeper) DeleteDelegationRecord(ctx sdk.Context, lockID uint64) {
+	k.prefixStore(ctx, types.KeyPrefixDelegationRecord).Delete(sdk.Uint64ToBigEndian(lockID))
+}
+
+// GetAllDelegationRecords returns all the superfluid delegation records, by lock ID
+func (k Keeper) GetAllDelegationRecords(ctx sdk.Context) []types.SuperfluidDelegationRecord {
+	iter := k.prefixStore(ctx, types.KeyPrefixDelegationRecord).Iterator(nil, nil)
+	defer iter.Close()
+
+	records := []types.SuperfluidDelegationRecord{}
+	for ; iter.Valid(); iter.Next() {
+		var record types.SuperfluidDelegationRecord
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// SetIntermediaryAccount stores an intermediary account, so that its delegation is refreshed every refresh epoch
+func (k Keeper) SetIntermediaryAccount(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) {
+	k.prefixStore(ctx, types.KeyPrefixIntermediaryAccount).Set(acc.GetAccAddress(), k.cdc.MustMarshalBinaryBare(&acc))
+}
+
+// GetAllIntermediaryAccounts returns all the intermediary accounts, by address
+func (k Keeper) GetAllIntermediaryAccounts(ctx sdk.Context) []types.SuperfluidIntermediaryAccount {
+	iter := k.prefixStore(ctx, types.KeyPrefixIntermediaryAccount).Iterator(nil, nil)
+	defer iter.Close()
+
+	accs := []types.SuperfluidIntermediaryAccount{}
+	for ; iter.Valid(); iter.Next() {
+		var acc types.SuperfluidIntermediaryAccount
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &acc)
+		accs = append(accs, acc)
+	}
+	return accs
+}
+
+func intermediaryAccountLocksPrefix(acc types.SuperfluidIntermediaryAccount) []byte {
+	return append(types.KeyPrefixIntermediaryAccountLock, acc.GetAccAddress()...)
+}
+
+// SetIntermediaryAccountLock adds a lock to the locks whose synthetic stake an intermediary account delegates
+func (k Keeper) SetIntermediaryAccountLock(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount, lockID uint64) {
+	k.prefixStore(ctx, intermediaryAccountLocksPrefix(acc)).Set(sdk.Uint64ToBigEndian(lockID), []byte{})
+}
+
+// deleteIntermediaryAccountLock removes a lock from the locks whose synthetic stake an intermediary account delegates
+func (k Keeper) deleteIntermediaryAccountLock(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount, lockID uint64) {
+	k.prefixStore(ctx, intermediaryAccountLocksPrefix(acc)).Delete(sdk.Uint64ToBigEndian(lockID))
+}
+
+// GetIntermediaryAccountLocks returns the locks whose synthetic stake an intermediary account delegates, by ID
+func (k Keeper) GetIntermediaryAccountLocks(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) []lockuptypes.PeriodLock {
+	iter := k.prefixStore(ctx, intermediaryAccountLocksPrefix(acc)).Iterator(nil, nil)
+	defer iter.Close()
+
+	locks := []lockuptypes.PeriodLock{}
+	for ; iter.Valid(); iter.Next() {
+		lock, err := k.lk.GetLockByID(ctx, sdk.BigEndianToUint64(iter.Key()))
+		if err != nil {
+			// locks broken by admin privilege are gone without any hook
+			continue
+		}
+		locks = append(locks, *lock)
+	}
+	return locks
+}
+
+// getLockedAmount returns the amount of the intermediary account's denom in its locks
+func (k Keeper) getLockedAmount(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, lock := range k.GetIntermediaryAccountLocks(ctx, acc) {
+		total = total.Add(lock.Coins.AmountOf(acc.Denom))
+	}
+	return total
+}
+
+// GetExpectedDelegationAmount returns the synthetic stake an intermediary account should delegate,
+// which is the risk adjusted OSMO value of its locks.
+func (k Keeper) GetExpectedDelegationAmount(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) sdk.Int {
+	multiplier := k.GetOsmoEquivalentMultiplier(ctx, acc.Denom)
+	return k.GetRiskAdjustedOsmoValue(ctx, multiplier.MulInt(k.getLockedAmount(ctx, acc)))
+}
+
+// GetDelegatedAmount returns the synthetic stake an intermediary account delegates
+func (k Keeper) GetDelegatedAmount(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) sdk.Int {
+	valAddr, err := sdk.ValAddressFromBech32(acc.ValAddr)
+	if err != nil {
+		return sdk.ZeroInt()
+	}
+	validator, found := k.sk.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroInt()
+	}
+	delegation, found := k.sk.GetDelegation(ctx, acc.GetAccAddress(), valAddr)
+	if !found {
+		return sdk.ZeroInt()
+	}
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+// refreshIntermediaryDelegation brings the delegation of an intermediary account to the risk adjusted OSMO value
+// of its locks. The bond denom is minted to delegate more, and burned out of the staking pools once undelegated,
+// as the synthetic stake is backed by the locked tokens and never circulates.
+func (k Keeper) refreshIntermediaryDelegation(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) error {
+	valAddr, err := sdk.ValAddressFromBech32(acc.ValAddr)
+	if err != nil {
+		return err
+	}
+	validator, found := k.sk.GetValidator(ctx, valAddr)
+	if !found {
+		return sdkerrors.Wrapf(stakingtypes.ErrNoValidatorFound, "%s", acc.ValAddr)
+	}
+	addr := acc.GetAccAddress()
+	bondDenom := k.sk.BondDenom(ctx)
+
+	expected := k.GetExpectedDelegationAmount(ctx, acc)
+	delegated := k.GetDelegatedAmount(ctx, acc)
+	switch {
+	case expected.GT(delegated):
+		coins := sdk.Coins{sdk.NewCoin(bondDenom, expected.Sub(delegated))}
+		if err := k.bk.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+			return err
+		}
+		_, err = k.sk.Delegate(ctx, addr, coins[0].Amount, stakingtypes.Unbonded, validator, true)
+		return err
+
+	case expected.LT(delegated):
+		var shares sdk.Dec
+		if expected.IsZero() {
+			delegation, _ := k.sk.GetDelegation(ctx, addr, valAddr)
+			shares = delegation.Shares
+		} else {
+			shares, err = k.sk.ValidateUnbondAmount(ctx, addr, valAddr, delegated.Sub(expected))
+			if err != nil {
+				return err
+			}
+		}
+		amount, err := k.sk.Unbond(ctx, addr, valAddr, shares)
+		if err != nil {
+			return err
+		}
+		if !amount.IsPositive() {
+			return nil
+		}
+
+		pool := stakingtypes.NotBondedPoolName
+		if validator.IsBonded() {
+			pool = stakingtypes.BondedPoolName
+		}
+		return k.bk.BurnCoins(ctx, pool, sdk.Coins{sdk.NewCoin(bondDenom, amount)})
+	}
+	return nil
+}
+
+// tryRefreshIntermediaryDelegation refreshes the delegation of an intermediary account from a hook.
+// The delegation is left as is on error, to be refreshed again at the end of the next refresh epoch.
+func (k Keeper) tryRefreshIntermediaryDelegation(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) {
+	err := applyIfNoError(ctx, func(ctx sdk.Context) error {
+		return k.refreshIntermediaryDelegation(ctx, acc)
+	})
+	if err != nil {
+		k.Logger(ctx).Error("failed to refresh a superfluid delegation", "denom", acc.Denom, "validator", acc.ValAddr, "error", err.Error())
+	}
+}
+
+// refreshIntermediaryDelegationsOfDenom refreshes the delegations of the intermediary accounts of denom
+func (k Keeper) refreshIntermediaryDelegationsOfDenom(ctx sdk.Context, denom string) {
+	for _, acc := range k.GetAllIntermediaryAccounts(ctx) {
+		if acc.Denom == denom {
+			k.tryRefreshIntermediaryDelegation(ctx, acc)
+		}
+	}
+}
+
+// distributeIntermediaryRewards withdraws the staking rewards of an intermediary account, and pays all of its
+// balance to the owners of its locks pro rata to their locked amount. The remainder of the division is paid later.
+func (k Keeper) distributeIntermediaryRewards(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) error {
+	valAddr, err := sdk.ValAddressFromBech32(acc.ValAddr)
+	if err != nil {
+		return err
+	}
+	addr := acc.GetAccAddress()
+	if _, found := k.sk.GetDelegation(ctx, addr, valAddr); found {
+		if _, err := k.dk.WithdrawDelegationRewards(ctx, addr, valAddr); err != nil {
+			return err
+		}
+	}
+
+	rewards := k.bk.GetAllBalances(ctx, addr)
+	if rewards.Empty() {
+		return nil
+	}
+	locks := k.GetIntermediaryAccountLocks(ctx, acc)
+	total := sdk.ZeroInt()
+	for _, lock := range locks {
+		total = total.Add(lock.Coins.AmountOf(acc.Denom))
+	}
+	if !total.IsPositive() {
+		return nil
+	}
+
+	for _, lock := range locks {
+		amount := lock.Coins.AmountOf(acc.Denom)
+		share := sdk.Coins{}
+		for _, coin := range rewards {
+			share = share.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(amount).Quo(total)))
+		}
+		if share.IsZero() {
+			continue
+		}
+		owner, err := sdk.AccAddressFromBech32(lock.Owner)
+		if err != nil {
+			return err
+		}
+		if err := k.bk.SendCoins(ctx, addr, owner, share); err != nil {
+			return err
+		}
+	}
+	return nil
+}
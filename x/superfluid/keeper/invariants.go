@@ -0,0 +1,42 @@
+package keeper
+
+// DONTCOVER
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+)
+
+const syntheticStakeBackingInvariantName = "synthetic-stake-backing"
+
+// RegisterInvariants registers all superfluid invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, syntheticStakeBackingInvariantName, SyntheticStakeBackingInvariant(keeper))
+}
+
+// SyntheticStakeBackingInvariant checks that no intermediary account delegates more than the OSMO value
+// of the locks it delegates for, valued from their pool rather than at the multiplier they were delegated at.
+// The risk factor leaves room for the value of the pool to move between refreshes.
+func SyntheticStakeBackingInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, acc := range keeper.GetAllIntermediaryAccounts(ctx) {
+			delegated := keeper.GetDelegatedAmount(ctx, acc)
+			multiplier, err := keeper.calculateOsmoEquivalentMultiplier(ctx, acc.Denom)
+			if err != nil {
+				multiplier = sdk.ZeroDec()
+			}
+			backing := multiplier.MulInt(keeper.getLockedAmount(ctx, acc)).TruncateInt()
+			if delegated.GT(backing) {
+				return sdk.FormatInvariant(types.ModuleName, syntheticStakeBackingInvariantName,
+					fmt.Sprintf("\tintermediary account %s of %s delegating to %s\n\tsynthetic stake: %s\n\tbacking value: %s\n",
+						acc.GetAccAddress(), acc.Denom, acc.ValAddr, delegated, backing)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, syntheticStakeBackingInvariantName,
+			"\tall synthetic stake is backed by locked tokens\n"), false
+	}
+}
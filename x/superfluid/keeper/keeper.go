@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+)
+
+// Keeper provides a way to manage module storage
+type Keeper struct {
+	cdc        codec.Marshaler
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
+
+	ak types.AccountKeeper
+	bk types.BankKeeper
+	sk types.StakingKeeper
+	dk types.DistrKeeper
+	ek types.EpochKeeper
+	lk types.LockupKeeper
+	gk types.GAMMKeeper
+}
+
+// NewKeeper returns an instance of Keeper
+func NewKeeper(cdc codec.Marshaler, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.DistrKeeper, ek types.EpochKeeper, lk types.LockupKeeper, gk types.GAMMKeeper) Keeper {
+	// ensure superfluid module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace,
+		ak:         ak,
+		bk:         bk,
+		sk:         sk,
+		dk:         dk,
+		ek:         ek,
+		lk:         lk,
+		gk:         gk,
+	}
+}
+
+// Logger returns a logger instance
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+func (k Keeper) prefixStore(ctx sdk.Context, keyPrefix []byte) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+}
+
+// applyIfNoError runs f on a cache of ctx, and only keeps its state changes and events if it succeeds.
+// It is used from the hooks, which can't fail.
+func applyIfNoError(ctx sdk.Context, f func(ctx sdk.Context) error) error {
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	if err := f(cacheCtx); err != nil {
+		return err
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
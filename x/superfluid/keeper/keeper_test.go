@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/app"
+	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/x/lockup/types"
+	minttypes "github.com/osmosis-labs/osmosis/x/mint/types"
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *app.OsmosisApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.SuperfluidKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+var (
+	acc1 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	acc2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+)
+
+// preparePool creates a pool of 5000000 of the bond denom and 5000000 foo, and returns its share denom.
+func (suite *KeeperTestSuite) preparePool() string {
+	bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	// The coins are minted, so that the pool's tokens can be burned when its shares are slashed.
+	for _, acc := range []sdk.AccAddress{acc1, acc2} {
+		coins := sdk.NewCoins(
+			sdk.NewCoin("uosmo", sdk.NewInt(10000000000)),
+			sdk.NewCoin(bondDenom, sdk.NewInt(10000000)),
+			sdk.NewCoin("foo", sdk.NewInt(10000000)),
+		)
+		err := suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, coins)
+		suite.Require().NoError(err)
+		err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, acc, coins)
+		suite.Require().NoError(err)
+	}
+
+	poolId, err := suite.app.GAMMKeeper.CreateBalancerPool(suite.ctx, acc1, gammtypes.BalancerPoolParams{
+		SwapFee: sdk.NewDec(0),
+		ExitFee: sdk.NewDec(0),
+	}, []gammtypes.PoolAsset{
+		{
+			Weight: sdk.NewInt(100),
+			Token:  sdk.NewCoin(bondDenom, sdk.NewInt(5000000)),
+		},
+		{
+			Weight: sdk.NewInt(100),
+			Token:  sdk.NewCoin("foo", sdk.NewInt(5000000)),
+		},
+	}, "")
+	suite.Require().NoError(err)
+	return gammtypes.GetPoolShareDenom(poolId)
+}
+
+// prepareValidator creates a bonded validator.
+func (suite *KeeperTestSuite) prepareValidator() stakingtypes.Validator {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, stakingtypes.Description{})
+	suite.Require().NoError(err)
+	validator = stakingkeeper.TestingUpdateValidator(suite.app.StakingKeeper, suite.ctx, validator, true)
+	suite.Require().NoError(suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator))
+	suite.app.StakingKeeper.AfterValidatorCreated(suite.ctx, validator.GetOperator())
+	return validator
+}
+
+// setSuperfluidAsset approves denom for superfluid staking through its governance proposal.
+func (suite *KeeperTestSuite) setSuperfluidAsset(denom string) {
+	err := suite.app.SuperfluidKeeper.HandleSetSuperfluidAssetsProposal(suite.ctx, &types.SetSuperfluidAssetsProposal{
+		Title:       "title",
+		Description: "description",
+		Assets:      []types.SuperfluidAsset{{Denom: denom}},
+	})
+	suite.Require().NoError(err)
+}
+
+// lockShares locks shares of denom of owner for the unbonding time.
+func (suite *KeeperTestSuite) lockShares(owner sdk.AccAddress, denom string, shares sdk.Int) lockuptypes.PeriodLock {
+	lock, err := suite.app.LockupKeeper.LockTokens(suite.ctx, owner, sdk.Coins{sdk.NewCoin(denom, shares)}, suite.app.StakingKeeper.UnbondingTime(suite.ctx))
+	suite.Require().NoError(err)
+	return lock
+}
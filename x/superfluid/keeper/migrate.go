@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+)
+
+// MigrateSuperfluidAsset moves the superfluid staking of fromDenom to toDenom, once the locks of fromDenom
+// hold as many toDenom tokens instead, like when gamm migrates a pool.
+// The approval of the asset, its multiplier and the delegation records of its locks move to toDenom.
+// The synthetic stake of the locks moves from the intermediary accounts of fromDenom to those of toDenom,
+// along with the rewards they didn't pay yet.
+func (k Keeper) MigrateSuperfluidAsset(ctx sdk.Context, fromDenom, toDenom string) error {
+	if asset, err := k.GetSuperfluidAsset(ctx, fromDenom); err == nil {
+		asset.Denom = toDenom
+		k.SetSuperfluidAsset(ctx, asset)
+		k.DeleteSuperfluidAsset(ctx, fromDenom)
+	}
+	if record, err := k.GetOsmoEquivalentMultiplierRecord(ctx, fromDenom); err == nil {
+		k.SetOsmoEquivalentMultiplier(ctx, record.EpochNumber, toDenom, record.Multiplier)
+		k.DeleteOsmoEquivalentMultiplier(ctx, fromDenom)
+	}
+
+	for _, record := range k.GetAllDelegationRecords(ctx) {
+		if record.Denom != fromDenom {
+			continue
+		}
+		fromAcc := record.GetIntermediaryAccount()
+		record.Denom = toDenom
+		k.SetDelegationRecord(ctx, record)
+		if record.Unbonding {
+			continue
+		}
+
+		toAcc := record.GetIntermediaryAccount()
+		k.deleteIntermediaryAccountLock(ctx, fromAcc, record.LockId)
+		k.SetIntermediaryAccount(ctx, toAcc)
+		k.SetIntermediaryAccountLock(ctx, toAcc, record.LockId)
+	}
+
+	for _, fromAcc := range k.GetAllIntermediaryAccounts(ctx) {
+		if fromAcc.Denom != fromDenom {
+			continue
+		}
+		toAcc := types.NewSuperfluidIntermediaryAccount(toDenom, fromAcc.ValAddr)
+
+		// The new account delegates before the old one undelegates, so that the validator isn't left without
+		// delegations in between. Undelegating withdraws the rewards of the old account, which the new one pays.
+		k.tryRefreshIntermediaryDelegation(ctx, toAcc)
+		k.tryRefreshIntermediaryDelegation(ctx, fromAcc)
+		rewards := k.bk.GetAllBalances(ctx, fromAcc.GetAccAddress())
+		if !rewards.Empty() {
+			if err := k.bk.SendCoins(ctx, fromAcc.GetAccAddress(), toAcc.GetAccAddress(), rewards); err != nil {
+				return err
+			}
+			k.SetIntermediaryAccount(ctx, toAcc)
+		}
+	}
+	return nil
+}
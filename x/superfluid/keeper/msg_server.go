@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/utils"
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+)
+
+type msgServer struct {
+	keeper Keeper
+}
+
+// NewMsgServerImpl returns an instance of MsgServer
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (server msgServer) SuperfluidDelegate(goCtx context.Context, msg *types.MsgSuperfluidDelegate) (*types.MsgSuperfluidDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.SuperfluidDelegate(ctx, sender, msg.LockId, valAddr)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSuperfluidDelegate,
+			sdk.NewAttribute(types.AttributeLockId, utils.Uint64ToString(msg.LockId)),
+			sdk.NewAttribute(types.AttributeValidator, msg.ValAddr),
+		),
+	})
+
+	return &types.MsgSuperfluidDelegateResponse{}, nil
+}
+
+func (server msgServer) SuperfluidUndelegate(goCtx context.Context, msg *types.MsgSuperfluidUndelegate) (*types.MsgSuperfluidUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.SuperfluidUndelegate(ctx, sender, msg.LockId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSuperfluidUndelegate,
+			sdk.NewAttribute(types.AttributeLockId, utils.Uint64ToString(msg.LockId)),
+		),
+	})
+
+	return &types.MsgSuperfluidUndelegateResponse{}, nil
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+)
+
+// SuperfluidDelegate stakes the risk adjusted OSMO value of a lock to a validator, through the intermediary account
+// of the lock's denom and the validator.
+// The lock must hold a superfluid asset only, not be unlocking, and last at least the unbonding time,
+// so that it can be slashed for the validator for as long as a delegation could.
+// The delegation is backed by a superbonding synthetic lockup of the lock lasting the unbonding time,
+// which keeps the lock from being force unlocked while it can be slashed.
+func (k Keeper) SuperfluidDelegate(ctx sdk.Context, sender sdk.AccAddress, lockID uint64, valAddr sdk.ValAddress) error {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	if lock.Owner != sender.String() {
+		return types.ErrNotLockOwner
+	}
+	if _, err := k.GetDelegationRecord(ctx, lockID); err == nil {
+		return sdkerrors.Wrapf(types.ErrAlreadySuperfluidDelegated, "lock %d", lockID)
+	}
+	if lock.IsUnlocking() {
+		return sdkerrors.Wrapf(types.ErrLockNotSuperfluidEligible, "lock %d is unlocking", lockID)
+	}
+	if len(lock.Coins) != 1 {
+		return sdkerrors.Wrapf(types.ErrLockNotSuperfluidEligible, "lock %d should hold a single denom: %s", lockID, lock.Coins)
+	}
+	if lock.Duration < k.sk.UnbondingTime(ctx) {
+		return sdkerrors.Wrapf(types.ErrLockNotSuperfluidEligible, "lock %d is shorter than the unbonding time", lockID)
+	}
+	denom := lock.Coins[0].Denom
+	if _, err := k.GetSuperfluidAsset(ctx, denom); err != nil {
+		return err
+	}
+	if _, found := k.sk.GetValidator(ctx, valAddr); !found {
+		return sdkerrors.Wrapf(stakingtypes.ErrNoValidatorFound, "%s", valAddr)
+	}
+
+	if _, err := k.lk.CreateSyntheticLockup(ctx, lockID, types.SuperbondingSuffix, k.sk.UnbondingTime(ctx)); err != nil {
+		return err
+	}
+
+	record := types.SuperfluidDelegationRecord{
+		LockId:  lockID,
+		Denom:   denom,
+		ValAddr: valAddr.String(),
+	}
+	k.SetDelegationRecord(ctx, record)
+	acc := record.GetIntermediaryAccount()
+	k.SetIntermediaryAccount(ctx, acc)
+	k.SetIntermediaryAccountLock(ctx, acc, lockID)
+	return k.refreshIntermediaryDelegation(ctx, acc)
+}
+
+// SuperfluidUndelegate undelegates the synthetic stake of a lock, and begins unlocking it if it is not yet.
+// The lock keeps its record until it is unlocked, as it can still be slashed for the validator meanwhile,
+// and its superbonding synthetic lockup until the unbonding time has passed.
+func (k Keeper) SuperfluidUndelegate(ctx sdk.Context, sender sdk.AccAddress, lockID uint64) error {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	if lock.Owner != sender.String() {
+		return types.ErrNotLockOwner
+	}
+	record, err := k.GetDelegationRecord(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	if record.Unbonding {
+		return sdkerrors.Wrapf(types.ErrNotSuperfluidDelegated, "lock %d is already undelegated", lockID)
+	}
+
+	if _, err := k.lk.BeginUnlockSyntheticLockup(ctx, lockID, types.SuperbondingSuffix); err != nil {
+		return err
+	}
+
+	record.Unbonding = true
+	k.SetDelegationRecord(ctx, record)
+	acc := record.GetIntermediaryAccount()
+	k.deleteIntermediaryAccountLock(ctx, acc, lockID)
+	if err := k.refreshIntermediaryDelegation(ctx, acc); err != nil {
+		return err
+	}
+
+	if !lock.IsUnlocking() {
+		_, err = k.lk.BeginUnlockPeriodLockByID(ctx, lockID)
+	}
+	return err
+}
+
+// GetLockSyntheticStake returns the share of its intermediary account's delegation staked for a lock,
+// which is zero once it is undelegated.
+func (k Keeper) GetLockSyntheticStake(ctx sdk.Context, record types.SuperfluidDelegationRecord) (sdk.Coin, error) {
+	bondDenom := k.sk.BondDenom(ctx)
+	if record.Unbonding {
+		return sdk.NewCoin(bondDenom, sdk.ZeroInt()), nil
+	}
+
+	lock, err := k.lk.GetLockByID(ctx, record.LockId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	multiplier := k.GetOsmoEquivalentMultiplier(ctx, record.Denom)
+	return sdk.NewCoin(bondDenom, k.GetRiskAdjustedOsmoValue(ctx, multiplier.MulInt(lock.Coins.AmountOf(record.Denom)))), nil
+}
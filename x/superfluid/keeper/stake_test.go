@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/x/superfluid/keeper"
+	"github.com/osmosis-labs/osmosis/x/superfluid/types"
+)
+
+func (suite *KeeperTestSuite) TestSuperfluidDelegate() {
+	suite.SetupTest()
+
+	denom := suite.preparePool()
+	validator := suite.prepareValidator()
+	sfKeeper := suite.app.SuperfluidKeeper
+
+	// Only superfluid assets can be superfluid staked.
+	lock := suite.lockShares(acc1, denom, gammtypes.OneShare.MulRaw(50))
+	err := sfKeeper.SuperfluidDelegate(suite.ctx, acc1, lock.ID, validator.GetOperator())
+	suite.Require().ErrorIs(err, types.ErrNonSuperfluidAsset)
+
+	// Each share of the pool is worth 5000000 / 100 shares of the bond denom.
+	suite.setSuperfluidAsset(denom)
+	suite.Require().Equal(sdk.NewDec(50000).QuoInt(gammtypes.OneShare), sfKeeper.GetOsmoEquivalentMultiplier(suite.ctx, denom))
+
+	// Only the owner of the lock can superfluid stake it.
+	err = sfKeeper.SuperfluidDelegate(suite.ctx, acc2, lock.ID, validator.GetOperator())
+	suite.Require().ErrorIs(err, types.ErrNotLockOwner)
+
+	// The lock is staked at half of its OSMO value with the default risk factor.
+	err = sfKeeper.SuperfluidDelegate(suite.ctx, acc1, lock.ID, validator.GetOperator())
+	suite.Require().NoError(err)
+	res, err := suite.queryClient.LockSuperfluidStake(sdk.WrapSDKContext(suite.ctx), &types.LockSuperfluidStakeRequest{LockId: lock.ID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(validator.GetOperator().String(), res.Record.ValAddr)
+	suite.Require().Equal(sdk.NewInt(1250000), res.SyntheticStake.Amount)
+	intermediaryAddr, err := sdk.AccAddressFromBech32(res.IntermediaryAddress)
+	suite.Require().NoError(err)
+	delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, intermediaryAddr, validator.GetOperator())
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(1250000), delegation.Shares)
+
+	// A lock is superfluid staked once.
+	err = sfKeeper.SuperfluidDelegate(suite.ctx, acc1, lock.ID, validator.GetOperator())
+	suite.Require().ErrorIs(err, types.ErrAlreadySuperfluidDelegated)
+
+	// Locks shorter than the unbonding time aren't eligible.
+	shortLock, err := suite.app.LockupKeeper.LockTokens(suite.ctx, acc1, sdk.Coins{sdk.NewCoin(denom, gammtypes.OneShare)}, time.Hour)
+	suite.Require().NoError(err)
+	err = sfKeeper.SuperfluidDelegate(suite.ctx, acc1, shortLock.ID, validator.GetOperator())
+	suite.Require().ErrorIs(err, types.ErrLockNotSuperfluidEligible)
+
+	// Another lock of the denom to the validator shares its intermediary account.
+	lock2 := suite.lockShares(acc1, denom, gammtypes.OneShare.MulRaw(10))
+	err = sfKeeper.SuperfluidDelegate(suite.ctx, acc1, lock2.ID, validator.GetOperator())
+	suite.Require().NoError(err)
+	delegation, _ = suite.app.StakingKeeper.GetDelegation(suite.ctx, intermediaryAddr, validator.GetOperator())
+	suite.Require().Equal(sdk.NewDec(1500000), delegation.Shares)
+	suite.Require().Len(sfKeeper.GetAllIntermediaryAccounts(suite.ctx), 1)
+
+	_, broken := keeper.SyntheticStakeBackingInvariant(sfKeeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidUndelegate() {
+	suite.SetupTest()
+
+	denom := suite.preparePool()
+	validator := suite.prepareValidator()
+	suite.setSuperfluidAsset(denom)
+	sfKeeper := suite.app.SuperfluidKeeper
+
+	lock := suite.lockShares(acc1, denom, gammtypes.OneShare.MulRaw(50))
+	err := sfKeeper.SuperfluidDelegate(suite.ctx, acc1, lock.ID, validator.GetOperator())
+	suite.Require().NoError(err)
+	record, err := sfKeeper.GetDelegationRecord(suite.ctx, lock.ID)
+	suite.Require().NoError(err)
+	intermediaryAddr := record.GetIntermediaryAccount().GetAccAddress()
+
+	err = sfKeeper.SuperfluidUndelegate(suite.ctx, acc2, lock.ID)
+	suite.Require().ErrorIs(err, types.ErrNotLockOwner)
+
+	// The lock can't skip the unbonding by unlocking while it is delegated.
+	_, err = suite.app.LockupKeeper.BeginUnlockPeriodLockByID(suite.ctx, lock.ID)
+	suite.Require().ErrorIs(err, lockuptypes.ErrLockHasSyntheticLockups)
+	_, _, err = suite.app.LockupKeeper.BeginUnlockAllNotUnlockings(suite.ctx, acc1)
+	suite.Require().ErrorIs(err, lockuptypes.ErrLockHasSyntheticLockups)
+	_, err = suite.app.LockupKeeper.BeginPartialUnlockPeriodLockByID(suite.ctx, lock.ID, sdk.Coins{sdk.NewCoin(denom, gammtypes.OneShare)})
+	suite.Require().ErrorIs(err, lockuptypes.ErrLockHasSyntheticLockups)
+
+	// The synthetic stake is undelegated, and the lock begins unlocking with its record kept.
+	bondedSupply := suite.app.BankKeeper.GetSupply(suite.ctx).GetTotal().AmountOf(suite.app.StakingKeeper.BondDenom(suite.ctx))
+	err = sfKeeper.SuperfluidUndelegate(suite.ctx, acc1, lock.ID)
+	suite.Require().NoError(err)
+	_, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, intermediaryAddr, validator.GetOperator())
+	suite.Require().False(found)
+	suite.Require().Equal(sdk.NewInt(1250000), bondedSupply.Sub(suite.app.BankKeeper.GetSupply(suite.ctx).GetTotal().AmountOf(suite.app.StakingKeeper.BondDenom(suite.ctx))))
+	record, err = sfKeeper.GetDelegationRecord(suite.ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().True(record.Unbonding)
+	unlocking, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().True(unlocking.IsUnlocking())
+
+	err = sfKeeper.SuperfluidUndelegate(suite.ctx, acc1, lock.ID)
+	suite.Require().ErrorIs(err, types.ErrNotSuperfluidDelegated)
+
+	// The record is gone once the lock is unlocked.
+	suite.ctx = suite.ctx.WithBlockTime(unlocking.EndTime)
+	_, err = suite.app.LockupKeeper.UnlockPeriodLockByID(suite.ctx, lock.ID)
+	suite.Require().NoError(err)
+	_, err = sfKeeper.GetDelegationRecord(suite.ctx, lock.ID)
+	suite.Require().ErrorIs(err, types.ErrNotSuperfluidDelegated)
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidLockForceUnlock() {
+	suite.SetupTest()
+
+	denom := suite.preparePool()
+	validator := suite.prepareValidator()
+	suite.setSuperfluidAsset(denom)
+	sfKeeper := suite.app.SuperfluidKeeper
+	lockupKeeper := suite.app.LockupKeeper
+
+	lock := suite.lockShares(acc1, denom, gammtypes.OneShare.MulRaw(50))
+	err := sfKeeper.SuperfluidDelegate(suite.ctx, acc1, lock.ID, validator.GetOperator())
+	suite.Require().NoError(err)
+	synthLock, err := lockupKeeper.GetSyntheticLockup(suite.ctx, lock.ID, types.SuperbondingSuffix)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.app.StakingKeeper.UnbondingTime(suite.ctx), synthLock.Duration)
+
+	// A superfluid delegated lock can't be force unlocked.
+	_, _, err = lockupKeeper.ForceUnlock(suite.ctx, acc1, lock.ID)
+	suite.Require().ErrorIs(err, lockuptypes.ErrLockHasSyntheticLockups)
+
+	// Nor while its delegation is unbonding, as it can still be slashed.
+	err = sfKeeper.SuperfluidUndelegate(suite.ctx, acc1, lock.ID)
+	suite.Require().NoError(err)
+	_, _, err = lockupKeeper.ForceUnlock(suite.ctx, acc1, lock.ID)
+	suite.Require().ErrorIs(err, lockuptypes.ErrLockHasSyntheticLockups)
+
+	// It can once the unbonding time has passed, and its record goes away with it.
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(synthLock.Duration))
+	lockupKeeper.DeleteAllMaturedSyntheticLocks(suite.ctx)
+	_, _, err = lockupKeeper.ForceUnlock(suite.ctx, acc1, lock.ID)
+	suite.Require().NoError(err)
+	_, err = sfKeeper.GetDelegationRecord(suite.ctx, lock.ID)
+	suite.Require().ErrorIs(err, types.ErrNotSuperfluidDelegated)
+
+	_, broken := keeper.SyntheticStakeBackingInvariant(sfKeeper)(suite.ctx)
+	suite.Require().False(broken)
+}
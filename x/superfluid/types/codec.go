@@ -0,0 +1,40 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSuperfluidDelegate{}, "osmosis/superfluid/delegate", nil)
+	cdc.RegisterConcrete(&MsgSuperfluidUndelegate{}, "osmosis/superfluid/undelegate", nil)
+	cdc.RegisterConcrete(&SetSuperfluidAssetsProposal{}, "osmosis/SetSuperfluidAssetsProposal", nil)
+	cdc.RegisterConcrete(&RemoveSuperfluidAssetsProposal{}, "osmosis/RemoveSuperfluidAssetsProposal", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgSuperfluidDelegate{},
+		&MsgSuperfluidUndelegate{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&SetSuperfluidAssetsProposal{},
+		&RemoveSuperfluidAssetsProposal{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	amino.Seal()
+}
@@ -0,0 +1,19 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/superfluid module sentinel errors
+var (
+	ErrNonSuperfluidAsset         = sdkerrors.Register(ModuleName, 1, "asset is not a superfluid asset")
+	ErrInvalidSuperfluidAsset     = sdkerrors.Register(ModuleName, 2, "invalid superfluid asset")
+	ErrNotLockOwner               = sdkerrors.Register(ModuleName, 3, "msg sender is not the owner of specified lock")
+	ErrLockNotSuperfluidEligible  = sdkerrors.Register(ModuleName, 4, "lock can't be superfluid staked")
+	ErrAlreadySuperfluidDelegated = sdkerrors.Register(ModuleName, 5, "lock is already superfluid staked")
+	ErrNotSuperfluidDelegated     = sdkerrors.Register(ModuleName, 6, "lock is not superfluid staked")
+
+	ErrEmptyProposalAssets = sdkerrors.Register(ModuleName, 10, "assets are empty")
+)
@@ -0,0 +1,13 @@
+package types
+
+// event types
+const (
+	TypeEvtSuperfluidDelegate    = "superfluid_delegate"
+	TypeEvtSuperfluidUndelegate  = "superfluid_undelegate"
+	TypeEvtSetSuperfluidAsset    = "set_superfluid_asset"
+	TypeEvtRemoveSuperfluidAsset = "remove_superfluid_asset"
+
+	AttributeLockId    = "lock_id"
+	AttributeValidator = "validator"
+	AttributeDenom     = "denom"
+)
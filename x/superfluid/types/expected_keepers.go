@@ -0,0 +1,70 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/x/lockup/types"
+)
+
+// AccountKeeper defines the expected interface needed to get module accounts.
+type AccountKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, name string) authtypes.ModuleAccountI
+}
+
+// BankKeeper defines the expected interface needed to mint, burn and send coins.
+type BankKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// StakingKeeper defines the expected interface needed to delegate the synthetic stake of the locks.
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	UnbondingTime(ctx sdk.Context) time.Duration
+
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegation stakingtypes.Delegation, found bool)
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc stakingtypes.BondStatus, validator stakingtypes.Validator, subtractAccount bool) (newShares sdk.Dec, err error)
+	ValidateUnbondAmount(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt sdk.Int) (shares sdk.Dec, err error)
+	Unbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) (amount sdk.Int, err error)
+}
+
+// DistrKeeper defines the expected interface needed to withdraw the rewards of the synthetic stake.
+type DistrKeeper interface {
+	WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error)
+}
+
+// LockupKeeper defines the expected interface needed to read, slash and back the delegations of locks.
+type LockupKeeper interface {
+	GetLockByID(ctx sdk.Context, lockID uint64) (*lockuptypes.PeriodLock, error)
+	BeginUnlockPeriodLockByID(ctx sdk.Context, LockID uint64) (*lockuptypes.PeriodLock, error)
+	SlashTokensFromLockByID(ctx sdk.Context, lockID uint64, coins sdk.Coins, recipientModule string) (*lockuptypes.PeriodLock, error)
+
+	CreateSyntheticLockup(ctx sdk.Context, lockID uint64, suffix string, duration time.Duration) (*lockuptypes.SyntheticLock, error)
+	BeginUnlockSyntheticLockup(ctx sdk.Context, lockID uint64, suffix string) (*lockuptypes.SyntheticLock, error)
+}
+
+// GAMMKeeper defines the expected interface needed to value pool shares at their TWAP, and to remove the slashed ones from their pool.
+type GAMMKeeper interface {
+	GetPool(ctx sdk.Context, poolId uint64) (gammtypes.PoolI, error)
+	ArithmeticTwap(ctx sdk.Context, poolId uint64, baseAsset, quoteAsset string, startTime, endTime time.Time) (sdk.Dec, error)
+	SetPool(ctx sdk.Context, pool gammtypes.PoolI) error
+	ExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, tokenOutMins sdk.Coins) error
+	BurnPoolShareFromAccount(ctx sdk.Context, pool gammtypes.PoolI, addr sdk.AccAddress, amount sdk.Int) error
+}
+
+// EpochKeeper defines the expected interface needed to number the multiplier records, and to time their TWAP.
+type EpochKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) epochstypes.EpochInfo
+}
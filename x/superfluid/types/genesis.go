@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default superfluid genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:                    DefaultParams(),
+		SuperfluidAssets:          []SuperfluidAsset{},
+		OsmoEquivalentMultipliers: []OsmoEquivalentMultiplierRecord{},
+		DelegationRecords:         []SuperfluidDelegationRecord{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	assets := map[string]bool{}
+	for _, asset := range gs.SuperfluidAssets {
+		if err := asset.Validate(); err != nil {
+			return err
+		}
+		if assets[asset.Denom] {
+			return fmt.Errorf("duplicate superfluid asset %s", asset.Denom)
+		}
+		assets[asset.Denom] = true
+	}
+
+	multipliers := map[string]bool{}
+	for _, record := range gs.OsmoEquivalentMultipliers {
+		if !assets[record.Denom] {
+			return fmt.Errorf("multiplier of %s which is not a superfluid asset", record.Denom)
+		}
+		if multipliers[record.Denom] {
+			return fmt.Errorf("duplicate multiplier of %s", record.Denom)
+		}
+		multipliers[record.Denom] = true
+		if record.Multiplier.IsNil() || record.Multiplier.IsNegative() {
+			return fmt.Errorf("invalid multiplier of %s: %s", record.Denom, record.Multiplier)
+		}
+	}
+
+	lockIds := map[uint64]bool{}
+	for _, record := range gs.DelegationRecords {
+		if lockIds[record.LockId] {
+			return fmt.Errorf("duplicate superfluid delegation record of lock %d", record.LockId)
+		}
+		lockIds[record.LockId] = true
+		if err := sdk.ValidateDenom(record.Denom); err != nil {
+			return err
+		}
+		if _, err := sdk.ValAddressFromBech32(record.ValAddr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeSetSuperfluidAssets    = "SetSuperfluidAssets"
+	ProposalTypeRemoveSuperfluidAssets = "RemoveSuperfluidAssets"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetSuperfluidAssets)
+	govtypes.RegisterProposalTypeCodec(&SetSuperfluidAssetsProposal{}, "osmosis/SetSuperfluidAssetsProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveSuperfluidAssets)
+	govtypes.RegisterProposalTypeCodec(&RemoveSuperfluidAssetsProposal{}, "osmosis/RemoveSuperfluidAssetsProposal")
+}
+
+var _ govtypes.Content = &SetSuperfluidAssetsProposal{}
+var _ govtypes.Content = &RemoveSuperfluidAssetsProposal{}
+
+func NewSetSuperfluidAssetsProposal(title, description string, assets []SuperfluidAsset) govtypes.Content {
+	return &SetSuperfluidAssetsProposal{
+		Title:       title,
+		Description: description,
+		Assets:      assets,
+	}
+}
+
+func (p *SetSuperfluidAssetsProposal) GetTitle() string { return p.Title }
+
+func (p *SetSuperfluidAssetsProposal) GetDescription() string { return p.Description }
+
+func (p *SetSuperfluidAssetsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetSuperfluidAssetsProposal) ProposalType() string { return ProposalTypeSetSuperfluidAssets }
+
+func (p *SetSuperfluidAssetsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.Assets) == 0 {
+		return ErrEmptyProposalAssets
+	}
+
+	denoms := map[string]bool{}
+	for _, asset := range p.Assets {
+		if err := asset.Validate(); err != nil {
+			return err
+		}
+		if denoms[asset.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidSuperfluidAsset, "duplicate denom %s", asset.Denom)
+		}
+		denoms[asset.Denom] = true
+	}
+
+	return nil
+}
+
+func (p SetSuperfluidAssetsProposal) String() string {
+	assetsStr := ""
+	for _, asset := range p.Assets {
+		assetsStr = assetsStr + fmt.Sprintf("%s ", asset.Denom)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Superfluid Assets Proposal:
+  Title:       %s
+  Description: %s
+  Assets:      %s
+`, p.Title, p.Description, assetsStr))
+	return b.String()
+}
+
+func NewRemoveSuperfluidAssetsProposal(title, description string, denoms []string) govtypes.Content {
+	return &RemoveSuperfluidAssetsProposal{
+		Title:                 title,
+		Description:           description,
+		SuperfluidAssetDenoms: denoms,
+	}
+}
+
+func (p *RemoveSuperfluidAssetsProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveSuperfluidAssetsProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveSuperfluidAssetsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveSuperfluidAssetsProposal) ProposalType() string {
+	return ProposalTypeRemoveSuperfluidAssets
+}
+
+func (p *RemoveSuperfluidAssetsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.SuperfluidAssetDenoms) == 0 {
+		return ErrEmptyProposalAssets
+	}
+
+	denoms := map[string]bool{}
+	for _, denom := range p.SuperfluidAssetDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if denoms[denom] {
+			return sdkerrors.Wrapf(ErrInvalidSuperfluidAsset, "duplicate denom %s", denom)
+		}
+		denoms[denom] = true
+	}
+
+	return nil
+}
+
+func (p RemoveSuperfluidAssetsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Remove Superfluid Assets Proposal:
+  Title:       %s
+  Description: %s
+  Denoms:      %s
+`, p.Title, p.Description, strings.Join(p.SuperfluidAssetDenoms, " ")))
+	return b.String()
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetSuperfluidAssetsProposal(t *testing.T) {
+	tests := []struct {
+		name       string
+		proposal   SetSuperfluidAssetsProposal
+		expectPass bool
+	}{
+		{
+			name:       "share denoms",
+			proposal:   SetSuperfluidAssetsProposal{Title: "title", Description: "description", Assets: []SuperfluidAsset{{Denom: "gamm/pool/1"}, {Denom: "gamm/pool/2"}}},
+			expectPass: true,
+		},
+		{
+			name:       "no title",
+			proposal:   SetSuperfluidAssetsProposal{Description: "description", Assets: []SuperfluidAsset{{Denom: "gamm/pool/1"}}},
+			expectPass: false,
+		},
+		{
+			name:       "no assets",
+			proposal:   SetSuperfluidAssetsProposal{Title: "title", Description: "description"},
+			expectPass: false,
+		},
+		{
+			name:       "not a share denom",
+			proposal:   SetSuperfluidAssetsProposal{Title: "title", Description: "description", Assets: []SuperfluidAsset{{Denom: "uatom"}}},
+			expectPass: false,
+		},
+		{
+			name:       "duplicate asset",
+			proposal:   SetSuperfluidAssetsProposal{Title: "title", Description: "description", Assets: []SuperfluidAsset{{Denom: "gamm/pool/1"}, {Denom: "gamm/pool/1"}}},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.proposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.proposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestRemoveSuperfluidAssetsProposal(t *testing.T) {
+	tests := []struct {
+		name       string
+		proposal   RemoveSuperfluidAssetsProposal
+		expectPass bool
+	}{
+		{
+			name:       "share denoms",
+			proposal:   RemoveSuperfluidAssetsProposal{Title: "title", Description: "description", SuperfluidAssetDenoms: []string{"gamm/pool/1", "gamm/pool/2"}},
+			expectPass: true,
+		},
+		{
+			name:       "no denoms",
+			proposal:   RemoveSuperfluidAssetsProposal{Title: "title", Description: "description"},
+			expectPass: false,
+		},
+		{
+			name:       "duplicate denom",
+			proposal:   RemoveSuperfluidAssetsProposal{Title: "title", Description: "description", SuperfluidAssetDenoms: []string{"gamm/pool/1", "gamm/pool/1"}},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.proposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.proposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package types
+
+// SuperbondingSuffix is the suffix of the synthetic lockups backing the superfluid delegations of locks
+const SuperbondingSuffix = "superbonding"
+
+var (
+	// ModuleName defines the module name
+	ModuleName = "superfluid"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for superfluid
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// KeyPrefixSuperfluidAsset defines prefix to store the superfluid assets by denom
+	KeyPrefixSuperfluidAsset = []byte{0x01}
+
+	// KeyPrefixTokenMultiplier defines prefix to store the OSMO equivalent multipliers by denom
+	KeyPrefixTokenMultiplier = []byte{0x02}
+
+	// KeyPrefixDelegationRecord defines prefix to store the superfluid delegation records by lock ID
+	KeyPrefixDelegationRecord = []byte{0x03}
+
+	// KeyPrefixIntermediaryAccount defines prefix to store the intermediary accounts by address
+	KeyPrefixIntermediaryAccount = []byte{0x04}
+
+	// KeyPrefixIntermediaryAccountLock defines prefix for the iteration of the delegated lock IDs by intermediary account
+	KeyPrefixIntermediaryAccountLock = []byte{0x05}
+)
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// constants
+const (
+	TypeMsgSuperfluidDelegate   = "superfluid_delegate"
+	TypeMsgSuperfluidUndelegate = "superfluid_undelegate"
+)
+
+var _ sdk.Msg = &MsgSuperfluidDelegate{}
+
+// NewMsgSuperfluidDelegate creates a message to superfluid stake a lock to a validator
+func NewMsgSuperfluidDelegate(sender sdk.AccAddress, lockId uint64, valAddr sdk.ValAddress) *MsgSuperfluidDelegate {
+	return &MsgSuperfluidDelegate{
+		Sender:  sender.String(),
+		LockId:  lockId,
+		ValAddr: valAddr.String(),
+	}
+}
+
+func (m MsgSuperfluidDelegate) Route() string { return RouterKey }
+func (m MsgSuperfluidDelegate) Type() string  { return TypeMsgSuperfluidDelegate }
+func (m MsgSuperfluidDelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("invalid sender address (%s)", err)
+	}
+	if m.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d", m.LockId)
+	}
+	if _, err := sdk.ValAddressFromBech32(m.ValAddr); err != nil {
+		return fmt.Errorf("invalid validator address (%s)", err)
+	}
+	return nil
+}
+func (m MsgSuperfluidDelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+func (m MsgSuperfluidDelegate) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSuperfluidUndelegate{}
+
+// NewMsgSuperfluidUndelegate creates a message to undelegate the synthetic stake of a lock
+func NewMsgSuperfluidUndelegate(sender sdk.AccAddress, lockId uint64) *MsgSuperfluidUndelegate {
+	return &MsgSuperfluidUndelegate{
+		Sender: sender.String(),
+		LockId: lockId,
+	}
+}
+
+func (m MsgSuperfluidUndelegate) Route() string { return RouterKey }
+func (m MsgSuperfluidUndelegate) Type() string  { return TypeMsgSuperfluidUndelegate }
+func (m MsgSuperfluidUndelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("invalid sender address (%s)", err)
+	}
+	if m.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d", m.LockId)
+	}
+	return nil
+}
+func (m MsgSuperfluidUndelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+func (m MsgSuperfluidUndelegate) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+// Parameter store keys
+var (
+	KeyRefreshEpochIdentifier = []byte("RefreshEpochIdentifier")
+	KeyRiskFactor             = []byte("RiskFactor")
+)
+
+// ParamTable for superfluid module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(refreshEpochIdentifier string, riskFactor sdk.Dec) Params {
+	return Params{
+		RefreshEpochIdentifier: refreshEpochIdentifier,
+		RiskFactor:             riskFactor,
+	}
+}
+
+// default superfluid module parameters
+func DefaultParams() Params {
+	return Params{
+		RefreshEpochIdentifier: "day",
+		RiskFactor:             sdk.NewDecWithPrec(5, 1), // 50%
+	}
+}
+
+// validate params
+func (p Params) Validate() error {
+	if err := epochtypes.ValidateEpochIdentifierInterface(p.RefreshEpochIdentifier); err != nil {
+		return err
+	}
+	if err := validateRiskFactor(p.RiskFactor); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRefreshEpochIdentifier, &p.RefreshEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyRiskFactor, &p.RiskFactor, validateRiskFactor),
+	}
+}
+
+func validateRiskFactor(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("risk factor should be within [0, 1): %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+// Validate checks that the asset is the share denom of a pool.
+func (a SuperfluidAsset) Validate() error {
+	if _, err := gammtypes.GetPoolIdFromShareDenom(a.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidSuperfluidAsset, err.Error())
+	}
+	return nil
+}
+
+// NewSuperfluidIntermediaryAccount returns the intermediary account of the locks of denom superfluid staked to valAddr.
+func NewSuperfluidIntermediaryAccount(denom string, valAddr string) SuperfluidIntermediaryAccount {
+	return SuperfluidIntermediaryAccount{
+		Denom:   denom,
+		ValAddr: valAddr,
+	}
+}
+
+// GetAccAddress returns the address of the intermediary account, which is derived from its denom and validator
+// like module account addresses, so that no key can sign for it.
+func (a SuperfluidIntermediaryAccount) GetAccAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s/%s/%s", ModuleName, a.Denom, a.ValAddr))
+}
+
+// GetIntermediaryAccount returns the intermediary account delegating the synthetic stake of the record's lock.
+func (r SuperfluidDelegationRecord) GetIntermediaryAccount() SuperfluidIntermediaryAccount {
+	return NewSuperfluidIntermediaryAccount(r.Denom, r.ValAddr)
+}